@@ -62,6 +62,13 @@ type Configuration struct {
 	// Integrations provide configuration options for AI/ML/Batch frameworks
 	// integrations (including K8S job).
 	Integrations *Integrations `json:"integrations,omitempty"`
+
+	// FairSharing controls the fair sharing semantics across the ClusterQueues
+	// of a cohort.
+	FairSharing *FairSharing `json:"fairSharing,omitempty"`
+
+	// Preemption controls how the Workloads to preempt are selected.
+	Preemption *Preemption `json:"preemption,omitempty"`
 }
 
 type ControllerManager struct {
@@ -188,6 +195,35 @@ type WaitForPodsReady struct {
 	// until the jobs reach the PodsReady=true condition. It defaults to false if Enable is false
 	// and defaults to true otherwise.
 	BlockAdmission *bool `json:"blockAdmission,omitempty"`
+
+	// RequeuingStrategy defines the strategy for requeuing a Workload after
+	// it's evicted for exceeding the PodsReady timeout.
+	// +optional
+	RequeuingStrategy *RequeuingStrategy `json:"requeuingStrategy,omitempty"`
+}
+
+type RequeuingStrategy struct {
+	// BackoffLimitCount defines the maximum number of requeuing retries.
+	// When the number is reached, the workload is deactivated (`.spec.active`=`false`).
+	//
+	// Defaults to null, which means unlimited retries.
+	// +optional
+	BackoffLimitCount *int32 `json:"backoffLimitCount,omitempty"`
+
+	// BackoffBaseSeconds defines the base for the exponential backoff of the
+	// requeuing retries. The workload is requeued after
+	// "BackoffBaseSeconds*2^(n-1)" seconds, where n is the number of times the
+	// workload was evicted for exceeding the PodsReady timeout.
+	//
+	// Defaults to 60.
+	// +optional
+	BackoffBaseSeconds *int32 `json:"backoffBaseSeconds,omitempty"`
+
+	// BackoffMaxSeconds defines the maximum backoff time to requeue an evicted workload.
+	//
+	// Defaults to 3600.
+	// +optional
+	BackoffMaxSeconds *int32 `json:"backoffMaxSeconds,omitempty"`
 }
 
 type InternalCertManagement struct {
@@ -220,7 +256,57 @@ type Integrations struct {
 	// Possible options:
 	//  - "batch/job"
 	//  - "kubeflow.org/mpijob"
+	//  - "kubeflow.org/pytorchjob"
+	//  - "kubeflow.org/tfjob"
+	//  - "kubeflow.org/xgboostjob"
+	//  - "kubeflow.org/paddlejob"
+	//  - "kubeflow.org/mxjob"
 	//  - "ray.io/rayjob"
+	//  - "ray.io/raycluster"
 	//  - "jobset.x-k8s.io/jobset"
+	//  - "pod"
 	Frameworks []string `json:"frameworks,omitempty"`
+	// PodOptions defines kueue controller behaviour for pod objects
+	PodOptions *PodIntegrationOptions `json:"podOptions,omitempty"`
+}
+
+type PodIntegrationOptions struct {
+	// NamespaceSelector can be used to omit some namespaces from pod reconciliation.
+	// Defaults to excluding the kube-system namespace and the namespace of kueue.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// PodSelector can be used to choose what pods to reconcile.
+	// Defaults to selecting all the pods.
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+}
+
+type VictimOrderingPolicy string
+
+const (
+	LowestPriorityFirst  VictimOrderingPolicy = "LowestPriority"
+	LeastRuntimeLost     VictimOrderingPolicy = "LeastRuntimeLost"
+	FewestPods           VictimOrderingPolicy = "FewestPods"
+	LowestPreemptionCost VictimOrderingPolicy = "PreemptionCost"
+)
+
+type Preemption struct {
+	// VictimOrdering determines the order in which the candidates for
+	// preemption are considered, after the candidates from ClusterQueues
+	// borrowing quota in the cohort. The possible values are:
+	//  - "LowestPriority": lowest priority first (default).
+	//  - "LeastRuntimeLost": the Workloads admitted most recently first.
+	//  - "FewestPods": the Workloads with the fewest pods first.
+	//  - "PreemptionCost": the Workloads with the lowest value of the
+	//    kueue.x-k8s.io/preemption-cost annotation first.
+	// Ties are broken by lowest priority and then by the most recent
+	// admission.
+	VictimOrdering VictimOrderingPolicy `json:"victimOrdering,omitempty"`
+}
+
+type FairSharing struct {
+	// Enable indicates whether to enable fair sharing for all cohorts.
+	// When enabled, Kueue orders the Workloads that borrow quota by the share
+	// of their ClusterQueues, prioritizing the ClusterQueues with the lowest
+	// share, and allows preempting Workloads from ClusterQueues with a higher
+	// share than the preemptor's. Defaults to false.
+	Enable bool `json:"enable"`
 }
@@ -39,6 +39,8 @@ const (
 	DefaultClientConnectionQPS    = 20.0
 	DefaultClientConnectionBurst  = 30
 	defaultPodsReadyTimeout       = 5 * time.Minute
+	DefaultRequeuingBackoffBase   = 60
+	DefaultRequeuingBackoffMax    = 3600
 )
 
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
@@ -109,6 +111,14 @@ func SetDefaults_Configuration(cfg *Configuration) {
 			}
 			cfg.WaitForPodsReady.BlockAdmission = &defaultBlockAdmission
 		}
+		if rs := cfg.WaitForPodsReady.RequeuingStrategy; rs != nil {
+			if rs.BackoffBaseSeconds == nil {
+				rs.BackoffBaseSeconds = pointer.Int32(DefaultRequeuingBackoffBase)
+			}
+			if rs.BackoffMaxSeconds == nil {
+				rs.BackoffMaxSeconds = pointer.Int32(DefaultRequeuingBackoffMax)
+			}
+		}
 	}
 	if cfg.Integrations == nil {
 		cfg.Integrations = &Integrations{}
@@ -116,4 +126,21 @@ func SetDefaults_Configuration(cfg *Configuration) {
 	if cfg.Integrations.Frameworks == nil {
 		cfg.Integrations.Frameworks = []string{job.FrameworkName}
 	}
+	if cfg.Integrations.PodOptions == nil {
+		cfg.Integrations.PodOptions = &PodIntegrationOptions{}
+	}
+	if cfg.Integrations.PodOptions.NamespaceSelector == nil {
+		cfg.Integrations.PodOptions.NamespaceSelector = &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{
+					Key:      "kubernetes.io/metadata.name",
+					Operator: metav1.LabelSelectorOpNotIn,
+					Values:   []string{"kube-system", *cfg.Namespace},
+				},
+			},
+		}
+	}
+	if cfg.Integrations.PodOptions.PodSelector == nil {
+		cfg.Integrations.PodOptions.PodSelector = &metav1.LabelSelector{}
+	}
 }
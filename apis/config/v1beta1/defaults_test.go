@@ -52,8 +52,27 @@ func TestSetDefaults_Configuration(t *testing.T) {
 		QPS:   pointer.Float32(DefaultClientConnectionQPS),
 		Burst: pointer.Int32(DefaultClientConnectionBurst),
 	}
+	podsIntegrationOptions := func(namespace string) *PodIntegrationOptions {
+		return &PodIntegrationOptions{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{
+						Key:      "kubernetes.io/metadata.name",
+						Operator: metav1.LabelSelectorOpNotIn,
+						Values:   []string{"kube-system", namespace},
+					},
+				},
+			},
+			PodSelector: &metav1.LabelSelector{},
+		}
+	}
 	defaultIntegrations := &Integrations{
 		Frameworks: []string{job.FrameworkName},
+		PodOptions: podsIntegrationOptions(DefaultNamespace),
+	}
+	overwriteNamespaceIntegrations := &Integrations{
+		Frameworks: []string{job.FrameworkName},
+		PodOptions: podsIntegrationOptions(overwriteNamespace),
 	}
 	podsReadyTimeoutTimeout := metav1.Duration{Duration: defaultPodsReadyTimeout}
 	podsReadyTimeoutOverwrite := metav1.Duration{Duration: time.Minute}
@@ -206,7 +225,7 @@ func TestSetDefaults_Configuration(t *testing.T) {
 					WebhookSecretName:  pointer.String(DefaultWebhookSecretName),
 				},
 				ClientConnection: defaultClientConnection,
-				Integrations:     defaultIntegrations,
+				Integrations:     overwriteNamespaceIntegrations,
 			},
 		},
 		"should not default InternalCertManagement": {
@@ -223,7 +242,7 @@ func TestSetDefaults_Configuration(t *testing.T) {
 					Enable: pointer.Bool(false),
 				},
 				ClientConnection: defaultClientConnection,
-				Integrations:     defaultIntegrations,
+				Integrations:     overwriteNamespaceIntegrations,
 			},
 		},
 		"should not default values in custom ClientConnection": {
@@ -247,7 +266,7 @@ func TestSetDefaults_Configuration(t *testing.T) {
 					QPS:   pointer.Float32(123.0),
 					Burst: pointer.Int32(456),
 				},
-				Integrations: defaultIntegrations,
+				Integrations: overwriteNamespaceIntegrations,
 			},
 		},
 		"should default empty custom ClientConnection": {
@@ -265,7 +284,7 @@ func TestSetDefaults_Configuration(t *testing.T) {
 					Enable: pointer.Bool(false),
 				},
 				ClientConnection: defaultClientConnection,
-				Integrations:     defaultIntegrations,
+				Integrations:     overwriteNamespaceIntegrations,
 			},
 		},
 		"defaulting waitForPodsReady.timeout": {
@@ -341,6 +360,38 @@ func TestSetDefaults_Configuration(t *testing.T) {
 				Integrations:     defaultIntegrations,
 			},
 		},
+		"defaulting waitForPodsReady.requeuingStrategy": {
+			original: &Configuration{
+				WaitForPodsReady: &WaitForPodsReady{
+					Enable: true,
+					RequeuingStrategy: &RequeuingStrategy{
+						BackoffLimitCount: pointer.Int32(5),
+					},
+				},
+				InternalCertManagement: &InternalCertManagement{
+					Enable: pointer.Bool(false),
+				},
+			},
+			want: &Configuration{
+				WaitForPodsReady: &WaitForPodsReady{
+					Enable:         true,
+					BlockAdmission: pointer.Bool(true),
+					Timeout:        &podsReadyTimeoutTimeout,
+					RequeuingStrategy: &RequeuingStrategy{
+						BackoffLimitCount:  pointer.Int32(5),
+						BackoffBaseSeconds: pointer.Int32(DefaultRequeuingBackoffBase),
+						BackoffMaxSeconds:  pointer.Int32(DefaultRequeuingBackoffMax),
+					},
+				},
+				Namespace:         pointer.String(DefaultNamespace),
+				ControllerManager: defaultCtrlManagerConfigurationSpec,
+				InternalCertManagement: &InternalCertManagement{
+					Enable: pointer.Bool(false),
+				},
+				ClientConnection: defaultClientConnection,
+				Integrations:     defaultIntegrations,
+			},
+		},
 		"integrations": {
 			original: &Configuration{
 				InternalCertManagement: &InternalCertManagement{
@@ -359,6 +410,39 @@ func TestSetDefaults_Configuration(t *testing.T) {
 				ClientConnection: defaultClientConnection,
 				Integrations: &Integrations{
 					Frameworks: []string{"a", "b"},
+					PodOptions: podsIntegrationOptions(DefaultNamespace),
+				},
+			},
+		},
+		"pod integration options": {
+			original: &Configuration{
+				InternalCertManagement: &InternalCertManagement{
+					Enable: pointer.Bool(false),
+				},
+				Integrations: &Integrations{
+					Frameworks: []string{"pod"},
+					PodOptions: &PodIntegrationOptions{
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"kueue-managed": "true"},
+						},
+					},
+				},
+			},
+			want: &Configuration{
+				Namespace:         pointer.String(DefaultNamespace),
+				ControllerManager: defaultCtrlManagerConfigurationSpec,
+				InternalCertManagement: &InternalCertManagement{
+					Enable: pointer.Bool(false),
+				},
+				ClientConnection: defaultClientConnection,
+				Integrations: &Integrations{
+					Frameworks: []string{"pod"},
+					PodOptions: &PodIntegrationOptions{
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"kueue-managed": "true"},
+						},
+						PodSelector: &metav1.LabelSelector{},
+					},
 				},
 			},
 		},
@@ -83,6 +83,16 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 		*out = new(Integrations)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FairSharing != nil {
+		in, out := &in.FairSharing, &out.FairSharing
+		*out = new(FairSharing)
+		**out = **in
+	}
+	if in.Preemption != nil {
+		in, out := &in.Preemption, &out.Preemption
+		*out = new(Preemption)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Configuration.
@@ -208,6 +218,21 @@ func (in *ControllerWebhook) DeepCopy() *ControllerWebhook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FairSharing) DeepCopyInto(out *FairSharing) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FairSharing.
+func (in *FairSharing) DeepCopy() *FairSharing {
+	if in == nil {
+		return nil
+	}
+	out := new(FairSharing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Integrations) DeepCopyInto(out *Integrations) {
 	*out = *in
@@ -216,6 +241,11 @@ func (in *Integrations) DeepCopyInto(out *Integrations) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PodOptions != nil {
+		in, out := &in.PodOptions, &out.PodOptions
+		*out = new(PodIntegrationOptions)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Integrations.
@@ -258,6 +288,76 @@ func (in *InternalCertManagement) DeepCopy() *InternalCertManagement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIntegrationOptions) DeepCopyInto(out *PodIntegrationOptions) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIntegrationOptions.
+func (in *PodIntegrationOptions) DeepCopy() *PodIntegrationOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIntegrationOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Preemption) DeepCopyInto(out *Preemption) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Preemption.
+func (in *Preemption) DeepCopy() *Preemption {
+	if in == nil {
+		return nil
+	}
+	out := new(Preemption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequeuingStrategy) DeepCopyInto(out *RequeuingStrategy) {
+	*out = *in
+	if in.BackoffLimitCount != nil {
+		in, out := &in.BackoffLimitCount, &out.BackoffLimitCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BackoffBaseSeconds != nil {
+		in, out := &in.BackoffBaseSeconds, &out.BackoffBaseSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BackoffMaxSeconds != nil {
+		in, out := &in.BackoffMaxSeconds, &out.BackoffMaxSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequeuingStrategy.
+func (in *RequeuingStrategy) DeepCopy() *RequeuingStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(RequeuingStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WaitForPodsReady) DeepCopyInto(out *WaitForPodsReady) {
 	*out = *in
@@ -271,6 +371,11 @@ func (in *WaitForPodsReady) DeepCopyInto(out *WaitForPodsReady) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RequeuingStrategy != nil {
+		in, out := &in.RequeuingStrategy, &out.RequeuingStrategy
+		*out = new(RequeuingStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WaitForPodsReady.
@@ -43,8 +43,10 @@ type ClusterQueueSpec struct {
 	// If empty, this ClusterQueue cannot borrow from any other ClusterQueue and
 	// vice versa.
 	//
-	// A cohort is a name that links CQs together, but it doesn't reference any
-	// object.
+	// A cohort is a name that links CQs together. It can optionally be backed
+	// by a Cohort object with the same name, which places the cohort in a
+	// hierarchy of cohorts and can provide additional quota. A CQ can borrow
+	// unused quota from any CQ in the hierarchy.
 	//
 	// Validation of a cohort name is equivalent to that of object names:
 	// subdomain in DNS (RFC 1123).
@@ -60,9 +62,12 @@ type ClusterQueueSpec struct {
 	// - BestEffortFIFO: workloads are ordered by creation time,
 	// however older workloads that can't be admitted will not block
 	// admitting newer workloads that fit existing quota.
+	// - RoundRobin: workloads of the same priority are taken from the
+	// LocalQueues in turns, weighted by the weight of the LocalQueues.
+	// Within a LocalQueue, workloads are ordered as in BestEffortFIFO.
 	//
 	// +kubebuilder:default=BestEffortFIFO
-	// +kubebuilder:validation:Enum=StrictFIFO;BestEffortFIFO
+	// +kubebuilder:validation:Enum=StrictFIFO;BestEffortFIFO;RoundRobin
 	QueueingStrategy QueueingStrategy `json:"queueingStrategy,omitempty"`
 
 	// namespaceSelector defines which namespaces are allowed to submit workloads to
@@ -88,6 +93,54 @@ type ClusterQueueSpec struct {
 	// preempt to accomomdate the pending Workload, preempting Workloads with
 	// lower priority first.
 	Preemption *ClusterQueuePreemption `json:"preemption,omitempty"`
+
+	// flavorFungibility defines whether a workload should try the next flavor
+	// before borrowing or preempting in the flavor being evaluated.
+	// +optional
+	FlavorFungibility *FlavorFungibility `json:"flavorFungibility,omitempty"`
+
+	// admissionChecks lists the AdmissionChecks required by this ClusterQueue.
+	// A Workload that reserved quota in this ClusterQueue is only admitted once
+	// all the listed checks report the Ready state.
+	// +optional
+	// +listType=set
+	AdmissionChecks []string `json:"admissionChecks,omitempty"`
+
+	// fairSharing defines the properties of the ClusterQueue when participating
+	// in fair sharing. The values are only relevant if fair sharing is enabled
+	// in the Kueue configuration.
+	// +optional
+	FairSharing *FairSharing `json:"fairSharing,omitempty"`
+
+	// stopPolicy - if set to a value different from None, the ClusterQueue is
+	// considered Inactive and doesn't admit new workloads.
+	//
+	// Depending on its value, the workloads that are already admitted:
+	//
+	// - None - are not affected, and new workloads are admitted.
+	// - Hold - keep running until they finish.
+	// - HoldAndDrain - are evicted.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=None;Hold;HoldAndDrain
+	// +kubebuilder:default="None"
+	StopPolicy *StopPolicy `json:"stopPolicy,omitempty"`
+}
+
+// FairSharing contains the properties of the ClusterQueue when participating
+// in fair sharing.
+type FairSharing struct {
+	// weight gives a comparative advantage to this ClusterQueue when competing
+	// for unused resources in the cohort against other ClusterQueues.
+	// The share of a ClusterQueue is based on the dominant resource usage above
+	// nominal quotas for each resource, divided by the weight.
+	// Admission prioritizes scheduling Workloads from ClusterQueues with the
+	// lowest share and preempting Workloads from the ClusterQueues with the
+	// highest share.
+	// A zero weight implies an infinite share value, meaning that this
+	// ClusterQueue will always be at disadvantage against other ClusterQueues.
+	// +kubebuilder:default=1
+	Weight *resource.Quantity `json:"weight,omitempty"`
 }
 
 type QueueingStrategy string
@@ -102,6 +155,13 @@ const (
 	// however older workloads that can't be admitted will not block
 	// admitting newer workloads that fit existing quota.
 	BestEffortFIFO QueueingStrategy = "BestEffortFIFO"
+
+	// RoundRobin means that workloads of the same priority are taken from
+	// the LocalQueues in turns, so that a LocalQueue with many workloads
+	// doesn't starve the others. LocalQueues with a higher weight get
+	// proportionally more turns. Older workloads that can't be admitted
+	// will not block admitting newer workloads that fit existing quota.
+	RoundRobin QueueingStrategy = "RoundRobin"
 )
 
 type ResourceGroup struct {
@@ -171,6 +231,19 @@ type ResourceQuota struct {
 	// borrowingLimit must be null if spec.cohort is empty.
 	// +optional
 	BorrowingLimit *resource.Quantity `json:"borrowingLimit,omitempty"`
+
+	// lendingLimit is the maximum amount of unused quota for the [flavor, resource]
+	// combination that this ClusterQueue can lend to other ClusterQueues in the
+	// same cohort.
+	// The rest of the nominalQuota, nominalQuota-lendingLimit, is reserved for
+	// the Workloads in this ClusterQueue and it can't be borrowed by other
+	// ClusterQueues, even when unused.
+	// If null, it means that there is no lending limit, meaning that all the
+	// nominalQuota can be borrowed by other ClusterQueues in the cohort.
+	// If not null, it must be non-negative and not greater than nominalQuota.
+	// lendingLimit must be null if spec.cohort is empty.
+	// +optional
+	LendingLimit *resource.Quantity `json:"lendingLimit,omitempty"`
 }
 
 // ResourceFlavorReference is the name of the ResourceFlavor.
@@ -226,6 +299,12 @@ type ResourceUsage struct {
 	// Borrowed is quantity of quota that is borrowed from the cohort. In other
 	// words, it's the used quota that is over the nominalQuota.
 	Borrowed resource.Quantity `json:"borrowed,omitempty"`
+
+	// Lent is the quantity of the unused nominal quota of this ClusterQueue,
+	// up to the lendingLimit, that is used by other ClusterQueues in the
+	// cohort. The quota borrowed in the cohort is split between the lenders in
+	// proportion to the quota that each of them can lend.
+	Lent resource.Quantity `json:"lent,omitempty"`
 }
 
 const (
@@ -234,6 +313,50 @@ const (
 	ClusterQueueActive string = "Active"
 )
 
+type StopPolicy string
+
+const (
+	None         StopPolicy = "None"
+	HoldAndDrain StopPolicy = "HoldAndDrain"
+	Hold         StopPolicy = "Hold"
+)
+
+type FlavorFungibilityPolicy string
+
+const (
+	Borrow        FlavorFungibilityPolicy = "Borrow"
+	Preempt       FlavorFungibilityPolicy = "Preempt"
+	TryNextFlavor FlavorFungibilityPolicy = "TryNextFlavor"
+)
+
+// FlavorFungibility determines whether a workload should try the next flavor
+// before borrowing or preempting in the current flavor.
+type FlavorFungibility struct {
+	// whenCanBorrow determines whether a workload should try the next flavor
+	// before borrowing in the current flavor. The possible values are:
+	//
+	// - `Borrow` (default): allocate in the current flavor if borrowing
+	//   is possible.
+	// - `TryNextFlavor`: try the next flavor even if the current
+	//   flavor has enough resources to borrow.
+	//
+	// +kubebuilder:validation:Enum={Borrow,TryNextFlavor}
+	// +kubebuilder:default="Borrow"
+	WhenCanBorrow FlavorFungibilityPolicy `json:"whenCanBorrow,omitempty"`
+
+	// whenCanPreempt determines whether a workload should try the next flavor
+	// before preempting in the current flavor. The possible values are:
+	//
+	// - `Preempt`: allocate in the current flavor if it's possible to preempt
+	//   some workloads.
+	// - `TryNextFlavor` (default): try the next flavor even if there are enough
+	//   candidates for preemption in the current flavor.
+	//
+	// +kubebuilder:validation:Enum={Preempt,TryNextFlavor}
+	// +kubebuilder:default="TryNextFlavor"
+	WhenCanPreempt FlavorFungibilityPolicy `json:"whenCanPreempt,omitempty"`
+}
+
 type PreemptionPolicy string
 
 const (
@@ -276,9 +399,58 @@ type ClusterQueuePreemption struct {
 	// +kubebuilder:default=Never
 	// +kubebuilder:validation:Enum=Never;LowerPriority;LowerOrNewerEqualPriority
 	WithinClusterQueue PreemptionPolicy `json:"withinClusterQueue,omitempty"`
+
+	// borrowWithinCohort determines whether a pending Workload that needs to
+	// borrow quota can preempt Workloads from other ClusterQueues in the
+	// cohort that are using more than their nominal quota. Without it, a
+	// pending Workload only reclaims quota within the cohort if it fits
+	// within the nominal quota of its ClusterQueue.
+	// It requires reclaimWithinCohort to be different from Never.
+	// +optional
+	BorrowWithinCohort *BorrowWithinCohort `json:"borrowWithinCohort,omitempty"`
+
+	// gracePeriodSeconds is the time, in seconds, the Workloads of this
+	// ClusterQueue are given to terminate after they are preempted. During
+	// the grace period, the Workloads have the PreemptionPending condition and
+	// keep their quota, until their pods exit or the grace period ends.
+	// Defaults to 0, which evicts the preempted Workloads immediately.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	GracePeriodSeconds *int32 `json:"gracePeriodSeconds,omitempty"`
+}
+
+type BorrowWithinCohortPolicy string
+
+const (
+	BorrowWithinCohortPolicyNever         BorrowWithinCohortPolicy = "Never"
+	BorrowWithinCohortPolicyLowerPriority BorrowWithinCohortPolicy = "LowerPriority"
+)
+
+// BorrowWithinCohort contains the configuration of the preemption of
+// Workloads in the cohort by a Workload that needs to borrow.
+type BorrowWithinCohort struct {
+	// policy determines the policy for preemption while borrowing. The
+	// possible values are:
+	//
+	// - `Never` (default): do not preempt Workloads in the cohort while
+	//   borrowing.
+	// - `LowerPriority`: preempt Workloads in the cohort that have lower
+	//   priority than the pending Workload, even if it needs to borrow.
+	//
+	// +kubebuilder:default=Never
+	// +kubebuilder:validation:Enum=Never;LowerPriority
+	Policy BorrowWithinCohortPolicy `json:"policy,omitempty"`
+
+	// maxPriorityThreshold restricts the Workloads that can be preempted by a
+	// borrowing Workload to the ones with a priority less than or equal to the
+	// threshold. When not set, any Workload with lower priority than the
+	// pending Workload can be preempted.
+	// +optional
+	MaxPriorityThreshold *int32 `json:"maxPriorityThreshold,omitempty"`
 }
 
 //+genclient
+//+genclient:nonNamespaced
 //+kubebuilder:object:root=true
 //+kubebuilder:storageversion
 //+kubebuilder:resource:scope=Cluster
@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CohortSpec defines the desired state of Cohort
+type CohortSpec struct {
+	// parent is the name of the Cohort this Cohort belongs to. The ClusterQueues
+	// of a Cohort, and of the Cohorts below it, can borrow unused quota from
+	// the whole tree that the parent is part of, and lend their unused quota
+	// to it.
+	// If empty, the Cohort is the root of a tree.
+	//
+	// The parent doesn't need to exist as an object; like spec.cohort in a
+	// ClusterQueue, it is a name that links Cohorts together.
+	//
+	// Validation of a parent name is equivalent to that of object names:
+	// subdomain in DNS (RFC 1123).
+	// +optional
+	Parent string `json:"parent,omitempty"`
+
+	// resourceGroups describes the quota that the Cohort provides on top of the
+	// quota of its ClusterQueues and child Cohorts. This quota is not owned by
+	// any ClusterQueue, so it can only be used by borrowing.
+	//
+	// The borrowingLimit of a [flavor, resource] limits how much the Cohort,
+	// including its ClusterQueues and child Cohorts, can borrow from its
+	// parent. It must be null if spec.parent is empty.
+	//
+	// Each resource and each flavor can only form part of one resource group.
+	// resourceGroups can be up to 16.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	ResourceGroups []ResourceGroup `json:"resourceGroups,omitempty"`
+}
+
+//+genclient
+//+genclient:nonNamespaced
+//+kubebuilder:object:root=true
+//+kubebuilder:storageversion
+//+kubebuilder:resource:scope=Cluster
+//+kubebuilder:printcolumn:name="Parent",JSONPath=".spec.parent",type=string,description="Parent of the Cohort"
+
+// Cohort is the Schema for the cohorts API. A Cohort groups ClusterQueues,
+// and other Cohorts, that borrow unused quota from each other.
+type Cohort struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec CohortSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// CohortList contains a list of Cohort
+type CohortList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Cohort `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Cohort{}, &CohortList{})
+}
@@ -26,6 +26,59 @@ import (
 type LocalQueueSpec struct {
 	// clusterQueue is a reference to a clusterQueue that backs this localQueue.
 	ClusterQueue ClusterQueueReference `json:"clusterQueue,omitempty"`
+
+	// flavorLimits sets limits, per flavor and resource, on the quota of the
+	// ClusterQueue that the workloads in this LocalQueue can use together.
+	// Workloads that would exceed the limits remain pending, even if there is
+	// enough unused quota in the ClusterQueue.
+	// Resources that are not listed are not limited.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	FlavorLimits []LocalQueueFlavorLimit `json:"flavorLimits,omitempty"`
+
+	// stopPolicy - if set to a value different from None, the LocalQueue is
+	// considered Inactive and its workloads are not admitted.
+	//
+	// Depending on its value, the workloads that are already admitted:
+	//
+	// - None - are not affected, and new workloads are admitted.
+	// - Hold - keep running until they finish.
+	// - HoldAndDrain - are evicted.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=None;Hold;HoldAndDrain
+	// +kubebuilder:default="None"
+	StopPolicy *StopPolicy `json:"stopPolicy,omitempty"`
+
+	// weight is the share of the admission turns that the LocalQueue gets,
+	// relative to the other LocalQueues of a ClusterQueue with the RoundRobin
+	// queueing strategy. It's ignored by the other queueing strategies.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+type LocalQueueFlavorLimit struct {
+	// name of the flavor.
+	Name ResourceFlavorReference `json:"name"`
+
+	// resources lists the limits for the resources in this flavor.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Resources []LocalQueueResourceLimit `json:"resources"`
+}
+
+type LocalQueueResourceLimit struct {
+	// name of the resource.
+	Name corev1.ResourceName `json:"name"`
+
+	// max is the maximum quantity of the resource that the workloads in the
+	// LocalQueue can use.
+	Max resource.Quantity `json:"max"`
 }
 
 // ClusterQueueReference is the name of the ClusterQueue.
@@ -0,0 +1,177 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ProvisioningRequestSpec defines the capacity requested for a Workload.
+type ProvisioningRequestSpec struct {
+	// podSets lists the groups of pods for which capacity is requested.
+	// Each entry is built from the corresponding podSetAssignment of the
+	// Workload that reserved quota.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	// +kubebuilder:validation:MinItems=1
+	PodSets []ProvisioningRequestPodSet `json:"podSets"`
+
+	// provisioningClassName is the name of the class of provisioning the
+	// request is handled by.
+	//
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$"
+	ProvisioningClassName string `json:"provisioningClassName"`
+
+	// parameters contains the provisioning class specific parameters.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxProperties=100
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+type ProvisioningRequestPodSet struct {
+	// name is the name of the Workload podSet.
+	Name string `json:"name"`
+
+	// template is the Pod template of the podSet, including the nodeSelector
+	// of the ResourceFlavors assigned to it.
+	Template corev1.PodTemplateSpec `json:"template"`
+
+	// count is the number of pods for which capacity is requested.
+	// +kubebuilder:validation:Minimum=1
+	Count int32 `json:"count"`
+}
+
+// ProvisioningRequestStatus defines the observed state of ProvisioningRequest
+type ProvisioningRequestStatus struct {
+	// conditions hold the latest available observations of the
+	// ProvisioningRequest current state.
+	//
+	// The type of the condition could be:
+	//
+	// - Provisioned: the requested capacity is available.
+	// - Failed: the requested capacity cannot be provisioned.
+	// - CapacityRevoked: the capacity that was provisioned is no longer
+	// available.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// podSetUpdates holds the modifications that should be applied to the
+	// pods for them to land on the provisioned capacity.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	PodSetUpdates []PodSetUpdate `json:"podSetUpdates,omitempty"`
+}
+
+const (
+	// ProvisioningRequestProvisioned means that the requested capacity is
+	// available.
+	ProvisioningRequestProvisioned = "Provisioned"
+
+	// ProvisioningRequestFailed means that the requested capacity cannot be
+	// provisioned.
+	ProvisioningRequestFailed = "Failed"
+
+	// ProvisioningRequestCapacityRevoked means that the capacity that was
+	// provisioned is no longer available.
+	ProvisioningRequestCapacityRevoked = "CapacityRevoked"
+)
+
+//+genclient
+//+kubebuilder:object:root=true
+//+kubebuilder:storageversion
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Class",JSONPath=".spec.provisioningClassName",type=string,description="Provisioning class handling the request"
+//+kubebuilder:printcolumn:name="Provisioned",JSONPath=".status.conditions[?(@.type=='Provisioned')].status",type=string,description="Whether the capacity is provisioned"
+//+kubebuilder:printcolumn:name="Age",JSONPath=".metadata.creationTimestamp",type=date,description="Time this request was created"
+
+// ProvisioningRequest is the Schema for the provisioningrequests API.
+// It requests capacity for the podSets of a Workload that reserved quota.
+type ProvisioningRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProvisioningRequestSpec   `json:"spec,omitempty"`
+	Status ProvisioningRequestStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ProvisioningRequestList contains a list of ProvisioningRequest
+type ProvisioningRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProvisioningRequest `json:"items"`
+}
+
+// ProvisioningRequestConfigSpec defines the desired state of ProvisioningRequestConfig
+type ProvisioningRequestConfigSpec struct {
+	// provisioningClassName is the name of the class of provisioning the
+	// ProvisioningRequests are created with.
+	//
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$"
+	ProvisioningClassName string `json:"provisioningClassName"`
+
+	// parameters contains the provisioning class specific parameters that are
+	// copied into the ProvisioningRequests.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxProperties=100
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+//+genclient
+//+genclient:nonNamespaced
+//+kubebuilder:object:root=true
+//+kubebuilder:storageversion
+//+kubebuilder:resource:scope=Cluster
+
+// ProvisioningRequestConfig is the Schema for the provisioningrequestconfigs API.
+// It holds the parameters of the AdmissionChecks handled by the
+// provisioning request admission check controller.
+type ProvisioningRequestConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ProvisioningRequestConfigSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ProvisioningRequestConfigList contains a list of ProvisioningRequestConfig
+type ProvisioningRequestConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProvisioningRequestConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ProvisioningRequest{}, &ProvisioningRequestList{}, &ProvisioningRequestConfig{}, &ProvisioningRequestConfigList{})
+}
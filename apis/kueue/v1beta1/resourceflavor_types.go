@@ -22,6 +22,7 @@ import (
 )
 
 //+genclient
+//+genclient:nonNamespaced
 //+kubebuilder:object:root=true
 //+kubebuilder:storageversion
 //+kubebuilder:resource:scope=Cluster,shortName={flavor,flavors}
@@ -65,6 +66,30 @@ type ResourceFlavorSpec struct {
 	// +listType=atomic
 	// +kubebuilder:validation:MaxItems=8
 	NodeTaints []corev1.Taint `json:"nodeTaints,omitempty"`
+
+	// tolerations are extra tolerations that will be added to the pods admitted
+	// in the quota associated with this ResourceFlavor.
+	// The tolerations are also taken into account, along with the tolerations of
+	// the podsets, when matching the nodeTaints during admission.
+	//
+	// An example of a toleration is
+	// cloud.provider.com/preemptible="true":NoSchedule
+	//
+	// tolerations can be up to 8 elements.
+	//
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=8
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// topologyName indicates the Topology that describes the hierarchy of the
+	// nodes of this ResourceFlavor. PodSets that request a topology level,
+	// through the kueue.x-k8s.io/podset-required-topology or
+	// kueue.x-k8s.io/podset-preferred-topology annotations, are only
+	// assigned ResourceFlavors with a Topology that has the level.
+	//
+	// +optional
+	TopologyName *string `json:"topologyName,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// PodSetRequiredTopologyAnnotation indicates that all the pods of a PodSet
+	// must be placed in a single domain of the topology level given by the
+	// value, a node label, for example "cloud.provider.com/topology-rack".
+	PodSetRequiredTopologyAnnotation = "kueue.x-k8s.io/podset-required-topology"
+
+	// PodSetPreferredTopologyAnnotation indicates that the pods of a PodSet
+	// should be placed in a single domain of the topology level given by the
+	// value, a node label. If no single domain has enough capacity, the pods
+	// are spread over the fewest domains of that level.
+	PodSetPreferredTopologyAnnotation = "kueue.x-k8s.io/podset-preferred-topology"
+
+	// TopologySchedulingLabel is added to the pods admitted with a topology
+	// assignment. The capacity used by these pods is accounted from the
+	// admitted Workloads instead of the pods.
+	TopologySchedulingLabel = "kueue.x-k8s.io/tas"
+)
+
+// TopologySpec defines the desired state of Topology
+type TopologySpec struct {
+	// levels define the levels of the topology, from the highest to the lowest,
+	// as node labels. For example, a block, a rack and a host.
+	//
+	// +required
+	// +listType=map
+	// +listMapKey=nodeLabel
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	Levels []TopologyLevel `json:"levels,omitempty"`
+}
+
+// TopologyLevel defines the desired state of TopologyLevel
+type TopologyLevel struct {
+	// nodeLabel indicates the name of the node label for a specific topology
+	// level, for example "cloud.provider.com/topology-rack".
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=316
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])$`
+	NodeLabel string `json:"nodeLabel"`
+}
+
+//+genclient
+//+genclient:nonNamespaced
+//+kubebuilder:object:root=true
+//+kubebuilder:storageversion
+//+kubebuilder:resource:scope=Cluster
+
+// Topology is the Schema for the topology API. A Topology describes the
+// hierarchy of the nodes of the ResourceFlavors that refer to it.
+type Topology struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec TopologySpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TopologyList contains a list of Topology
+type TopologyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Topology `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Topology{}, &TopologyList{})
+}
@@ -42,8 +42,9 @@ type WorkloadSpec struct {
 	// "system-node-critical" and "system-cluster-critical" are two special
 	// keywords which indicate the highest priorities with the former being
 	// the highest priority. Any other name must be defined by creating a
-	// PriorityClass object with that name. If not specified, the workload
-	// priority will be default or zero if there is no default.
+	// PriorityClass or a WorkloadPriorityClass object with that name, depending
+	// on priorityClassSource. If not specified, the workload priority will be
+	// default or zero if there is no default.
 	PriorityClassName string `json:"priorityClassName,omitempty"`
 
 	// Priority determines the order of access to the resources managed by the
@@ -52,6 +53,37 @@ type WorkloadSpec struct {
 	// The higher the value, the higher the priority.
 	// If priorityClassName is specified, priority must not be null.
 	Priority *int32 `json:"priority,omitempty"`
+
+	// priorityClassSource determines whether the priorityClass field refers to a pod PriorityClass or kueue.x-k8s.io/workloadpriorityclass.
+	// Workload's PriorityClass can accept the name of a pod priorityClass or a workloadPriorityClass.
+	// When using pod PriorityClass, a priorityClassSource field has the scheduling.k8s.io/priorityclass value.
+	// +kubebuilder:default=""
+	// +kubebuilder:validation:Enum=kueue.x-k8s.io/workloadpriorityclass;scheduling.k8s.io/priorityclass;""
+	PriorityClassSource string `json:"priorityClassSource,omitempty"`
+
+	// active determines whether the workload can be admitted.
+	// Setting active to false evicts the workload if it is admitted, and keeps
+	// it out of the queues until active is set back to true.
+	// Defaults to true.
+	// +kubebuilder:default=true
+	// +optional
+	Active *bool `json:"active,omitempty"`
+
+	// maximumExecutionTimeSeconds if provided, determines the maximum time, in seconds,
+	// the workload can be admitted before it's automatically evicted and finished.
+	// The time the workload was admitted before previous evictions is also
+	// accounted for.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaximumExecutionTimeSeconds *int32 `json:"maximumExecutionTimeSeconds,omitempty"`
+
+	// preemptionGracePeriodSeconds if provided, determines the time, in
+	// seconds, the workload is given to terminate after it's preempted, before
+	// it's evicted and its quota is freed. It overrides the grace period of
+	// the ClusterQueue.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	PreemptionGracePeriodSeconds *int32 `json:"preemptionGracePeriodSeconds,omitempty"`
 }
 
 type Admission struct {
@@ -87,6 +119,46 @@ type PodSetAssignment struct {
 	// +optional
 	// +kubebuilder:validation:Minimum=0
 	Count *int32 `json:"count,omitempty"`
+
+	// topologyAssignment indicates the topology domains where the pods of the
+	// podset are placed. It is only set for podsets that request a topology
+	// level and are assigned a ResourceFlavor with a Topology.
+	//
+	// +optional
+	TopologyAssignment *TopologyAssignment `json:"topologyAssignment,omitempty"`
+}
+
+type TopologyAssignment struct {
+	// levels are the node labels of the topology levels, from the highest
+	// to the requested one.
+	//
+	// +required
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	Levels []string `json:"levels"`
+
+	// domains are the topology domains assigned to the podset, with the
+	// number of pods in each domain.
+	//
+	// +required
+	// +listType=atomic
+	Domains []TopologyDomainAssignment `json:"domains"`
+}
+
+type TopologyDomainAssignment struct {
+	// values are the values of the node labels in levels that identify the
+	// domain.
+	//
+	// +required
+	// +listType=atomic
+	Values []string `json:"values"`
+
+	// count is the number of pods assigned to the domain.
+	//
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	Count int32 `json:"count"`
 }
 
 type PodSet struct {
@@ -138,7 +210,9 @@ type WorkloadStatus struct {
 	//
 	// The type of the condition could be:
 	//
-	// - Admitted: the Workload was admitted through a ClusterQueue.
+	// - QuotaReserved: the Workload reserved quota in a ClusterQueue.
+	// - Admitted: the Workload reserved quota and all its admission checks
+	// are Ready.
 	// - Finished: the associated workload finished running (failed or succeeded).
 	// - PodsReady: at least `.spec.podSets[*].count` Pods are ready or have
 	// succeeded.
@@ -154,6 +228,106 @@ type WorkloadStatus struct {
 	// +listType=map
 	// +listMapKey=name
 	ReclaimablePods []ReclaimablePod `json:"reclaimablePods,omitempty"`
+
+	// admissionChecks list all the admission checks required by the workload and the current status
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +patchStrategy=merge
+	// +patchMergeKey=name
+	// +kubebuilder:validation:MaxItems=8
+	AdmissionChecks []AdmissionCheckState `json:"admissionChecks,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// requeueState holds the state of the requeuing of the workload after it
+	// was evicted for exceeding the PodsReady timeout.
+	//
+	// +optional
+	RequeueState *RequeueState `json:"requeueState,omitempty"`
+
+	// accumulatedPastExecutionTimeSeconds holds the total time, in seconds,
+	// the workload spent admitted before its previous evictions.
+	//
+	// +optional
+	AccumulatedPastExecutionTimeSeconds *int32 `json:"accumulatedPastExecutionTimeSeconds,omitempty"`
+
+	// preemptionDeadline is the time when the workload is evicted, after it
+	// was preempted with a grace period, if it's still running.
+	//
+	// +optional
+	PreemptionDeadline *metav1.Time `json:"preemptionDeadline,omitempty"`
+}
+
+type RequeueState struct {
+	// count records the number of times the workload was evicted for
+	// exceeding the PodsReady timeout. It's reset when the workload is
+	// deactivated.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Count *int32 `json:"count,omitempty"`
+
+	// requeueAt records the time when the workload will be queued again.
+	// Until then, the workload is kept out of the queues.
+	//
+	// +optional
+	RequeueAt *metav1.Time `json:"requeueAt,omitempty"`
+}
+
+type AdmissionCheckState struct {
+	// name identifies the admission check.
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=316
+	Name string `json:"name"`
+	// state of the admissionCheck, one of Pending, Ready, Retry, Rejected
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=Pending;Ready;Retry;Rejected
+	State CheckState `json:"state"`
+	// lastTransitionTime is the last time the condition transitioned from one status to another.
+	// This should be when the underlying condition changed.  If that is not known, then using the time when the API field changed is acceptable.
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+	// message is a human readable message indicating details about the transition.
+	// This may be an empty string.
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=32768
+	Message string `json:"message"`
+
+	// podSetUpdates contains a list of pod set modifications suggested by the
+	// admission check controller. They are applied to the pods of the
+	// workload when it starts.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=8
+	PodSetUpdates []PodSetUpdate `json:"podSetUpdates,omitempty"`
+}
+
+// PodSetUpdate contains a list of pod set modifications suggested by AdmissionChecks.
+// The modifications should be additive only - modifications of already existing keys
+// or having the same key provided by multiple AdmissionChecks is not allowed and will
+// result in failure during workload admission.
+type PodSetUpdate struct {
+	// name of the PodSet to modify. Should match one of the Workload's PodSets.
+	// +required
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// +optional
+	// +kubebuilder:validation:MaxProperties=8
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:MaxProperties=8
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// +optional
+	// +kubebuilder:validation:MaxProperties=8
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 }
 
 type ReclaimablePod struct {
@@ -166,9 +340,13 @@ type ReclaimablePod struct {
 }
 
 const (
-	// WorkloadAdmitted means that the Workload was admitted by a ClusterQueue.
+	// WorkloadAdmitted means that the Workload has reserved quota and all the admissionChecks
+	// defined in the ClusterQueue are satisfied.
 	WorkloadAdmitted = "Admitted"
 
+	// WorkloadQuotaReserved means that the Workload has reserved quota in a ClusterQueue.
+	WorkloadQuotaReserved = "QuotaReserved"
+
 	// WorkloadFinished means that the workload associated to the
 	// ResourceClaim finished running (failed or succeeded).
 	WorkloadFinished = "Finished"
@@ -179,6 +357,10 @@ const (
 
 	// WorkloadEvicted means that the Workload was evicted by a ClusterQueue
 	WorkloadEvicted = "Evicted"
+
+	// WorkloadPreemptionPending means that the Workload was preempted and is
+	// given a grace period to terminate, before it's evicted.
+	WorkloadPreemptionPending = "PreemptionPending"
 )
 
 const (
@@ -189,6 +371,26 @@ const (
 	// WorkloadEvictedByPodsReadyTimeout indicates that the eviction took
 	// place due to a PodsReady timeout.
 	WorkloadEvictedByPodsReadyTimeout = "PodsReadyTimeout"
+
+	// WorkloadEvictedByAdmissionCheck indicates that the workload was evicted
+	// because at least one admission check transitioned to Retry or Rejected.
+	WorkloadEvictedByAdmissionCheck = "AdmissionCheck"
+
+	// WorkloadEvictedByClusterQueueStopped indicates that the workload was evicted
+	// because the ClusterQueue is stopped with the HoldAndDrain policy.
+	WorkloadEvictedByClusterQueueStopped = "ClusterQueueStopped"
+
+	// WorkloadEvictedByLocalQueueStopped indicates that the workload was evicted
+	// because the LocalQueue is stopped with the HoldAndDrain policy.
+	WorkloadEvictedByLocalQueueStopped = "LocalQueueStopped"
+
+	// WorkloadEvictedByDeactivation indicates that the workload was evicted
+	// because spec.active was set to false.
+	WorkloadEvictedByDeactivation = "InactiveWorkload"
+
+	// WorkloadEvictedByMaximumExecutionTime indicates that the workload was
+	// evicted because it exceeded its maximum execution time.
+	WorkloadEvictedByMaximumExecutionTime = "MaximumExecutionTimeExceeded"
 )
 
 // +genclient
@@ -196,7 +398,8 @@ const (
 // +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Queue",JSONPath=".spec.queueName",type=string,description="Name of the queue this workload was submitted to"
-// +kubebuilder:printcolumn:name="Admitted by",JSONPath=".status.admission.clusterQueue",type=string,description="Name of the ClusterQueue that admitted this workload"
+// +kubebuilder:printcolumn:name="Reserved in",JSONPath=".status.admission.clusterQueue",type=string,description="Name of the ClusterQueue where the workload is reserving quota"
+// +kubebuilder:printcolumn:name="Admitted",JSONPath=".status.conditions[?(@.type=='Admitted')].status",type=string,description="Admission status"
 // +kubebuilder:printcolumn:name="Age",JSONPath=".metadata.creationTimestamp",type=date,description="Time this workload was created"
 // +kubebuilder:resource:shortName={wl}
 
@@ -49,6 +49,165 @@ func (in *Admission) DeepCopy() *Admission {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionCheck) DeepCopyInto(out *AdmissionCheck) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionCheck.
+func (in *AdmissionCheck) DeepCopy() *AdmissionCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AdmissionCheck) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionCheckList) DeepCopyInto(out *AdmissionCheckList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AdmissionCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionCheckList.
+func (in *AdmissionCheckList) DeepCopy() *AdmissionCheckList {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionCheckList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AdmissionCheckList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionCheckParametersReference) DeepCopyInto(out *AdmissionCheckParametersReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionCheckParametersReference.
+func (in *AdmissionCheckParametersReference) DeepCopy() *AdmissionCheckParametersReference {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionCheckParametersReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionCheckSpec) DeepCopyInto(out *AdmissionCheckSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = new(AdmissionCheckParametersReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionCheckSpec.
+func (in *AdmissionCheckSpec) DeepCopy() *AdmissionCheckSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionCheckSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionCheckState) DeepCopyInto(out *AdmissionCheckState) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	if in.PodSetUpdates != nil {
+		in, out := &in.PodSetUpdates, &out.PodSetUpdates
+		*out = make([]PodSetUpdate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionCheckState.
+func (in *AdmissionCheckState) DeepCopy() *AdmissionCheckState {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionCheckState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionCheckStatus) DeepCopyInto(out *AdmissionCheckStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionCheckStatus.
+func (in *AdmissionCheckStatus) DeepCopy() *AdmissionCheckStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionCheckStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BorrowWithinCohort) DeepCopyInto(out *BorrowWithinCohort) {
+	*out = *in
+	if in.MaxPriorityThreshold != nil {
+		in, out := &in.MaxPriorityThreshold, &out.MaxPriorityThreshold
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BorrowWithinCohort.
+func (in *BorrowWithinCohort) DeepCopy() *BorrowWithinCohort {
+	if in == nil {
+		return nil
+	}
+	out := new(BorrowWithinCohort)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterQueue) DeepCopyInto(out *ClusterQueue) {
 	*out = *in
@@ -111,6 +270,16 @@ func (in *ClusterQueueList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterQueuePreemption) DeepCopyInto(out *ClusterQueuePreemption) {
 	*out = *in
+	if in.BorrowWithinCohort != nil {
+		in, out := &in.BorrowWithinCohort, &out.BorrowWithinCohort
+		*out = new(BorrowWithinCohort)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GracePeriodSeconds != nil {
+		in, out := &in.GracePeriodSeconds, &out.GracePeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueuePreemption.
@@ -141,6 +310,26 @@ func (in *ClusterQueueSpec) DeepCopyInto(out *ClusterQueueSpec) {
 	if in.Preemption != nil {
 		in, out := &in.Preemption, &out.Preemption
 		*out = new(ClusterQueuePreemption)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FlavorFungibility != nil {
+		in, out := &in.FlavorFungibility, &out.FlavorFungibility
+		*out = new(FlavorFungibility)
+		**out = **in
+	}
+	if in.AdmissionChecks != nil {
+		in, out := &in.AdmissionChecks, &out.AdmissionChecks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FairSharing != nil {
+		in, out := &in.FairSharing, &out.FairSharing
+		*out = new(FairSharing)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StopPolicy != nil {
+		in, out := &in.StopPolicy, &out.StopPolicy
+		*out = new(StopPolicy)
 		**out = **in
 	}
 }
@@ -184,6 +373,121 @@ func (in *ClusterQueueStatus) DeepCopy() *ClusterQueueStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Cohort) DeepCopyInto(out *Cohort) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cohort.
+func (in *Cohort) DeepCopy() *Cohort {
+	if in == nil {
+		return nil
+	}
+	out := new(Cohort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Cohort) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CohortList) DeepCopyInto(out *CohortList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Cohort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CohortList.
+func (in *CohortList) DeepCopy() *CohortList {
+	if in == nil {
+		return nil
+	}
+	out := new(CohortList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CohortList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CohortSpec) DeepCopyInto(out *CohortSpec) {
+	*out = *in
+	if in.ResourceGroups != nil {
+		in, out := &in.ResourceGroups, &out.ResourceGroups
+		*out = make([]ResourceGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CohortSpec.
+func (in *CohortSpec) DeepCopy() *CohortSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CohortSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FairSharing) DeepCopyInto(out *FairSharing) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FairSharing.
+func (in *FairSharing) DeepCopy() *FairSharing {
+	if in == nil {
+		return nil
+	}
+	out := new(FairSharing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavorFungibility) DeepCopyInto(out *FlavorFungibility) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorFungibility.
+func (in *FlavorFungibility) DeepCopy() *FlavorFungibility {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavorFungibility)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FlavorQuotas) DeepCopyInto(out *FlavorQuotas) {
 	*out = *in
@@ -233,7 +537,7 @@ func (in *LocalQueue) DeepCopyInto(out *LocalQueue) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -255,6 +559,28 @@ func (in *LocalQueue) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalQueueFlavorLimit) DeepCopyInto(out *LocalQueueFlavorLimit) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]LocalQueueResourceLimit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalQueueFlavorLimit.
+func (in *LocalQueueFlavorLimit) DeepCopy() *LocalQueueFlavorLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalQueueFlavorLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalQueueFlavorUsage) DeepCopyInto(out *LocalQueueFlavorUsage) {
 	*out = *in
@@ -272,37 +598,365 @@ func (in *LocalQueueFlavorUsage) DeepCopy() *LocalQueueFlavorUsage {
 	if in == nil {
 		return nil
 	}
-	out := new(LocalQueueFlavorUsage)
+	out := new(LocalQueueFlavorUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalQueueList) DeepCopyInto(out *LocalQueueList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LocalQueue, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalQueueList.
+func (in *LocalQueueList) DeepCopy() *LocalQueueList {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalQueueList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LocalQueueList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalQueueResourceLimit) DeepCopyInto(out *LocalQueueResourceLimit) {
+	*out = *in
+	out.Max = in.Max.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalQueueResourceLimit.
+func (in *LocalQueueResourceLimit) DeepCopy() *LocalQueueResourceLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalQueueResourceLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalQueueResourceUsage) DeepCopyInto(out *LocalQueueResourceUsage) {
+	*out = *in
+	out.Total = in.Total.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalQueueResourceUsage.
+func (in *LocalQueueResourceUsage) DeepCopy() *LocalQueueResourceUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalQueueResourceUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalQueueSpec) DeepCopyInto(out *LocalQueueSpec) {
+	*out = *in
+	if in.FlavorLimits != nil {
+		in, out := &in.FlavorLimits, &out.FlavorLimits
+		*out = make([]LocalQueueFlavorLimit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StopPolicy != nil {
+		in, out := &in.StopPolicy, &out.StopPolicy
+		*out = new(StopPolicy)
+		**out = **in
+	}
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalQueueSpec.
+func (in *LocalQueueSpec) DeepCopy() *LocalQueueSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalQueueSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalQueueStatus) DeepCopyInto(out *LocalQueueStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FlavorUsage != nil {
+		in, out := &in.FlavorUsage, &out.FlavorUsage
+		*out = make([]LocalQueueFlavorUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalQueueStatus.
+func (in *LocalQueueStatus) DeepCopy() *LocalQueueStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalQueueStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSet) DeepCopyInto(out *PodSet) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+	if in.MinCount != nil {
+		in, out := &in.MinCount, &out.MinCount
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSet.
+func (in *PodSet) DeepCopy() *PodSet {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSetAssignment) DeepCopyInto(out *PodSetAssignment) {
+	*out = *in
+	if in.Flavors != nil {
+		in, out := &in.Flavors, &out.Flavors
+		*out = make(map[corev1.ResourceName]ResourceFlavorReference, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ResourceUsage != nil {
+		in, out := &in.ResourceUsage, &out.ResourceUsage
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TopologyAssignment != nil {
+		in, out := &in.TopologyAssignment, &out.TopologyAssignment
+		*out = new(TopologyAssignment)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSetAssignment.
+func (in *PodSetAssignment) DeepCopy() *PodSetAssignment {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSetAssignment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSetUpdate) DeepCopyInto(out *PodSetUpdate) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSetUpdate.
+func (in *PodSetUpdate) DeepCopy() *PodSetUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSetUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisioningRequest) DeepCopyInto(out *ProvisioningRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningRequest.
+func (in *ProvisioningRequest) DeepCopy() *ProvisioningRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProvisioningRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisioningRequestConfig) DeepCopyInto(out *ProvisioningRequestConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningRequestConfig.
+func (in *ProvisioningRequestConfig) DeepCopy() *ProvisioningRequestConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningRequestConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProvisioningRequestConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisioningRequestConfigList) DeepCopyInto(out *ProvisioningRequestConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProvisioningRequestConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningRequestConfigList.
+func (in *ProvisioningRequestConfigList) DeepCopy() *ProvisioningRequestConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningRequestConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProvisioningRequestConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisioningRequestConfigSpec) DeepCopyInto(out *ProvisioningRequestConfigSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningRequestConfigSpec.
+func (in *ProvisioningRequestConfigSpec) DeepCopy() *ProvisioningRequestConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningRequestConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LocalQueueList) DeepCopyInto(out *LocalQueueList) {
+func (in *ProvisioningRequestList) DeepCopyInto(out *ProvisioningRequestList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]LocalQueue, len(*in))
+		*out = make([]ProvisioningRequest, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalQueueList.
-func (in *LocalQueueList) DeepCopy() *LocalQueueList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningRequestList.
+func (in *ProvisioningRequestList) DeepCopy() *ProvisioningRequestList {
 	if in == nil {
 		return nil
 	}
-	out := new(LocalQueueList)
+	out := new(ProvisioningRequestList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LocalQueueList) DeepCopyObject() runtime.Object {
+func (in *ProvisioningRequestList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -310,38 +964,52 @@ func (in *LocalQueueList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LocalQueueResourceUsage) DeepCopyInto(out *LocalQueueResourceUsage) {
+func (in *ProvisioningRequestPodSet) DeepCopyInto(out *ProvisioningRequestPodSet) {
 	*out = *in
-	out.Total = in.Total.DeepCopy()
+	in.Template.DeepCopyInto(&out.Template)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalQueueResourceUsage.
-func (in *LocalQueueResourceUsage) DeepCopy() *LocalQueueResourceUsage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningRequestPodSet.
+func (in *ProvisioningRequestPodSet) DeepCopy() *ProvisioningRequestPodSet {
 	if in == nil {
 		return nil
 	}
-	out := new(LocalQueueResourceUsage)
+	out := new(ProvisioningRequestPodSet)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LocalQueueSpec) DeepCopyInto(out *LocalQueueSpec) {
+func (in *ProvisioningRequestSpec) DeepCopyInto(out *ProvisioningRequestSpec) {
 	*out = *in
+	if in.PodSets != nil {
+		in, out := &in.PodSets, &out.PodSets
+		*out = make([]ProvisioningRequestPodSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalQueueSpec.
-func (in *LocalQueueSpec) DeepCopy() *LocalQueueSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningRequestSpec.
+func (in *ProvisioningRequestSpec) DeepCopy() *ProvisioningRequestSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LocalQueueSpec)
+	out := new(ProvisioningRequestSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LocalQueueStatus) DeepCopyInto(out *LocalQueueStatus) {
+func (in *ProvisioningRequestStatus) DeepCopyInto(out *ProvisioningRequestStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -350,91 +1018,60 @@ func (in *LocalQueueStatus) DeepCopyInto(out *LocalQueueStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.FlavorUsage != nil {
-		in, out := &in.FlavorUsage, &out.FlavorUsage
-		*out = make([]LocalQueueFlavorUsage, len(*in))
+	if in.PodSetUpdates != nil {
+		in, out := &in.PodSetUpdates, &out.PodSetUpdates
+		*out = make([]PodSetUpdate, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalQueueStatus.
-func (in *LocalQueueStatus) DeepCopy() *LocalQueueStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningRequestStatus.
+func (in *ProvisioningRequestStatus) DeepCopy() *ProvisioningRequestStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(LocalQueueStatus)
+	out := new(ProvisioningRequestStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PodSet) DeepCopyInto(out *PodSet) {
+func (in *ReclaimablePod) DeepCopyInto(out *ReclaimablePod) {
 	*out = *in
-	in.Template.DeepCopyInto(&out.Template)
-	if in.MinCount != nil {
-		in, out := &in.MinCount, &out.MinCount
-		*out = new(int32)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSet.
-func (in *PodSet) DeepCopy() *PodSet {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReclaimablePod.
+func (in *ReclaimablePod) DeepCopy() *ReclaimablePod {
 	if in == nil {
 		return nil
 	}
-	out := new(PodSet)
+	out := new(ReclaimablePod)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PodSetAssignment) DeepCopyInto(out *PodSetAssignment) {
+func (in *RequeueState) DeepCopyInto(out *RequeueState) {
 	*out = *in
-	if in.Flavors != nil {
-		in, out := &in.Flavors, &out.Flavors
-		*out = make(map[corev1.ResourceName]ResourceFlavorReference, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.ResourceUsage != nil {
-		in, out := &in.ResourceUsage, &out.ResourceUsage
-		*out = make(corev1.ResourceList, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val.DeepCopy()
-		}
-	}
 	if in.Count != nil {
 		in, out := &in.Count, &out.Count
 		*out = new(int32)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSetAssignment.
-func (in *PodSetAssignment) DeepCopy() *PodSetAssignment {
-	if in == nil {
-		return nil
+	if in.RequeueAt != nil {
+		in, out := &in.RequeueAt, &out.RequeueAt
+		*out = (*in).DeepCopy()
 	}
-	out := new(PodSetAssignment)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReclaimablePod) DeepCopyInto(out *ReclaimablePod) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReclaimablePod.
-func (in *ReclaimablePod) DeepCopy() *ReclaimablePod {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequeueState.
+func (in *RequeueState) DeepCopy() *RequeueState {
 	if in == nil {
 		return nil
 	}
-	out := new(ReclaimablePod)
+	out := new(RequeueState)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -514,6 +1151,18 @@ func (in *ResourceFlavorSpec) DeepCopyInto(out *ResourceFlavorSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TopologyName != nil {
+		in, out := &in.TopologyName, &out.TopologyName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceFlavorSpec.
@@ -562,6 +1211,11 @@ func (in *ResourceQuota) DeepCopyInto(out *ResourceQuota) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.LendingLimit != nil {
+		in, out := &in.LendingLimit, &out.LendingLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuota.
@@ -579,6 +1233,7 @@ func (in *ResourceUsage) DeepCopyInto(out *ResourceUsage) {
 	*out = *in
 	out.Total = in.Total.DeepCopy()
 	out.Borrowed = in.Borrowed.DeepCopy()
+	out.Lent = in.Lent.DeepCopy()
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceUsage.
@@ -591,6 +1246,146 @@ func (in *ResourceUsage) DeepCopy() *ResourceUsage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Topology) DeepCopyInto(out *Topology) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Topology.
+func (in *Topology) DeepCopy() *Topology {
+	if in == nil {
+		return nil
+	}
+	out := new(Topology)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Topology) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyAssignment) DeepCopyInto(out *TopologyAssignment) {
+	*out = *in
+	if in.Levels != nil {
+		in, out := &in.Levels, &out.Levels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]TopologyDomainAssignment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyAssignment.
+func (in *TopologyAssignment) DeepCopy() *TopologyAssignment {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyAssignment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyDomainAssignment) DeepCopyInto(out *TopologyDomainAssignment) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyDomainAssignment.
+func (in *TopologyDomainAssignment) DeepCopy() *TopologyDomainAssignment {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyDomainAssignment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyLevel) DeepCopyInto(out *TopologyLevel) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyLevel.
+func (in *TopologyLevel) DeepCopy() *TopologyLevel {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyLevel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyList) DeepCopyInto(out *TopologyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Topology, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyList.
+func (in *TopologyList) DeepCopy() *TopologyList {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TopologyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologySpec) DeepCopyInto(out *TopologySpec) {
+	*out = *in
+	if in.Levels != nil {
+		in, out := &in.Levels, &out.Levels
+		*out = make([]TopologyLevel, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologySpec.
+func (in *TopologySpec) DeepCopy() *TopologySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Workload) DeepCopyInto(out *Workload) {
 	*out = *in
@@ -650,6 +1445,63 @@ func (in *WorkloadList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadPriorityClass) DeepCopyInto(out *WorkloadPriorityClass) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadPriorityClass.
+func (in *WorkloadPriorityClass) DeepCopy() *WorkloadPriorityClass {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadPriorityClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkloadPriorityClass) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadPriorityClassList) DeepCopyInto(out *WorkloadPriorityClassList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkloadPriorityClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadPriorityClassList.
+func (in *WorkloadPriorityClassList) DeepCopy() *WorkloadPriorityClassList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadPriorityClassList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkloadPriorityClassList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadSpec) DeepCopyInto(out *WorkloadSpec) {
 	*out = *in
@@ -665,6 +1517,21 @@ func (in *WorkloadSpec) DeepCopyInto(out *WorkloadSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaximumExecutionTimeSeconds != nil {
+		in, out := &in.MaximumExecutionTimeSeconds, &out.MaximumExecutionTimeSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PreemptionGracePeriodSeconds != nil {
+		in, out := &in.PreemptionGracePeriodSeconds, &out.PreemptionGracePeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadSpec.
@@ -697,6 +1564,27 @@ func (in *WorkloadStatus) DeepCopyInto(out *WorkloadStatus) {
 		*out = make([]ReclaimablePod, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdmissionChecks != nil {
+		in, out := &in.AdmissionChecks, &out.AdmissionChecks
+		*out = make([]AdmissionCheckState, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RequeueState != nil {
+		in, out := &in.RequeueState, &out.RequeueState
+		*out = new(RequeueState)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccumulatedPastExecutionTimeSeconds != nil {
+		in, out := &in.AccumulatedPastExecutionTimeSeconds, &out.AccumulatedPastExecutionTimeSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PreemptionDeadline != nil {
+		in, out := &in.PreemptionDeadline, &out.PreemptionDeadline
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadStatus.
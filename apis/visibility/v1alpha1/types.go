@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+// PendingWorkload is a pending workload, with its positions in the queues.
+type PendingWorkload struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Priority is the priority of the workload.
+	Priority int32 `json:"priority"`
+
+	// LocalQueueName is the name of the LocalQueue the workload is submitted
+	// to.
+	LocalQueueName string `json:"localQueueName"`
+
+	// PositionInClusterQueue is the position of the workload in its
+	// ClusterQueue, starting from 0.
+	PositionInClusterQueue int32 `json:"positionInClusterQueue"`
+
+	// PositionInLocalQueue is the position of the workload in its
+	// LocalQueue, starting from 0.
+	PositionInLocalQueue int32 `json:"positionInLocalQueue"`
+}
+
+// +kubebuilder:object:root=true
+
+// PendingWorkloadsSummary holds the pending workloads of a ClusterQueue or a
+// LocalQueue, in the order in which they are evaluated for admission.
+type PendingWorkloadsSummary struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Items []PendingWorkload `json:"items"`
+}
+
+// PreemptionTarget is an admitted workload that would be preempted.
+type PreemptionTarget struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Priority is the priority of the workload.
+	Priority int32 `json:"priority"`
+
+	// ClusterQueueName is the name of the ClusterQueue that admitted the
+	// workload.
+	ClusterQueueName string `json:"clusterQueueName"`
+}
+
+// +kubebuilder:object:root=true
+
+// DryRunResult holds the outcome of evaluating the admission of a workload
+// against the current state of the cluster, without creating the workload.
+type DryRunResult struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// ClusterQueueName is the name of the ClusterQueue of the LocalQueue the
+	// workload would be submitted to.
+	ClusterQueueName string `json:"clusterQueueName"`
+
+	// Mode is the outcome of the flavor assignment: Fit, Preempt or NoFit.
+	Mode string `json:"mode"`
+
+	// Borrowing indicates whether the workload would borrow quota from the
+	// cohort.
+	Borrowing bool `json:"borrowing"`
+
+	// PodSetAssignments are the flavors that would be assigned to the pod
+	// sets of the workload.
+	PodSetAssignments []kueue.PodSetAssignment `json:"podSetAssignments,omitempty"`
+
+	// PreemptionTargets are the workloads that would be preempted to admit
+	// the workload.
+	PreemptionTargets []PreemptionTarget `json:"preemptionTargets,omitempty"`
+
+	// PendingReasons are the reasons why the workload would stay pending, if
+	// it can't be admitted.
+	PendingReasons []string `json:"pendingReasons,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PendingWorkloadsSummary{}, &DryRunResult{})
+}
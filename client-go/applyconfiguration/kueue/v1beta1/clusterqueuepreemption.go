@@ -24,8 +24,10 @@ import (
 // ClusterQueuePreemptionApplyConfiguration represents an declarative configuration of the ClusterQueuePreemption type for use
 // with apply.
 type ClusterQueuePreemptionApplyConfiguration struct {
-	ReclaimWithinCohort *v1beta1.PreemptionPolicy `json:"reclaimWithinCohort,omitempty"`
-	WithinClusterQueue  *v1beta1.PreemptionPolicy `json:"withinClusterQueue,omitempty"`
+	ReclaimWithinCohort *v1beta1.PreemptionPolicy             `json:"reclaimWithinCohort,omitempty"`
+	WithinClusterQueue  *v1beta1.PreemptionPolicy             `json:"withinClusterQueue,omitempty"`
+	BorrowWithinCohort  *BorrowWithinCohortApplyConfiguration `json:"borrowWithinCohort,omitempty"`
+	GracePeriodSeconds  *int32                                `json:"gracePeriodSeconds,omitempty"`
 }
 
 // ClusterQueuePreemptionApplyConfiguration constructs an declarative configuration of the ClusterQueuePreemption type for use with
@@ -49,3 +51,19 @@ func (b *ClusterQueuePreemptionApplyConfiguration) WithWithinClusterQueue(value
 	b.WithinClusterQueue = &value
 	return b
 }
+
+// WithBorrowWithinCohort sets the BorrowWithinCohort field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BorrowWithinCohort field is set to the value of the last call.
+func (b *ClusterQueuePreemptionApplyConfiguration) WithBorrowWithinCohort(value *BorrowWithinCohortApplyConfiguration) *ClusterQueuePreemptionApplyConfiguration {
+	b.BorrowWithinCohort = value
+	return b
+}
+
+// WithGracePeriodSeconds sets the GracePeriodSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GracePeriodSeconds field is set to the value of the last call.
+func (b *ClusterQueuePreemptionApplyConfiguration) WithGracePeriodSeconds(value int32) *ClusterQueuePreemptionApplyConfiguration {
+	b.GracePeriodSeconds = &value
+	return b
+}
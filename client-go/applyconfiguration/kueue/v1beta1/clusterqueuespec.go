@@ -30,6 +30,10 @@ type ClusterQueueSpecApplyConfiguration struct {
 	QueueingStrategy  *kueuev1beta1.QueueingStrategy            `json:"queueingStrategy,omitempty"`
 	NamespaceSelector *v1.LabelSelector                         `json:"namespaceSelector,omitempty"`
 	Preemption        *ClusterQueuePreemptionApplyConfiguration `json:"preemption,omitempty"`
+	FlavorFungibility *FlavorFungibilityApplyConfiguration      `json:"flavorFungibility,omitempty"`
+	AdmissionChecks   []string                                  `json:"admissionChecks,omitempty"`
+	FairSharing       *FairSharingApplyConfiguration            `json:"fairSharing,omitempty"`
+	StopPolicy        *kueuev1beta1.StopPolicy                  `json:"stopPolicy,omitempty"`
 }
 
 // ClusterQueueSpecApplyConfiguration constructs an declarative configuration of the ClusterQueueSpec type for use with
@@ -82,3 +86,37 @@ func (b *ClusterQueueSpecApplyConfiguration) WithPreemption(value *ClusterQueueP
 	b.Preemption = value
 	return b
 }
+
+// WithFlavorFungibility sets the FlavorFungibility field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FlavorFungibility field is set to the value of the last call.
+func (b *ClusterQueueSpecApplyConfiguration) WithFlavorFungibility(value *FlavorFungibilityApplyConfiguration) *ClusterQueueSpecApplyConfiguration {
+	b.FlavorFungibility = value
+	return b
+}
+
+// WithAdmissionChecks adds the given value to the AdmissionChecks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AdmissionChecks field.
+func (b *ClusterQueueSpecApplyConfiguration) WithAdmissionChecks(values ...string) *ClusterQueueSpecApplyConfiguration {
+	for i := range values {
+		b.AdmissionChecks = append(b.AdmissionChecks, values[i])
+	}
+	return b
+}
+
+// WithFairSharing sets the FairSharing field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FairSharing field is set to the value of the last call.
+func (b *ClusterQueueSpecApplyConfiguration) WithFairSharing(value *FairSharingApplyConfiguration) *ClusterQueueSpecApplyConfiguration {
+	b.FairSharing = value
+	return b
+}
+
+// WithStopPolicy sets the StopPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StopPolicy field is set to the value of the last call.
+func (b *ClusterQueueSpecApplyConfiguration) WithStopPolicy(value kueuev1beta1.StopPolicy) *ClusterQueueSpecApplyConfiguration {
+	b.StopPolicy = &value
+	return b
+}
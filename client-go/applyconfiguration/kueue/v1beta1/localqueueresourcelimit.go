@@ -0,0 +1,52 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+// LocalQueueResourceLimitApplyConfiguration represents an declarative configuration of the LocalQueueResourceLimit type for use
+// with apply.
+type LocalQueueResourceLimitApplyConfiguration struct {
+	Name *v1.ResourceName   `json:"name,omitempty"`
+	Max  *resource.Quantity `json:"max,omitempty"`
+}
+
+// LocalQueueResourceLimitApplyConfiguration constructs an declarative configuration of the LocalQueueResourceLimit type for use with
+// apply.
+func LocalQueueResourceLimit() *LocalQueueResourceLimitApplyConfiguration {
+	return &LocalQueueResourceLimitApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *LocalQueueResourceLimitApplyConfiguration) WithName(value v1.ResourceName) *LocalQueueResourceLimitApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithMax sets the Max field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Max field is set to the value of the last call.
+func (b *LocalQueueResourceLimitApplyConfiguration) WithMax(value resource.Quantity) *LocalQueueResourceLimitApplyConfiguration {
+	b.Max = &value
+	return b
+}
@@ -24,7 +24,10 @@ import (
 // LocalQueueSpecApplyConfiguration represents an declarative configuration of the LocalQueueSpec type for use
 // with apply.
 type LocalQueueSpecApplyConfiguration struct {
-	ClusterQueue *v1beta1.ClusterQueueReference `json:"clusterQueue,omitempty"`
+	ClusterQueue *v1beta1.ClusterQueueReference            `json:"clusterQueue,omitempty"`
+	FlavorLimits []LocalQueueFlavorLimitApplyConfiguration `json:"flavorLimits,omitempty"`
+	StopPolicy   *v1beta1.StopPolicy                       `json:"stopPolicy,omitempty"`
+	Weight       *int32                                    `json:"weight,omitempty"`
 }
 
 // LocalQueueSpecApplyConfiguration constructs an declarative configuration of the LocalQueueSpec type for use with
@@ -40,3 +43,32 @@ func (b *LocalQueueSpecApplyConfiguration) WithClusterQueue(value v1beta1.Cluste
 	b.ClusterQueue = &value
 	return b
 }
+
+// WithFlavorLimits adds the given value to the FlavorLimits field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the FlavorLimits field.
+func (b *LocalQueueSpecApplyConfiguration) WithFlavorLimits(values ...*LocalQueueFlavorLimitApplyConfiguration) *LocalQueueSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithFlavorLimits")
+		}
+		b.FlavorLimits = append(b.FlavorLimits, *values[i])
+	}
+	return b
+}
+
+// WithStopPolicy sets the StopPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StopPolicy field is set to the value of the last call.
+func (b *LocalQueueSpecApplyConfiguration) WithStopPolicy(value v1beta1.StopPolicy) *LocalQueueSpecApplyConfiguration {
+	b.StopPolicy = &value
+	return b
+}
+
+// WithWeight sets the Weight field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Weight field is set to the value of the last call.
+func (b *LocalQueueSpecApplyConfiguration) WithWeight(value int32) *LocalQueueSpecApplyConfiguration {
+	b.Weight = &value
+	return b
+}
@@ -25,10 +25,11 @@ import (
 // PodSetAssignmentApplyConfiguration represents an declarative configuration of the PodSetAssignment type for use
 // with apply.
 type PodSetAssignmentApplyConfiguration struct {
-	Name          *string                                             `json:"name,omitempty"`
-	Flavors       map[v1.ResourceName]v1beta1.ResourceFlavorReference `json:"flavors,omitempty"`
-	ResourceUsage *v1.ResourceList                                    `json:"resourceUsage,omitempty"`
-	Count         *int32                                              `json:"count,omitempty"`
+	Name               *string                                             `json:"name,omitempty"`
+	Flavors            map[v1.ResourceName]v1beta1.ResourceFlavorReference `json:"flavors,omitempty"`
+	ResourceUsage      *v1.ResourceList                                    `json:"resourceUsage,omitempty"`
+	Count              *int32                                              `json:"count,omitempty"`
+	TopologyAssignment *TopologyAssignmentApplyConfiguration               `json:"topologyAssignment,omitempty"`
 }
 
 // PodSetAssignmentApplyConfiguration constructs an declarative configuration of the PodSetAssignment type for use with
@@ -74,3 +75,11 @@ func (b *PodSetAssignmentApplyConfiguration) WithCount(value int32) *PodSetAssig
 	b.Count = &value
 	return b
 }
+
+// WithTopologyAssignment sets the TopologyAssignment field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TopologyAssignment field is set to the value of the last call.
+func (b *PodSetAssignmentApplyConfiguration) WithTopologyAssignment(value *TopologyAssignmentApplyConfiguration) *PodSetAssignmentApplyConfiguration {
+	b.TopologyAssignment = value
+	return b
+}
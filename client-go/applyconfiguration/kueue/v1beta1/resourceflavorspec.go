@@ -24,8 +24,10 @@ import (
 // ResourceFlavorSpecApplyConfiguration represents an declarative configuration of the ResourceFlavorSpec type for use
 // with apply.
 type ResourceFlavorSpecApplyConfiguration struct {
-	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
-	NodeTaints []v1.Taint        `json:"nodeTaints,omitempty"`
+	NodeLabels   map[string]string `json:"nodeLabels,omitempty"`
+	NodeTaints   []v1.Taint        `json:"nodeTaints,omitempty"`
+	Tolerations  []v1.Toleration   `json:"tolerations,omitempty"`
+	TopologyName *string           `json:"topologyName,omitempty"`
 }
 
 // ResourceFlavorSpecApplyConfiguration constructs an declarative configuration of the ResourceFlavorSpec type for use with
@@ -57,3 +59,21 @@ func (b *ResourceFlavorSpecApplyConfiguration) WithNodeTaints(values ...v1.Taint
 	}
 	return b
 }
+
+// WithTolerations adds the given value to the Tolerations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Tolerations field.
+func (b *ResourceFlavorSpecApplyConfiguration) WithTolerations(values ...v1.Toleration) *ResourceFlavorSpecApplyConfiguration {
+	for i := range values {
+		b.Tolerations = append(b.Tolerations, values[i])
+	}
+	return b
+}
+
+// WithTopologyName sets the TopologyName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TopologyName field is set to the value of the last call.
+func (b *ResourceFlavorSpecApplyConfiguration) WithTopologyName(value string) *ResourceFlavorSpecApplyConfiguration {
+	b.TopologyName = &value
+	return b
+}
@@ -28,6 +28,7 @@ type ResourceUsageApplyConfiguration struct {
 	Name     *v1.ResourceName   `json:"name,omitempty"`
 	Total    *resource.Quantity `json:"total,omitempty"`
 	Borrowed *resource.Quantity `json:"borrowed,omitempty"`
+	Lent     *resource.Quantity `json:"lent,omitempty"`
 }
 
 // ResourceUsageApplyConfiguration constructs an declarative configuration of the ResourceUsage type for use with
@@ -59,3 +60,11 @@ func (b *ResourceUsageApplyConfiguration) WithBorrowed(value resource.Quantity)
 	b.Borrowed = &value
 	return b
 }
+
+// WithLent sets the Lent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Lent field is set to the value of the last call.
+func (b *ResourceUsageApplyConfiguration) WithLent(value resource.Quantity) *ResourceUsageApplyConfiguration {
+	b.Lent = &value
+	return b
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// TopologyDomainAssignmentApplyConfiguration represents an declarative configuration of the TopologyDomainAssignment type for use
+// with apply.
+type TopologyDomainAssignmentApplyConfiguration struct {
+	Values []string `json:"values,omitempty"`
+	Count  *int32   `json:"count,omitempty"`
+}
+
+// TopologyDomainAssignmentApplyConfiguration constructs an declarative configuration of the TopologyDomainAssignment type for use with
+// apply.
+func TopologyDomainAssignment() *TopologyDomainAssignmentApplyConfiguration {
+	return &TopologyDomainAssignmentApplyConfiguration{}
+}
+
+// WithValues adds the given value to the Values field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Values field.
+func (b *TopologyDomainAssignmentApplyConfiguration) WithValues(values ...string) *TopologyDomainAssignmentApplyConfiguration {
+	for i := range values {
+		b.Values = append(b.Values, values[i])
+	}
+	return b
+}
+
+// WithCount sets the Count field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Count field is set to the value of the last call.
+func (b *TopologyDomainAssignmentApplyConfiguration) WithCount(value int32) *TopologyDomainAssignmentApplyConfiguration {
+	b.Count = &value
+	return b
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// TopologySpecApplyConfiguration represents an declarative configuration of the TopologySpec type for use
+// with apply.
+type TopologySpecApplyConfiguration struct {
+	Levels []TopologyLevelApplyConfiguration `json:"levels,omitempty"`
+}
+
+// TopologySpecApplyConfiguration constructs an declarative configuration of the TopologySpec type for use with
+// apply.
+func TopologySpec() *TopologySpecApplyConfiguration {
+	return &TopologySpecApplyConfiguration{}
+}
+
+// WithLevels adds the given value to the Levels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Levels field.
+func (b *TopologySpecApplyConfiguration) WithLevels(values ...*TopologyLevelApplyConfiguration) *TopologySpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithLevels")
+		}
+		b.Levels = append(b.Levels, *values[i])
+	}
+	return b
+}
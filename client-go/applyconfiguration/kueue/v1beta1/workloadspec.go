@@ -20,10 +20,14 @@ package v1beta1
 // WorkloadSpecApplyConfiguration represents an declarative configuration of the WorkloadSpec type for use
 // with apply.
 type WorkloadSpecApplyConfiguration struct {
-	PodSets           []PodSetApplyConfiguration `json:"podSets,omitempty"`
-	QueueName         *string                    `json:"queueName,omitempty"`
-	PriorityClassName *string                    `json:"priorityClassName,omitempty"`
-	Priority          *int32                     `json:"priority,omitempty"`
+	PodSets                      []PodSetApplyConfiguration `json:"podSets,omitempty"`
+	QueueName                    *string                    `json:"queueName,omitempty"`
+	PriorityClassName            *string                    `json:"priorityClassName,omitempty"`
+	Priority                     *int32                     `json:"priority,omitempty"`
+	PriorityClassSource          *string                    `json:"priorityClassSource,omitempty"`
+	Active                       *bool                      `json:"active,omitempty"`
+	MaximumExecutionTimeSeconds  *int32                     `json:"maximumExecutionTimeSeconds,omitempty"`
+	PreemptionGracePeriodSeconds *int32                     `json:"preemptionGracePeriodSeconds,omitempty"`
 }
 
 // WorkloadSpecApplyConfiguration constructs an declarative configuration of the WorkloadSpec type for use with
@@ -68,3 +72,35 @@ func (b *WorkloadSpecApplyConfiguration) WithPriority(value int32) *WorkloadSpec
 	b.Priority = &value
 	return b
 }
+
+// WithPriorityClassSource sets the PriorityClassSource field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PriorityClassSource field is set to the value of the last call.
+func (b *WorkloadSpecApplyConfiguration) WithPriorityClassSource(value string) *WorkloadSpecApplyConfiguration {
+	b.PriorityClassSource = &value
+	return b
+}
+
+// WithActive sets the Active field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Active field is set to the value of the last call.
+func (b *WorkloadSpecApplyConfiguration) WithActive(value bool) *WorkloadSpecApplyConfiguration {
+	b.Active = &value
+	return b
+}
+
+// WithMaximumExecutionTimeSeconds sets the MaximumExecutionTimeSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaximumExecutionTimeSeconds field is set to the value of the last call.
+func (b *WorkloadSpecApplyConfiguration) WithMaximumExecutionTimeSeconds(value int32) *WorkloadSpecApplyConfiguration {
+	b.MaximumExecutionTimeSeconds = &value
+	return b
+}
+
+// WithPreemptionGracePeriodSeconds sets the PreemptionGracePeriodSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreemptionGracePeriodSeconds field is set to the value of the last call.
+func (b *WorkloadSpecApplyConfiguration) WithPreemptionGracePeriodSeconds(value int32) *WorkloadSpecApplyConfiguration {
+	b.PreemptionGracePeriodSeconds = &value
+	return b
+}
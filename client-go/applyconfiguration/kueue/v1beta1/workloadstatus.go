@@ -24,9 +24,13 @@ import (
 // WorkloadStatusApplyConfiguration represents an declarative configuration of the WorkloadStatus type for use
 // with apply.
 type WorkloadStatusApplyConfiguration struct {
-	Admission       *AdmissionApplyConfiguration       `json:"admission,omitempty"`
-	Conditions      []v1.Condition                     `json:"conditions,omitempty"`
-	ReclaimablePods []ReclaimablePodApplyConfiguration `json:"reclaimablePods,omitempty"`
+	Admission                           *AdmissionApplyConfiguration            `json:"admission,omitempty"`
+	Conditions                          []v1.Condition                          `json:"conditions,omitempty"`
+	ReclaimablePods                     []ReclaimablePodApplyConfiguration      `json:"reclaimablePods,omitempty"`
+	AdmissionChecks                     []AdmissionCheckStateApplyConfiguration `json:"admissionChecks,omitempty"`
+	RequeueState                        *RequeueStateApplyConfiguration         `json:"requeueState,omitempty"`
+	AccumulatedPastExecutionTimeSeconds *int32                                  `json:"accumulatedPastExecutionTimeSeconds,omitempty"`
+	PreemptionDeadline                  *v1.Time                                `json:"preemptionDeadline,omitempty"`
 }
 
 // WorkloadStatusApplyConfiguration constructs an declarative configuration of the WorkloadStatus type for use with
@@ -65,3 +69,40 @@ func (b *WorkloadStatusApplyConfiguration) WithReclaimablePods(values ...*Reclai
 	}
 	return b
 }
+
+// WithAdmissionChecks adds the given value to the AdmissionChecks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AdmissionChecks field.
+func (b *WorkloadStatusApplyConfiguration) WithAdmissionChecks(values ...*AdmissionCheckStateApplyConfiguration) *WorkloadStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAdmissionChecks")
+		}
+		b.AdmissionChecks = append(b.AdmissionChecks, *values[i])
+	}
+	return b
+}
+
+// WithRequeueState sets the RequeueState field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequeueState field is set to the value of the last call.
+func (b *WorkloadStatusApplyConfiguration) WithRequeueState(value *RequeueStateApplyConfiguration) *WorkloadStatusApplyConfiguration {
+	b.RequeueState = value
+	return b
+}
+
+// WithAccumulatedPastExecutionTimeSeconds sets the AccumulatedPastExecutionTimeSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AccumulatedPastExecutionTimeSeconds field is set to the value of the last call.
+func (b *WorkloadStatusApplyConfiguration) WithAccumulatedPastExecutionTimeSeconds(value int32) *WorkloadStatusApplyConfiguration {
+	b.AccumulatedPastExecutionTimeSeconds = &value
+	return b
+}
+
+// WithPreemptionDeadline sets the PreemptionDeadline field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreemptionDeadline field is set to the value of the last call.
+func (b *WorkloadStatusApplyConfiguration) WithPreemptionDeadline(value v1.Time) *WorkloadStatusApplyConfiguration {
+	b.PreemptionDeadline = &value
+	return b
+}
@@ -30,6 +30,18 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 	// Group=kueue.x-k8s.io, Version=v1beta1
 	case v1beta1.SchemeGroupVersion.WithKind("Admission"):
 		return &kueuev1beta1.AdmissionApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("AdmissionCheck"):
+		return &kueuev1beta1.AdmissionCheckApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("AdmissionCheckParametersReference"):
+		return &kueuev1beta1.AdmissionCheckParametersReferenceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("AdmissionCheckSpec"):
+		return &kueuev1beta1.AdmissionCheckSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("AdmissionCheckState"):
+		return &kueuev1beta1.AdmissionCheckStateApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("AdmissionCheckStatus"):
+		return &kueuev1beta1.AdmissionCheckStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("BorrowWithinCohort"):
+		return &kueuev1beta1.BorrowWithinCohortApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ClusterQueue"):
 		return &kueuev1beta1.ClusterQueueApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ClusterQueuePreemption"):
@@ -38,14 +50,26 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta1.ClusterQueueSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ClusterQueueStatus"):
 		return &kueuev1beta1.ClusterQueueStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Cohort"):
+		return &kueuev1beta1.CohortApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CohortSpec"):
+		return &kueuev1beta1.CohortSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("FairSharing"):
+		return &kueuev1beta1.FairSharingApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("FlavorFungibility"):
+		return &kueuev1beta1.FlavorFungibilityApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("FlavorQuotas"):
 		return &kueuev1beta1.FlavorQuotasApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("FlavorUsage"):
 		return &kueuev1beta1.FlavorUsageApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("LocalQueue"):
 		return &kueuev1beta1.LocalQueueApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("LocalQueueFlavorLimit"):
+		return &kueuev1beta1.LocalQueueFlavorLimitApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("LocalQueueFlavorUsage"):
 		return &kueuev1beta1.LocalQueueFlavorUsageApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("LocalQueueResourceLimit"):
+		return &kueuev1beta1.LocalQueueResourceLimitApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("LocalQueueResourceUsage"):
 		return &kueuev1beta1.LocalQueueResourceUsageApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("LocalQueueSpec"):
@@ -56,8 +80,24 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta1.PodSetApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("PodSetAssignment"):
 		return &kueuev1beta1.PodSetAssignmentApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PodSetUpdate"):
+		return &kueuev1beta1.PodSetUpdateApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ProvisioningRequest"):
+		return &kueuev1beta1.ProvisioningRequestApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ProvisioningRequestConfig"):
+		return &kueuev1beta1.ProvisioningRequestConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ProvisioningRequestConfigSpec"):
+		return &kueuev1beta1.ProvisioningRequestConfigSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ProvisioningRequestPodSet"):
+		return &kueuev1beta1.ProvisioningRequestPodSetApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ProvisioningRequestSpec"):
+		return &kueuev1beta1.ProvisioningRequestSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ProvisioningRequestStatus"):
+		return &kueuev1beta1.ProvisioningRequestStatusApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ReclaimablePod"):
 		return &kueuev1beta1.ReclaimablePodApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RequeueState"):
+		return &kueuev1beta1.RequeueStateApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ResourceFlavor"):
 		return &kueuev1beta1.ResourceFlavorApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ResourceFlavorSpec"):
@@ -68,8 +108,20 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta1.ResourceQuotaApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ResourceUsage"):
 		return &kueuev1beta1.ResourceUsageApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Topology"):
+		return &kueuev1beta1.TopologyApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TopologyAssignment"):
+		return &kueuev1beta1.TopologyAssignmentApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TopologyDomainAssignment"):
+		return &kueuev1beta1.TopologyDomainAssignmentApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TopologyLevel"):
+		return &kueuev1beta1.TopologyLevelApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TopologySpec"):
+		return &kueuev1beta1.TopologySpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("Workload"):
 		return &kueuev1beta1.WorkloadApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("WorkloadPriorityClass"):
+		return &kueuev1beta1.WorkloadPriorityClassApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("WorkloadSpec"):
 		return &kueuev1beta1.WorkloadSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("WorkloadStatus"):
@@ -34,7 +34,6 @@ import (
 // FakeClusterQueues implements ClusterQueueInterface
 type FakeClusterQueues struct {
 	Fake *FakeKueueV1beta1
-	ns   string
 }
 
 var clusterqueuesResource = v1beta1.SchemeGroupVersion.WithResource("clusterqueues")
@@ -44,8 +43,7 @@ var clusterqueuesKind = v1beta1.SchemeGroupVersion.WithKind("ClusterQueue")
 // Get takes name of the clusterQueue, and returns the corresponding clusterQueue object, and an error if there is any.
 func (c *FakeClusterQueues) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.ClusterQueue, err error) {
 	obj, err := c.Fake.
-		Invokes(testing.NewGetAction(clusterqueuesResource, c.ns, name), &v1beta1.ClusterQueue{})
-
+		Invokes(testing.NewRootGetAction(clusterqueuesResource, name), &v1beta1.ClusterQueue{})
 	if obj == nil {
 		return nil, err
 	}
@@ -55,8 +53,7 @@ func (c *FakeClusterQueues) Get(ctx context.Context, name string, options v1.Get
 // List takes label and field selectors, and returns the list of ClusterQueues that match those selectors.
 func (c *FakeClusterQueues) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.ClusterQueueList, err error) {
 	obj, err := c.Fake.
-		Invokes(testing.NewListAction(clusterqueuesResource, clusterqueuesKind, c.ns, opts), &v1beta1.ClusterQueueList{})
-
+		Invokes(testing.NewRootListAction(clusterqueuesResource, clusterqueuesKind, opts), &v1beta1.ClusterQueueList{})
 	if obj == nil {
 		return nil, err
 	}
@@ -77,15 +74,13 @@ func (c *FakeClusterQueues) List(ctx context.Context, opts v1.ListOptions) (resu
 // Watch returns a watch.Interface that watches the requested clusterQueues.
 func (c *FakeClusterQueues) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return c.Fake.
-		InvokesWatch(testing.NewWatchAction(clusterqueuesResource, c.ns, opts))
-
+		InvokesWatch(testing.NewRootWatchAction(clusterqueuesResource, opts))
 }
 
 // Create takes the representation of a clusterQueue and creates it.  Returns the server's representation of the clusterQueue, and an error, if there is any.
 func (c *FakeClusterQueues) Create(ctx context.Context, clusterQueue *v1beta1.ClusterQueue, opts v1.CreateOptions) (result *v1beta1.ClusterQueue, err error) {
 	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(clusterqueuesResource, c.ns, clusterQueue), &v1beta1.ClusterQueue{})
-
+		Invokes(testing.NewRootCreateAction(clusterqueuesResource, clusterQueue), &v1beta1.ClusterQueue{})
 	if obj == nil {
 		return nil, err
 	}
@@ -95,8 +90,7 @@ func (c *FakeClusterQueues) Create(ctx context.Context, clusterQueue *v1beta1.Cl
 // Update takes the representation of a clusterQueue and updates it. Returns the server's representation of the clusterQueue, and an error, if there is any.
 func (c *FakeClusterQueues) Update(ctx context.Context, clusterQueue *v1beta1.ClusterQueue, opts v1.UpdateOptions) (result *v1beta1.ClusterQueue, err error) {
 	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(clusterqueuesResource, c.ns, clusterQueue), &v1beta1.ClusterQueue{})
-
+		Invokes(testing.NewRootUpdateAction(clusterqueuesResource, clusterQueue), &v1beta1.ClusterQueue{})
 	if obj == nil {
 		return nil, err
 	}
@@ -107,8 +101,7 @@ func (c *FakeClusterQueues) Update(ctx context.Context, clusterQueue *v1beta1.Cl
 // Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
 func (c *FakeClusterQueues) UpdateStatus(ctx context.Context, clusterQueue *v1beta1.ClusterQueue, opts v1.UpdateOptions) (*v1beta1.ClusterQueue, error) {
 	obj, err := c.Fake.
-		Invokes(testing.NewUpdateSubresourceAction(clusterqueuesResource, "status", c.ns, clusterQueue), &v1beta1.ClusterQueue{})
-
+		Invokes(testing.NewRootUpdateSubresourceAction(clusterqueuesResource, "status", clusterQueue), &v1beta1.ClusterQueue{})
 	if obj == nil {
 		return nil, err
 	}
@@ -118,14 +111,13 @@ func (c *FakeClusterQueues) UpdateStatus(ctx context.Context, clusterQueue *v1be
 // Delete takes name of the clusterQueue and deletes it. Returns an error if one occurs.
 func (c *FakeClusterQueues) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
-		Invokes(testing.NewDeleteActionWithOptions(clusterqueuesResource, c.ns, name, opts), &v1beta1.ClusterQueue{})
-
+		Invokes(testing.NewRootDeleteActionWithOptions(clusterqueuesResource, name, opts), &v1beta1.ClusterQueue{})
 	return err
 }
 
 // DeleteCollection deletes a collection of objects.
 func (c *FakeClusterQueues) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
-	action := testing.NewDeleteCollectionAction(clusterqueuesResource, c.ns, listOpts)
+	action := testing.NewRootDeleteCollectionAction(clusterqueuesResource, listOpts)
 
 	_, err := c.Fake.Invokes(action, &v1beta1.ClusterQueueList{})
 	return err
@@ -134,8 +126,7 @@ func (c *FakeClusterQueues) DeleteCollection(ctx context.Context, opts v1.Delete
 // Patch applies the patch and returns the patched clusterQueue.
 func (c *FakeClusterQueues) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ClusterQueue, err error) {
 	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(clusterqueuesResource, c.ns, name, pt, data, subresources...), &v1beta1.ClusterQueue{})
-
+		Invokes(testing.NewRootPatchSubresourceAction(clusterqueuesResource, name, pt, data, subresources...), &v1beta1.ClusterQueue{})
 	if obj == nil {
 		return nil, err
 	}
@@ -156,8 +147,7 @@ func (c *FakeClusterQueues) Apply(ctx context.Context, clusterQueue *kueuev1beta
 		return nil, fmt.Errorf("clusterQueue.Name must be provided to Apply")
 	}
 	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(clusterqueuesResource, c.ns, *name, types.ApplyPatchType, data), &v1beta1.ClusterQueue{})
-
+		Invokes(testing.NewRootPatchSubresourceAction(clusterqueuesResource, *name, types.ApplyPatchType, data), &v1beta1.ClusterQueue{})
 	if obj == nil {
 		return nil, err
 	}
@@ -179,8 +169,7 @@ func (c *FakeClusterQueues) ApplyStatus(ctx context.Context, clusterQueue *kueue
 		return nil, fmt.Errorf("clusterQueue.Name must be provided to Apply")
 	}
 	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(clusterqueuesResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1beta1.ClusterQueue{})
-
+		Invokes(testing.NewRootPatchSubresourceAction(clusterqueuesResource, *name, types.ApplyPatchType, data, "status"), &v1beta1.ClusterQueue{})
 	if obj == nil {
 		return nil, err
 	}
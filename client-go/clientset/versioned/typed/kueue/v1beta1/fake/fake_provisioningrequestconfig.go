@@ -0,0 +1,144 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+	v1beta1 "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	kueuev1beta1 "sigs.k8s.io/kueue/client-go/applyconfiguration/kueue/v1beta1"
+)
+
+// FakeProvisioningRequestConfigs implements ProvisioningRequestConfigInterface
+type FakeProvisioningRequestConfigs struct {
+	Fake *FakeKueueV1beta1
+}
+
+var provisioningrequestconfigsResource = v1beta1.SchemeGroupVersion.WithResource("provisioningrequestconfigs")
+
+var provisioningrequestconfigsKind = v1beta1.SchemeGroupVersion.WithKind("ProvisioningRequestConfig")
+
+// Get takes name of the provisioningRequestConfig, and returns the corresponding provisioningRequestConfig object, and an error if there is any.
+func (c *FakeProvisioningRequestConfigs) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.ProvisioningRequestConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(provisioningrequestconfigsResource, name), &v1beta1.ProvisioningRequestConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ProvisioningRequestConfig), err
+}
+
+// List takes label and field selectors, and returns the list of ProvisioningRequestConfigs that match those selectors.
+func (c *FakeProvisioningRequestConfigs) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.ProvisioningRequestConfigList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(provisioningrequestconfigsResource, provisioningrequestconfigsKind, opts), &v1beta1.ProvisioningRequestConfigList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.ProvisioningRequestConfigList{ListMeta: obj.(*v1beta1.ProvisioningRequestConfigList).ListMeta}
+	for _, item := range obj.(*v1beta1.ProvisioningRequestConfigList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested provisioningRequestConfigs.
+func (c *FakeProvisioningRequestConfigs) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(provisioningrequestconfigsResource, opts))
+}
+
+// Create takes the representation of a provisioningRequestConfig and creates it.  Returns the server's representation of the provisioningRequestConfig, and an error, if there is any.
+func (c *FakeProvisioningRequestConfigs) Create(ctx context.Context, provisioningRequestConfig *v1beta1.ProvisioningRequestConfig, opts v1.CreateOptions) (result *v1beta1.ProvisioningRequestConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(provisioningrequestconfigsResource, provisioningRequestConfig), &v1beta1.ProvisioningRequestConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ProvisioningRequestConfig), err
+}
+
+// Update takes the representation of a provisioningRequestConfig and updates it. Returns the server's representation of the provisioningRequestConfig, and an error, if there is any.
+func (c *FakeProvisioningRequestConfigs) Update(ctx context.Context, provisioningRequestConfig *v1beta1.ProvisioningRequestConfig, opts v1.UpdateOptions) (result *v1beta1.ProvisioningRequestConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(provisioningrequestconfigsResource, provisioningRequestConfig), &v1beta1.ProvisioningRequestConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ProvisioningRequestConfig), err
+}
+
+// Delete takes name of the provisioningRequestConfig and deletes it. Returns an error if one occurs.
+func (c *FakeProvisioningRequestConfigs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(provisioningrequestconfigsResource, name, opts), &v1beta1.ProvisioningRequestConfig{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeProvisioningRequestConfigs) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(provisioningrequestconfigsResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.ProvisioningRequestConfigList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched provisioningRequestConfig.
+func (c *FakeProvisioningRequestConfigs) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ProvisioningRequestConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(provisioningrequestconfigsResource, name, pt, data, subresources...), &v1beta1.ProvisioningRequestConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ProvisioningRequestConfig), err
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied provisioningRequestConfig.
+func (c *FakeProvisioningRequestConfigs) Apply(ctx context.Context, provisioningRequestConfig *kueuev1beta1.ProvisioningRequestConfigApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.ProvisioningRequestConfig, err error) {
+	if provisioningRequestConfig == nil {
+		return nil, fmt.Errorf("provisioningRequestConfig provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(provisioningRequestConfig)
+	if err != nil {
+		return nil, err
+	}
+	name := provisioningRequestConfig.Name
+	if name == nil {
+		return nil, fmt.Errorf("provisioningRequestConfig.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(provisioningrequestconfigsResource, *name, types.ApplyPatchType, data), &v1beta1.ProvisioningRequestConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ProvisioningRequestConfig), err
+}
@@ -17,10 +17,22 @@ limitations under the License.
 
 package v1beta1
 
+type AdmissionCheckExpansion interface{}
+
 type ClusterQueueExpansion interface{}
 
+type CohortExpansion interface{}
+
 type LocalQueueExpansion interface{}
 
+type ProvisioningRequestExpansion interface{}
+
+type ProvisioningRequestConfigExpansion interface{}
+
 type ResourceFlavorExpansion interface{}
 
+type TopologyExpansion interface{}
+
 type WorkloadExpansion interface{}
+
+type WorkloadPriorityClassExpansion interface{}
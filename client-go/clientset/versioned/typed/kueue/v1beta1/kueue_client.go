@@ -27,10 +27,16 @@ import (
 
 type KueueV1beta1Interface interface {
 	RESTClient() rest.Interface
+	AdmissionChecksGetter
 	ClusterQueuesGetter
+	CohortsGetter
 	LocalQueuesGetter
+	ProvisioningRequestsGetter
+	ProvisioningRequestConfigsGetter
 	ResourceFlavorsGetter
+	TopologiesGetter
 	WorkloadsGetter
+	WorkloadPriorityClassesGetter
 }
 
 // KueueV1beta1Client is used to interact with features provided by the kueue.x-k8s.io group.
@@ -38,22 +44,46 @@ type KueueV1beta1Client struct {
 	restClient rest.Interface
 }
 
-func (c *KueueV1beta1Client) ClusterQueues(namespace string) ClusterQueueInterface {
-	return newClusterQueues(c, namespace)
+func (c *KueueV1beta1Client) AdmissionChecks() AdmissionCheckInterface {
+	return newAdmissionChecks(c)
+}
+
+func (c *KueueV1beta1Client) ClusterQueues() ClusterQueueInterface {
+	return newClusterQueues(c)
+}
+
+func (c *KueueV1beta1Client) Cohorts() CohortInterface {
+	return newCohorts(c)
 }
 
 func (c *KueueV1beta1Client) LocalQueues(namespace string) LocalQueueInterface {
 	return newLocalQueues(c, namespace)
 }
 
-func (c *KueueV1beta1Client) ResourceFlavors(namespace string) ResourceFlavorInterface {
-	return newResourceFlavors(c, namespace)
+func (c *KueueV1beta1Client) ProvisioningRequests(namespace string) ProvisioningRequestInterface {
+	return newProvisioningRequests(c, namespace)
+}
+
+func (c *KueueV1beta1Client) ProvisioningRequestConfigs() ProvisioningRequestConfigInterface {
+	return newProvisioningRequestConfigs(c)
+}
+
+func (c *KueueV1beta1Client) ResourceFlavors() ResourceFlavorInterface {
+	return newResourceFlavors(c)
+}
+
+func (c *KueueV1beta1Client) Topologies() TopologyInterface {
+	return newTopologies(c)
 }
 
 func (c *KueueV1beta1Client) Workloads(namespace string) WorkloadInterface {
 	return newWorkloads(c, namespace)
 }
 
+func (c *KueueV1beta1Client) WorkloadPriorityClasses() WorkloadPriorityClassInterface {
+	return newWorkloadPriorityClasses(c)
+}
+
 // NewForConfig creates a new KueueV1beta1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -0,0 +1,196 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+	v1beta1 "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	kueuev1beta1 "sigs.k8s.io/kueue/client-go/applyconfiguration/kueue/v1beta1"
+	scheme "sigs.k8s.io/kueue/client-go/clientset/versioned/scheme"
+)
+
+// TopologiesGetter has a method to return a TopologyInterface.
+// A group's client should implement this interface.
+type TopologiesGetter interface {
+	Topologies() TopologyInterface
+}
+
+// TopologyInterface has methods to work with Topology resources.
+type TopologyInterface interface {
+	Create(ctx context.Context, topology *v1beta1.Topology, opts v1.CreateOptions) (*v1beta1.Topology, error)
+	Update(ctx context.Context, topology *v1beta1.Topology, opts v1.UpdateOptions) (*v1beta1.Topology, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.Topology, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.TopologyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.Topology, err error)
+	Apply(ctx context.Context, topology *kueuev1beta1.TopologyApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.Topology, err error)
+	TopologyExpansion
+}
+
+// topologies implements TopologyInterface
+type topologies struct {
+	client rest.Interface
+}
+
+// newTopologies returns a Topologies
+func newTopologies(c *KueueV1beta1Client) *topologies {
+	return &topologies{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the topology, and returns the corresponding topology object, and an error if there is any.
+func (c *topologies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.Topology, err error) {
+	result = &v1beta1.Topology{}
+	err = c.client.Get().
+		Resource("topologies").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Topologies that match those selectors.
+func (c *topologies) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.TopologyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.TopologyList{}
+	err = c.client.Get().
+		Resource("topologies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested topologies.
+func (c *topologies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("topologies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a topology and creates it.  Returns the server's representation of the topology, and an error, if there is any.
+func (c *topologies) Create(ctx context.Context, topology *v1beta1.Topology, opts v1.CreateOptions) (result *v1beta1.Topology, err error) {
+	result = &v1beta1.Topology{}
+	err = c.client.Post().
+		Resource("topologies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(topology).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a topology and updates it. Returns the server's representation of the topology, and an error, if there is any.
+func (c *topologies) Update(ctx context.Context, topology *v1beta1.Topology, opts v1.UpdateOptions) (result *v1beta1.Topology, err error) {
+	result = &v1beta1.Topology{}
+	err = c.client.Put().
+		Resource("topologies").
+		Name(topology.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(topology).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the topology and deletes it. Returns an error if one occurs.
+func (c *topologies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("topologies").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *topologies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("topologies").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched topology.
+func (c *topologies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.Topology, err error) {
+	result = &v1beta1.Topology{}
+	err = c.client.Patch(pt).
+		Resource("topologies").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied topology.
+func (c *topologies) Apply(ctx context.Context, topology *kueuev1beta1.TopologyApplyConfiguration, opts v1.ApplyOptions) (result *v1beta1.Topology, err error) {
+	if topology == nil {
+		return nil, fmt.Errorf("topology provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(topology)
+	if err != nil {
+		return nil, err
+	}
+	name := topology.Name
+	if name == nil {
+		return nil, fmt.Errorf("topology.Name must be provided to Apply")
+	}
+	result = &v1beta1.Topology{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Resource("topologies").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
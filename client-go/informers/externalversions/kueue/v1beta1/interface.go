@@ -23,14 +23,26 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// AdmissionChecks returns a AdmissionCheckInformer.
+	AdmissionChecks() AdmissionCheckInformer
 	// ClusterQueues returns a ClusterQueueInformer.
 	ClusterQueues() ClusterQueueInformer
+	// Cohorts returns a CohortInformer.
+	Cohorts() CohortInformer
 	// LocalQueues returns a LocalQueueInformer.
 	LocalQueues() LocalQueueInformer
+	// ProvisioningRequests returns a ProvisioningRequestInformer.
+	ProvisioningRequests() ProvisioningRequestInformer
+	// ProvisioningRequestConfigs returns a ProvisioningRequestConfigInformer.
+	ProvisioningRequestConfigs() ProvisioningRequestConfigInformer
 	// ResourceFlavors returns a ResourceFlavorInformer.
 	ResourceFlavors() ResourceFlavorInformer
+	// Topologies returns a TopologyInformer.
+	Topologies() TopologyInformer
 	// Workloads returns a WorkloadInformer.
 	Workloads() WorkloadInformer
+	// WorkloadPriorityClasses returns a WorkloadPriorityClassInformer.
+	WorkloadPriorityClasses() WorkloadPriorityClassInformer
 }
 
 type version struct {
@@ -44,9 +56,19 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// AdmissionChecks returns a AdmissionCheckInformer.
+func (v *version) AdmissionChecks() AdmissionCheckInformer {
+	return &admissionCheckInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ClusterQueues returns a ClusterQueueInformer.
 func (v *version) ClusterQueues() ClusterQueueInformer {
-	return &clusterQueueInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+	return &clusterQueueInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// Cohorts returns a CohortInformer.
+func (v *version) Cohorts() CohortInformer {
+	return &cohortInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
 // LocalQueues returns a LocalQueueInformer.
@@ -54,12 +76,32 @@ func (v *version) LocalQueues() LocalQueueInformer {
 	return &localQueueInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ProvisioningRequests returns a ProvisioningRequestInformer.
+func (v *version) ProvisioningRequests() ProvisioningRequestInformer {
+	return &provisioningRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ProvisioningRequestConfigs returns a ProvisioningRequestConfigInformer.
+func (v *version) ProvisioningRequestConfigs() ProvisioningRequestConfigInformer {
+	return &provisioningRequestConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ResourceFlavors returns a ResourceFlavorInformer.
 func (v *version) ResourceFlavors() ResourceFlavorInformer {
-	return &resourceFlavorInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+	return &resourceFlavorInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// Topologies returns a TopologyInformer.
+func (v *version) Topologies() TopologyInformer {
+	return &topologyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
 // Workloads returns a WorkloadInformer.
 func (v *version) Workloads() WorkloadInformer {
 	return &workloadInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// WorkloadPriorityClasses returns a WorkloadPriorityClassInformer.
+func (v *version) WorkloadPriorityClasses() WorkloadPriorityClassInformer {
+	return &workloadPriorityClassInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
@@ -17,13 +17,17 @@ limitations under the License.
 
 package v1beta1
 
+// AdmissionCheckListerExpansion allows custom methods to be added to
+// AdmissionCheckLister.
+type AdmissionCheckListerExpansion interface{}
+
 // ClusterQueueListerExpansion allows custom methods to be added to
 // ClusterQueueLister.
 type ClusterQueueListerExpansion interface{}
 
-// ClusterQueueNamespaceListerExpansion allows custom methods to be added to
-// ClusterQueueNamespaceLister.
-type ClusterQueueNamespaceListerExpansion interface{}
+// CohortListerExpansion allows custom methods to be added to
+// CohortLister.
+type CohortListerExpansion interface{}
 
 // LocalQueueListerExpansion allows custom methods to be added to
 // LocalQueueLister.
@@ -33,13 +37,25 @@ type LocalQueueListerExpansion interface{}
 // LocalQueueNamespaceLister.
 type LocalQueueNamespaceListerExpansion interface{}
 
+// ProvisioningRequestListerExpansion allows custom methods to be added to
+// ProvisioningRequestLister.
+type ProvisioningRequestListerExpansion interface{}
+
+// ProvisioningRequestNamespaceListerExpansion allows custom methods to be added to
+// ProvisioningRequestNamespaceLister.
+type ProvisioningRequestNamespaceListerExpansion interface{}
+
+// ProvisioningRequestConfigListerExpansion allows custom methods to be added to
+// ProvisioningRequestConfigLister.
+type ProvisioningRequestConfigListerExpansion interface{}
+
 // ResourceFlavorListerExpansion allows custom methods to be added to
 // ResourceFlavorLister.
 type ResourceFlavorListerExpansion interface{}
 
-// ResourceFlavorNamespaceListerExpansion allows custom methods to be added to
-// ResourceFlavorNamespaceLister.
-type ResourceFlavorNamespaceListerExpansion interface{}
+// TopologyListerExpansion allows custom methods to be added to
+// TopologyLister.
+type TopologyListerExpansion interface{}
 
 // WorkloadListerExpansion allows custom methods to be added to
 // WorkloadLister.
@@ -48,3 +64,7 @@ type WorkloadListerExpansion interface{}
 // WorkloadNamespaceListerExpansion allows custom methods to be added to
 // WorkloadNamespaceLister.
 type WorkloadNamespaceListerExpansion interface{}
+
+// WorkloadPriorityClassListerExpansion allows custom methods to be added to
+// WorkloadPriorityClassLister.
+type WorkloadPriorityClassListerExpansion interface{}
@@ -0,0 +1,67 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	v1beta1 "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+// TopologyLister helps list Topologies.
+// All objects returned here must be treated as read-only.
+type TopologyLister interface {
+	// List lists all Topologies in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.Topology, err error)
+	// Get retrieves the Topology from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.Topology, error)
+	TopologyListerExpansion
+}
+
+// topologyLister implements the TopologyLister interface.
+type topologyLister struct {
+	indexer cache.Indexer
+}
+
+// NewTopologyLister returns a new TopologyLister.
+func NewTopologyLister(indexer cache.Indexer) TopologyLister {
+	return &topologyLister{indexer: indexer}
+}
+
+// List lists all Topologies in the indexer.
+func (s *topologyLister) List(selector labels.Selector) (ret []*v1beta1.Topology, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.Topology))
+	})
+	return ret, err
+}
+
+// Get retrieves the Topology from the index for a given name.
+func (s *topologyLister) Get(name string) (*v1beta1.Topology, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("topology"), name)
+	}
+	return obj.(*v1beta1.Topology), nil
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"sigs.k8s.io/kueue/client-go/clientset/versioned"
+)
+
+// ClientGetter provides the clients used by the commands.
+type ClientGetter interface {
+	// Namespace returns the namespace selected with the --namespace flag or,
+	// if not set, the namespace of the current context.
+	Namespace() (string, error)
+	KueueClientset() (versioned.Interface, error)
+	DynamicClient() (dynamic.Interface, error)
+	RESTMapper() (meta.RESTMapper, error)
+}
+
+// configFlags is the ClientGetter that loads the kubeconfig, like kubectl.
+type configFlags struct {
+	kubeConfig string
+	context    string
+	namespace  string
+
+	clientConfig clientcmd.ClientConfig
+}
+
+var _ ClientGetter = (*configFlags)(nil)
+
+func (f *configFlags) addFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&f.kubeConfig, "kubeconfig", "", "Path to the kubeconfig file to use.")
+	flags.StringVar(&f.context, "context", "", "The name of the kubeconfig context to use.")
+	flags.StringVarP(&f.namespace, "namespace", "n", "", "If present, the namespace scope for this request.")
+}
+
+// toClientConfig is called after the flags are parsed.
+func (f *configFlags) toClientConfig() clientcmd.ClientConfig {
+	if f.clientConfig == nil {
+		rules := clientcmd.NewDefaultClientConfigLoadingRules()
+		rules.ExplicitPath = f.kubeConfig
+		overrides := &clientcmd.ConfigOverrides{CurrentContext: f.context}
+		overrides.Context.Namespace = f.namespace
+		f.clientConfig = clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, overrides)
+	}
+	return f.clientConfig
+}
+
+func (f *configFlags) restConfig() (*rest.Config, error) {
+	return f.toClientConfig().ClientConfig()
+}
+
+func (f *configFlags) Namespace() (string, error) {
+	ns, _, err := f.toClientConfig().Namespace()
+	return ns, err
+}
+
+func (f *configFlags) KueueClientset() (versioned.Interface, error) {
+	cfg, err := f.restConfig()
+	if err != nil {
+		return nil, err
+	}
+	return versioned.NewForConfig(cfg)
+}
+
+func (f *configFlags) DynamicClient() (dynamic.Interface, error) {
+	cfg, err := f.restConfig()
+	if err != nil {
+		return nil, err
+	}
+	return dynamic.NewForConfig(cfg)
+}
+
+func (f *configFlags) RESTMapper() (meta.RESTMapper, error) {
+	cfg, err := f.restConfig()
+	if err != nil {
+		return nil, err
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient)), nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+func newCreateCmd(o KueuectlOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a LocalQueue",
+	}
+	cmd.AddCommand(newCreateLocalQueueCmd(o))
+	return cmd
+}
+
+func newCreateLocalQueueCmd(o KueuectlOptions) *cobra.Command {
+	var (
+		output          string
+		clusterQueue    string
+		ignoreUnknownCQ bool
+	)
+	cmd := &cobra.Command{
+		Use:     "localqueue NAME --clusterqueue CLUSTERQUEUE",
+		Aliases: []string{"lq"},
+		Short:   "Create a LocalQueue pointing to a ClusterQueue",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateOutput(output); err != nil {
+				return err
+			}
+			if clusterQueue == "" {
+				return errors.New("--clusterqueue is required")
+			}
+			namespace, err := o.ClientGetter.Namespace()
+			if err != nil {
+				return err
+			}
+			clientset, err := o.ClientGetter.KueueClientset()
+			if err != nil {
+				return err
+			}
+			if !ignoreUnknownCQ {
+				_, err := clientset.KueueV1beta1().ClusterQueues().Get(cmd.Context(), clusterQueue, metav1.GetOptions{})
+				if apierrors.IsNotFound(err) {
+					return fmt.Errorf("ClusterQueue %s doesn't exist, use --ignore-unknown-cq to create the LocalQueue anyway", clusterQueue)
+				}
+				if err != nil {
+					return err
+				}
+			}
+			lq := &kueue.LocalQueue{
+				ObjectMeta: metav1.ObjectMeta{Name: args[0], Namespace: namespace},
+				Spec:       kueue.LocalQueueSpec{ClusterQueue: kueue.ClusterQueueReference(clusterQueue)},
+			}
+			lq, err = clientset.KueueV1beta1().LocalQueues(namespace).Create(cmd.Context(), lq, metav1.CreateOptions{})
+			if err != nil {
+				return err
+			}
+			if output != outputTable {
+				return printObject(o.Out, output, lq, nil)
+			}
+			_, err = fmt.Fprintf(o.Out, "localqueue.kueue.x-k8s.io/%s created\n", lq.Name)
+			return err
+		},
+	}
+	addOutputFlag(cmd, &output)
+	cmd.Flags().StringVarP(&clusterQueue, "clusterqueue", "c", "", "The ClusterQueue of the LocalQueue.")
+	cmd.Flags().BoolVarP(&ignoreUnknownCQ, "ignore-unknown-cq", "i", false, "Create the LocalQueue even if the ClusterQueue doesn't exist.")
+	return cmd
+}
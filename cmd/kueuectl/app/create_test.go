@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestCreateLocalQueue(t *testing.T) {
+	cq := utiltesting.MakeClusterQueue("cq").Obj()
+	cases := map[string]struct {
+		objs       []runtime.Object
+		args       []string
+		wantOutput string
+		wantErr    bool
+		wantSpec   *kueue.LocalQueueSpec
+	}{
+		"created": {
+			objs:       []runtime.Object{cq},
+			args:       []string{"create", "localqueue", "lq", "--clusterqueue", "cq"},
+			wantOutput: "localqueue.kueue.x-k8s.io/lq created\n",
+			wantSpec:   &kueue.LocalQueueSpec{ClusterQueue: "cq"},
+		},
+		"missing cluster queue": {
+			args:    []string{"create", "localqueue", "lq", "-c", "cq"},
+			wantErr: true,
+		},
+		"missing cluster queue ignored": {
+			args:       []string{"create", "lq", "lq", "-c", "cq", "--ignore-unknown-cq"},
+			wantOutput: "localqueue.kueue.x-k8s.io/lq created\n",
+			wantSpec:   &kueue.LocalQueueSpec{ClusterQueue: "cq"},
+		},
+		"no cluster queue": {
+			args:    []string{"create", "localqueue", "lq"},
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			getter := newFakeClientGetter(tc.objs...)
+			got, err := runCmd(t, getter, nil, tc.args...)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("Expected an error, got output:\n%s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantOutput, got); diff != "" {
+				t.Errorf("Unexpected output (-want,+got):\n%s", diff)
+			}
+			lq, err := getter.clientset.KueueV1beta1().LocalQueues("default").Get(context.Background(), "lq", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Getting the LocalQueue: %v", err)
+			}
+			if diff := cmp.Diff(*tc.wantSpec, lq.Spec); diff != "" {
+				t.Errorf("Unexpected LocalQueue spec (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/client-go/clientset/versioned"
+	"sigs.k8s.io/kueue/pkg/util/priority"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+func newDescribeCmd(o KueuectlOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe",
+		Short: "Show the details of a Workload",
+	}
+	cmd.AddCommand(newDescribeWorkloadCmd(o))
+	return cmd
+}
+
+func newDescribeWorkloadCmd(o KueuectlOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:     "workload NAME",
+		Aliases: []string{"workloads", "wl"},
+		Short:   "Show the status of a Workload and why it is pending",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, err := o.ClientGetter.Namespace()
+			if err != nil {
+				return err
+			}
+			clientset, err := o.ClientGetter.KueueClientset()
+			if err != nil {
+				return err
+			}
+			wl, err := clientset.KueueV1beta1().Workloads(namespace).Get(cmd.Context(), args[0], metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			reasons, err := pendingReasons(cmd.Context(), clientset, wl)
+			if err != nil {
+				return err
+			}
+			return describeWorkload(o.Out, wl, reasons, o.Clock.Now())
+		},
+	}
+}
+
+func describeWorkload(out io.Writer, wl *kueue.Workload, reasons []string, now time.Time) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "Name:\t%s\n", wl.Name)
+	fmt.Fprintf(w, "Namespace:\t%s\n", wl.Namespace)
+	fmt.Fprintf(w, "LocalQueue:\t%s\n", valueOrNone(wl.Spec.QueueName))
+	if wl.Status.Admission != nil {
+		fmt.Fprintf(w, "ClusterQueue:\t%s\n", wl.Status.Admission.ClusterQueue)
+	}
+	fmt.Fprintf(w, "Priority:\t%d\n", priority.Priority(wl))
+	fmt.Fprintf(w, "Status:\t%s\n", workloadStatus(wl))
+	fmt.Fprintf(w, "Age:\t%s\n", age(wl.CreationTimestamp, now))
+	if len(reasons) > 0 {
+		fmt.Fprintln(w, "Pending Reasons:")
+		for _, r := range reasons {
+			fmt.Fprintf(w, "  - %s\n", r)
+		}
+	}
+	if wl.Status.Admission != nil {
+		fmt.Fprintln(w, "Admission:")
+		for _, psa := range wl.Status.Admission.PodSetAssignments {
+			flavors := make([]string, 0, len(psa.Flavors))
+			for r, f := range psa.Flavors {
+				flavors = append(flavors, fmt.Sprintf("%s=%s", r, f))
+			}
+			sort.Strings(flavors)
+			fmt.Fprintf(w, "  %s:\tflavors: %s\n", psa.Name, strings.Join(flavors, ","))
+		}
+	}
+	if len(wl.Status.AdmissionChecks) > 0 {
+		fmt.Fprintln(w, "Admission Checks:")
+		for _, ac := range wl.Status.AdmissionChecks {
+			fmt.Fprintf(w, "  %s:\t%s\t%s\n", ac.Name, ac.State, ac.Message)
+		}
+	}
+	if len(wl.Status.Conditions) > 0 {
+		fmt.Fprintln(w, "Conditions:")
+		for _, c := range wl.Status.Conditions {
+			fmt.Fprintf(w, "  %s:\t%s\t%s\t%s\n", c.Type, c.Status, c.Reason, c.Message)
+		}
+	}
+	return w.Flush()
+}
+
+// pendingReasons explains why the workload is not admitted, based on the
+// status of the workload and of its queues.
+func pendingReasons(ctx context.Context, clientset versioned.Interface, wl *kueue.Workload) ([]string, error) {
+	if workload.IsAdmitted(wl) || apimeta.IsStatusConditionTrue(wl.Status.Conditions, kueue.WorkloadFinished) {
+		return nil, nil
+	}
+	var reasons []string
+	if !workload.IsActive(wl) {
+		reasons = append(reasons, "The Workload is deactivated")
+	}
+	if evicted := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadEvicted); evicted != nil && evicted.Status == metav1.ConditionTrue {
+		reasons = append(reasons, fmt.Sprintf("The Workload was evicted (%s): %s", evicted.Reason, evicted.Message))
+	}
+	if workload.IsWaitingForRequeue(wl) {
+		reasons = append(reasons, fmt.Sprintf("The Workload is waiting to be requeued at %s", wl.Status.RequeueState.RequeueAt.Format(time.RFC3339)))
+	}
+	if workload.HasQuotaReservation(wl) {
+		var pending []string
+		for _, ac := range wl.Status.AdmissionChecks {
+			if ac.State != kueue.CheckStateReady {
+				pending = append(pending, ac.Name)
+			}
+		}
+		if len(pending) > 0 {
+			reasons = append(reasons, fmt.Sprintf("The Workload is waiting for the admission checks: %s", strings.Join(pending, ", ")))
+		}
+		return reasons, nil
+	}
+
+	queueReasons, err := queuesPendingReasons(ctx, clientset, wl)
+	if err != nil {
+		return nil, err
+	}
+	reasons = append(reasons, queueReasons...)
+	if cond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadQuotaReserved); cond != nil && cond.Status == metav1.ConditionFalse && cond.Message != "" {
+		reasons = append(reasons, cond.Message)
+	}
+	if len(reasons) == 0 {
+		reasons = append(reasons, "The Workload is waiting to be evaluated for admission")
+	}
+	return reasons, nil
+}
+
+func queuesPendingReasons(ctx context.Context, clientset versioned.Interface, wl *kueue.Workload) ([]string, error) {
+	if wl.Spec.QueueName == "" {
+		return []string{"The Workload is not submitted to a LocalQueue"}, nil
+	}
+	lq, err := clientset.KueueV1beta1().LocalQueues(wl.Namespace).Get(ctx, wl.Spec.QueueName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return []string{fmt.Sprintf("LocalQueue %s doesn't exist", wl.Spec.QueueName)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var reasons []string
+	if isStopped(lq.Spec.StopPolicy) {
+		reasons = append(reasons, fmt.Sprintf("LocalQueue %s is stopped", lq.Name))
+	}
+	cq, err := clientset.KueueV1beta1().ClusterQueues().Get(ctx, string(lq.Spec.ClusterQueue), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return append(reasons, fmt.Sprintf("ClusterQueue %s doesn't exist", lq.Spec.ClusterQueue)), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if isStopped(cq.Spec.StopPolicy) {
+		reasons = append(reasons, fmt.Sprintf("ClusterQueue %s is stopped", cq.Name))
+	} else if cond := apimeta.FindStatusCondition(cq.Status.Conditions, kueue.ClusterQueueActive); cond != nil && cond.Status != metav1.ConditionTrue {
+		reasons = append(reasons, fmt.Sprintf("ClusterQueue %s is inactive: %s", cq.Name, cond.Message))
+	}
+	return reasons, nil
+}
+
+func isStopped(policy *kueue.StopPolicy) bool {
+	return policy != nil && *policy != kueue.None
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/client-go/clientset/versioned/fake"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestDescribeWorkload(t *testing.T) {
+	cq := utiltesting.MakeClusterQueue("cq").Obj()
+	lq := utiltesting.MakeLocalQueue("lq", "default").ClusterQueue("cq").Obj()
+	wl := utiltesting.MakeWorkload("wl", "default").
+		Queue("lq").
+		Priority(10).
+		Creation(testNow.Add(-time.Minute)).
+		Condition(metav1.Condition{
+			Type:    kueue.WorkloadQuotaReserved,
+			Status:  metav1.ConditionFalse,
+			Reason:  "Pending",
+			Message: "couldn't assign flavors to pod set main: insufficient quota for cpu in flavor default in ClusterQueue",
+		}).
+		Obj()
+
+	got, err := runCmd(t, newFakeClientGetter(cq, lq, wl), nil, "describe", "workload", "wl")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := `Name:        wl
+Namespace:   default
+LocalQueue:  lq
+Priority:    10
+Status:      Pending
+Age:         60s
+Pending Reasons:
+- couldn't assign flavors to pod set main: insufficient quota for cpu in flavor default in ClusterQueue
+Conditions:
+QuotaReserved:  False  Pending  couldn't assign flavors to pod set main: insufficient quota for cpu in flavor default in ClusterQueue
+`
+	if diff := cmp.Diff(want, trimLines(got)); diff != "" {
+		t.Errorf("Unexpected output (-want,+got):\n%s", diff)
+	}
+}
+
+func TestPendingReasons(t *testing.T) {
+	hold := kueue.Hold
+	cq := utiltesting.MakeClusterQueue("cq").Obj()
+	lq := utiltesting.MakeLocalQueue("lq", "default").ClusterQueue("cq").Obj()
+	stoppedLQ := lq.DeepCopy()
+	stoppedLQ.Spec.StopPolicy = &hold
+	inactiveCQ := cq.DeepCopy()
+	inactiveCQ.Status.Conditions = []metav1.Condition{{
+		Type:    kueue.ClusterQueueActive,
+		Status:  metav1.ConditionFalse,
+		Message: "Can't admit new workloads; some flavors are not found",
+	}}
+
+	cases := map[string]struct {
+		objs     []runtime.Object
+		workload *kueue.Workload
+		want     []string
+	}{
+		"admitted": {
+			objs: []runtime.Object{cq, lq},
+			workload: utiltesting.MakeWorkload("wl", "default").Queue("lq").
+				ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+				Admit(utiltesting.MakeAdmission("cq").Obj()).
+				Obj(),
+		},
+		"waiting to be evaluated": {
+			objs:     []runtime.Object{cq, lq},
+			workload: utiltesting.MakeWorkload("wl", "default").Queue("lq").Obj(),
+			want:     []string{"The Workload is waiting to be evaluated for admission"},
+		},
+		"deactivated": {
+			objs:     []runtime.Object{cq, lq},
+			workload: utiltesting.MakeWorkload("wl", "default").Queue("lq").Active(false).Obj(),
+			want:     []string{"The Workload is deactivated"},
+		},
+		"local queue not found": {
+			workload: utiltesting.MakeWorkload("wl", "default").Queue("lq").Obj(),
+			want:     []string{"LocalQueue lq doesn't exist"},
+		},
+		"stopped local queue and missing cluster queue": {
+			objs:     []runtime.Object{stoppedLQ},
+			workload: utiltesting.MakeWorkload("wl", "default").Queue("lq").Obj(),
+			want:     []string{"LocalQueue lq is stopped", "ClusterQueue cq doesn't exist"},
+		},
+		"inactive cluster queue": {
+			objs:     []runtime.Object{inactiveCQ, lq},
+			workload: utiltesting.MakeWorkload("wl", "default").Queue("lq").Obj(),
+			want:     []string{"ClusterQueue cq is inactive: Can't admit new workloads; some flavors are not found"},
+		},
+		"waiting for admission checks": {
+			objs: []runtime.Object{cq, lq},
+			workload: utiltesting.MakeWorkload("wl", "default").Queue("lq").
+				ReserveQuota(utiltesting.MakeAdmission("cq").Assignment(corev1.ResourceCPU, "default", "1").Obj()).
+				AdmissionCheck(kueue.AdmissionCheckState{Name: "check1", State: kueue.CheckStateReady}).
+				AdmissionCheck(kueue.AdmissionCheckState{Name: "check2", State: kueue.CheckStatePending}).
+				Obj(),
+			want: []string{"The Workload is waiting for the admission checks: check2"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := pendingReasons(context.Background(), fake.NewSimpleClientset(tc.objs...), tc.workload)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected reasons (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
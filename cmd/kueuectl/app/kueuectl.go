@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/utils/clock"
+)
+
+// IOStreams are the streams used by the commands.
+type IOStreams struct {
+	In     io.Reader
+	Out    io.Writer
+	ErrOut io.Writer
+}
+
+// KueuectlOptions configure the kueuectl command.
+type KueuectlOptions struct {
+	IOStreams
+	// ClientGetter provides the clients. If nil, the clients are built from
+	// the kubeconfig selected with the command flags.
+	ClientGetter ClientGetter
+	Clock        clock.Clock
+}
+
+// NewDefaultKueuectlCmd returns the kueuectl command using the standard
+// streams and the kubeconfig.
+func NewDefaultKueuectlCmd() *cobra.Command {
+	return NewKueuectlCmd(KueuectlOptions{
+		IOStreams: IOStreams{In: os.Stdin, Out: os.Stdout, ErrOut: os.Stderr},
+		Clock:     clock.RealClock{},
+	})
+}
+
+// NewKueuectlCmd returns the kueuectl command.
+func NewKueuectlCmd(o KueuectlOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           "kueuectl",
+		Short:         "Controls the Kueue queueing system",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+	cmd.SetIn(o.In)
+	cmd.SetOut(o.Out)
+	cmd.SetErr(o.ErrOut)
+
+	flags := &configFlags{}
+	flags.addFlags(cmd.PersistentFlags())
+	if o.ClientGetter == nil {
+		o.ClientGetter = flags
+	}
+	if o.Clock == nil {
+		o.Clock = clock.RealClock{}
+	}
+
+	cmd.AddCommand(newListCmd(o))
+	cmd.AddCommand(newDescribeCmd(o))
+	cmd.AddCommand(newCreateCmd(o))
+	cmd.AddCommand(newStopCmd(o))
+	cmd.AddCommand(newResumeCmd(o))
+	cmd.AddCommand(newSubmitCmd(o))
+	return cmd
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	testingclock "k8s.io/utils/clock/testing"
+
+	"sigs.k8s.io/kueue/client-go/clientset/versioned"
+	"sigs.k8s.io/kueue/client-go/clientset/versioned/fake"
+)
+
+var testNow = time.Date(2023, 10, 16, 12, 0, 0, 0, time.UTC)
+
+type fakeClientGetter struct {
+	namespace string
+	clientset *fake.Clientset
+	dynamic   *dynamicfake.FakeDynamicClient
+	mapper    meta.RESTMapper
+}
+
+var _ ClientGetter = (*fakeClientGetter)(nil)
+
+func newFakeClientGetter(objs ...runtime.Object) *fakeClientGetter {
+	return &fakeClientGetter{
+		namespace: "default",
+		clientset: fake.NewSimpleClientset(objs...),
+		dynamic:   dynamicfake.NewSimpleDynamicClient(scheme.Scheme),
+		mapper:    meta.NewDefaultRESTMapper(nil),
+	}
+}
+
+func (f *fakeClientGetter) Namespace() (string, error) {
+	return f.namespace, nil
+}
+
+func (f *fakeClientGetter) KueueClientset() (versioned.Interface, error) {
+	return f.clientset, nil
+}
+
+func (f *fakeClientGetter) DynamicClient() (dynamic.Interface, error) {
+	return f.dynamic, nil
+}
+
+func (f *fakeClientGetter) RESTMapper() (meta.RESTMapper, error) {
+	return f.mapper, nil
+}
+
+// runCmd runs kueuectl with the arguments and returns the output.
+func runCmd(t *testing.T, getter ClientGetter, in io.Reader, args ...string) (string, error) {
+	t.Helper()
+	out := &bytes.Buffer{}
+	cmd := NewKueuectlCmd(KueuectlOptions{
+		IOStreams:    IOStreams{In: in, Out: out, ErrOut: out},
+		ClientGetter: getter,
+		Clock:        testingclock.NewFakeClock(testNow),
+	})
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+// trimLines removes the indentation and the trailing spaces of the lines.
+func trimLines(s string) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	for i := range lines {
+		lines[i] = strings.TrimSpace(lines[i])
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
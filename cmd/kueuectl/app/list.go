@@ -0,0 +1,252 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+type listOptions struct {
+	KueuectlOptions
+	output        string
+	selector      string
+	allNamespaces bool
+	localQueue    string
+}
+
+func newListCmd(o KueuectlOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List ClusterQueues, LocalQueues or Workloads",
+	}
+	cmd.AddCommand(newListClusterQueuesCmd(o))
+	cmd.AddCommand(newListLocalQueuesCmd(o))
+	cmd.AddCommand(newListWorkloadsCmd(o))
+	return cmd
+}
+
+func (o *listOptions) addFlags(cmd *cobra.Command, namespaced bool) {
+	addOutputFlag(cmd, &o.output)
+	cmd.Flags().StringVarP(&o.selector, "selector", "l", "", "Label selector to filter on.")
+	if namespaced {
+		cmd.Flags().BoolVarP(&o.allNamespaces, "all-namespaces", "A", false, "List the objects across all namespaces.")
+	}
+}
+
+func (o *listOptions) namespace() (string, error) {
+	if o.allNamespaces {
+		return metav1.NamespaceAll, nil
+	}
+	return o.ClientGetter.Namespace()
+}
+
+func newListClusterQueuesCmd(o KueuectlOptions) *cobra.Command {
+	lo := &listOptions{KueuectlOptions: o}
+	cmd := &cobra.Command{
+		Use:     "clusterqueue",
+		Aliases: []string{"clusterqueues", "cq"},
+		Short:   "List ClusterQueues",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := validateOutput(lo.output); err != nil {
+				return err
+			}
+			clientset, err := lo.ClientGetter.KueueClientset()
+			if err != nil {
+				return err
+			}
+			list, err := clientset.KueueV1beta1().ClusterQueues().List(cmd.Context(), metav1.ListOptions{LabelSelector: lo.selector})
+			if err != nil {
+				return err
+			}
+			now := lo.Clock.Now()
+			t := &table{headers: []string{"NAME", "COHORT", "PENDING WORKLOADS", "ADMITTED WORKLOADS", "ACTIVE", "USAGE", "BORROWED", "AGE"}}
+			for _, cq := range list.Items {
+				t.addRow(
+					cq.Name,
+					valueOrNone(cq.Spec.Cohort),
+					strconv.Itoa(int(cq.Status.PendingWorkloads)),
+					strconv.Itoa(int(cq.Status.AdmittedWorkloads)),
+					strconv.FormatBool(apimeta.IsStatusConditionTrue(cq.Status.Conditions, kueue.ClusterQueueActive)),
+					formatUsage(cq.Status.FlavorsUsage, func(r kueue.ResourceUsage) resource.Quantity { return r.Total }),
+					formatUsage(cq.Status.FlavorsUsage, func(r kueue.ResourceUsage) resource.Quantity { return r.Borrowed }),
+					age(cq.CreationTimestamp, now),
+				)
+			}
+			return printObject(lo.Out, lo.output, list, t)
+		},
+	}
+	lo.addFlags(cmd, false)
+	return cmd
+}
+
+func newListLocalQueuesCmd(o KueuectlOptions) *cobra.Command {
+	lo := &listOptions{KueuectlOptions: o}
+	cmd := &cobra.Command{
+		Use:     "localqueue",
+		Aliases: []string{"localqueues", "lq"},
+		Short:   "List LocalQueues",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := validateOutput(lo.output); err != nil {
+				return err
+			}
+			namespace, err := lo.namespace()
+			if err != nil {
+				return err
+			}
+			clientset, err := lo.ClientGetter.KueueClientset()
+			if err != nil {
+				return err
+			}
+			list, err := clientset.KueueV1beta1().LocalQueues(namespace).List(cmd.Context(), metav1.ListOptions{LabelSelector: lo.selector})
+			if err != nil {
+				return err
+			}
+			now := lo.Clock.Now()
+			t := &table{headers: []string{"NAME", "CLUSTERQUEUE", "PENDING WORKLOADS", "ADMITTED WORKLOADS", "ACTIVE", "USAGE", "AGE"}}
+			for _, lq := range list.Items {
+				usage := make([]kueue.FlavorUsage, 0, len(lq.Status.FlavorUsage))
+				for _, fu := range lq.Status.FlavorUsage {
+					resources := make([]kueue.ResourceUsage, 0, len(fu.Resources))
+					for _, r := range fu.Resources {
+						resources = append(resources, kueue.ResourceUsage{Name: r.Name, Total: r.Total})
+					}
+					usage = append(usage, kueue.FlavorUsage{Name: fu.Name, Resources: resources})
+				}
+				t.addRow(
+					lq.Name,
+					string(lq.Spec.ClusterQueue),
+					strconv.Itoa(int(lq.Status.PendingWorkloads)),
+					strconv.Itoa(int(lq.Status.AdmittedWorkloads)),
+					strconv.FormatBool(apimeta.IsStatusConditionTrue(lq.Status.Conditions, kueue.LocalQueueActive)),
+					formatUsage(usage, func(r kueue.ResourceUsage) resource.Quantity { return r.Total }),
+					age(lq.CreationTimestamp, now),
+				)
+			}
+			if lo.allNamespaces {
+				t.prependColumn("NAMESPACE", func(i int) string { return list.Items[i].Namespace })
+			}
+			return printObject(lo.Out, lo.output, list, t)
+		},
+	}
+	lo.addFlags(cmd, true)
+	return cmd
+}
+
+func newListWorkloadsCmd(o KueuectlOptions) *cobra.Command {
+	lo := &listOptions{KueuectlOptions: o}
+	cmd := &cobra.Command{
+		Use:     "workload",
+		Aliases: []string{"workloads", "wl"},
+		Short:   "List Workloads",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := validateOutput(lo.output); err != nil {
+				return err
+			}
+			namespace, err := lo.namespace()
+			if err != nil {
+				return err
+			}
+			clientset, err := lo.ClientGetter.KueueClientset()
+			if err != nil {
+				return err
+			}
+			list, err := clientset.KueueV1beta1().Workloads(namespace).List(cmd.Context(), metav1.ListOptions{LabelSelector: lo.selector})
+			if err != nil {
+				return err
+			}
+			if lo.localQueue != "" {
+				items := list.Items[:0]
+				for _, wl := range list.Items {
+					if wl.Spec.QueueName == lo.localQueue {
+						items = append(items, wl)
+					}
+				}
+				list.Items = items
+			}
+			now := lo.Clock.Now()
+			t := &table{headers: []string{"NAME", "LOCALQUEUE", "CLUSTERQUEUE", "STATUS", "AGE"}}
+			for _, wl := range list.Items {
+				var cqName string
+				if wl.Status.Admission != nil {
+					cqName = string(wl.Status.Admission.ClusterQueue)
+				}
+				t.addRow(
+					wl.Name,
+					valueOrNone(wl.Spec.QueueName),
+					valueOrNone(cqName),
+					workloadStatus(&wl),
+					age(wl.CreationTimestamp, now),
+				)
+			}
+			if lo.allNamespaces {
+				t.prependColumn("NAMESPACE", func(i int) string { return list.Items[i].Namespace })
+			}
+			return printObject(lo.Out, lo.output, list, t)
+		},
+	}
+	lo.addFlags(cmd, true)
+	cmd.Flags().StringVar(&lo.localQueue, "localqueue", "", "Only list the Workloads submitted to the LocalQueue.")
+	return cmd
+}
+
+// formatUsage lists the non-zero quantities as <flavor>/<resource>=<quantity>.
+func formatUsage(usage []kueue.FlavorUsage, quantity func(kueue.ResourceUsage) resource.Quantity) string {
+	var parts []string
+	for _, fu := range usage {
+		for _, r := range fu.Resources {
+			q := quantity(r)
+			if q.IsZero() {
+				continue
+			}
+			parts = append(parts, fmt.Sprintf("%s/%s=%s", fu.Name, r.Name, q.String()))
+		}
+	}
+	if len(parts) == 0 {
+		return none
+	}
+	return strings.Join(parts, ",")
+}
+
+// workloadStatus summarizes the conditions of the workload.
+func workloadStatus(wl *kueue.Workload) string {
+	switch {
+	case apimeta.IsStatusConditionTrue(wl.Status.Conditions, kueue.WorkloadFinished):
+		return "Finished"
+	case workload.IsPreemptionPending(wl):
+		return "PreemptionPending"
+	case workload.IsAdmitted(wl):
+		return "Admitted"
+	case workload.HasQuotaReservation(wl):
+		return "QuotaReserved"
+	case !workload.IsActive(wl):
+		return "Inactive"
+	}
+	return "Pending"
+}
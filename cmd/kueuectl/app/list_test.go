@@ -0,0 +1,163 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestList(t *testing.T) {
+	created := metav1.NewTime(testNow.Add(-2 * time.Hour))
+	cq := utiltesting.MakeClusterQueue("cq").Cohort("all").Obj()
+	cq.CreationTimestamp = created
+	cq.Status = kueue.ClusterQueueStatus{
+		PendingWorkloads:  1,
+		AdmittedWorkloads: 2,
+		Conditions:        []metav1.Condition{{Type: kueue.ClusterQueueActive, Status: metav1.ConditionTrue}},
+		FlavorsUsage: []kueue.FlavorUsage{{
+			Name: "default",
+			Resources: []kueue.ResourceUsage{
+				{Name: corev1.ResourceCPU, Total: resource.MustParse("6"), Borrowed: resource.MustParse("2")},
+				{Name: corev1.ResourceMemory, Total: resource.MustParse("1Gi")},
+			},
+		}},
+	}
+	idle := utiltesting.MakeClusterQueue("idle").Obj()
+	idle.CreationTimestamp = created
+	lq := utiltesting.MakeLocalQueue("lq", "default").ClusterQueue("cq").Obj()
+	lq.CreationTimestamp = created
+	lq.Status = kueue.LocalQueueStatus{
+		PendingWorkloads:  1,
+		AdmittedWorkloads: 2,
+		Conditions:        []metav1.Condition{{Type: kueue.LocalQueueActive, Status: metav1.ConditionTrue}},
+		FlavorUsage: []kueue.LocalQueueFlavorUsage{{
+			Name:      "default",
+			Resources: []kueue.LocalQueueResourceUsage{{Name: corev1.ResourceCPU, Total: resource.MustParse("6")}},
+		}},
+	}
+	otherLQ := utiltesting.MakeLocalQueue("lq", "other").ClusterQueue("cq").Obj()
+	otherLQ.CreationTimestamp = created
+	pending := utiltesting.MakeWorkload("pending", "default").Queue("lq").Creation(testNow.Add(-time.Minute)).Obj()
+	admitted := utiltesting.MakeWorkload("admitted", "default").
+		Queue("lq").
+		Creation(testNow.Add(-time.Hour)).
+		ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+		Admit(utiltesting.MakeAdmission("cq").Obj()).
+		Obj()
+	other := utiltesting.MakeWorkload("other", "other").Queue("lq").Creation(testNow.Add(-time.Minute)).Obj()
+	objs := []runtime.Object{cq, idle, lq, otherLQ, pending, admitted, other}
+
+	cases := map[string]struct {
+		args    []string
+		want    string
+		wantErr bool
+	}{
+		"cluster queues": {
+			args: []string{"list", "clusterqueue"},
+			want: `NAME   COHORT   PENDING WORKLOADS   ADMITTED WORKLOADS   ACTIVE   USAGE                              BORROWED        AGE
+cq     all      1                   2                    true     default/cpu=6,default/memory=1Gi   default/cpu=2   120m
+idle   <none>   0                   0                    false    <none>                             <none>          120m
+`,
+		},
+		"local queues": {
+			args: []string{"list", "lq"},
+			want: `NAME   CLUSTERQUEUE   PENDING WORKLOADS   ADMITTED WORKLOADS   ACTIVE   USAGE           AGE
+lq     cq             1                   2                    true     default/cpu=6   120m
+`,
+		},
+		"local queues in all namespaces": {
+			args: []string{"list", "localqueues", "-A"},
+			want: `NAMESPACE   NAME   CLUSTERQUEUE   PENDING WORKLOADS   ADMITTED WORKLOADS   ACTIVE   USAGE           AGE
+default     lq     cq             1                   2                    true     default/cpu=6   120m
+other       lq     cq             0                   0                    false    <none>          120m
+`,
+		},
+		"workloads": {
+			args: []string{"list", "workloads"},
+			want: `NAME       LOCALQUEUE   CLUSTERQUEUE   STATUS     AGE
+admitted   lq           cq             Admitted   60m
+pending    lq           <none>         Pending    60s
+`,
+		},
+		"workloads of a local queue": {
+			args: []string{"list", "wl", "--localqueue", "other"},
+			want: "No resources found\n",
+		},
+		"unsupported output": {
+			args:    []string{"list", "cq", "-o", "wide"},
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := runCmd(t, newFakeClientGetter(objs...), nil, tc.args...)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("Expected an error, got output:\n%s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected output (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestListOutput(t *testing.T) {
+	lq := utiltesting.MakeLocalQueue("lq", "default").ClusterQueue("cq").Obj()
+	for _, output := range []string{"json", "yaml"} {
+		t.Run(output, func(t *testing.T) {
+			got, err := runCmd(t, newFakeClientGetter(lq), nil, "list", "localqueue", "-o", output)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			data := []byte(got)
+			if output == "yaml" {
+				if data, err = yaml.YAMLToJSON(data); err != nil {
+					t.Fatalf("Decoding the output: %v", err)
+				}
+			}
+			var list kueue.LocalQueueList
+			if err := json.Unmarshal(data, &list); err != nil {
+				t.Fatalf("Decoding the output: %v", err)
+			}
+			wantTypeMeta := metav1.TypeMeta{APIVersion: kueue.GroupVersion.String(), Kind: "LocalQueueList"}
+			if diff := cmp.Diff(wantTypeMeta, list.TypeMeta); diff != "" {
+				t.Errorf("Unexpected list kind (-want,+got):\n%s", diff)
+			}
+			if len(list.Items) != 1 || list.Items[0].Name != "lq" || list.Items[0].Kind != "LocalQueue" {
+				t.Errorf("Unexpected items %v", list.Items)
+			}
+		})
+	}
+}
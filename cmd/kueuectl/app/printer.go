@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/kueue/client-go/clientset/versioned/scheme"
+)
+
+const (
+	outputTable = ""
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+
+	none = "<none>"
+)
+
+func addOutputFlag(cmd *cobra.Command, output *string) {
+	cmd.Flags().StringVarP(output, "output", "o", outputTable, "Output format. One of: json, yaml. Prints a table if not set.")
+}
+
+func validateOutput(output string) error {
+	switch output {
+	case outputTable, outputJSON, outputYAML:
+		return nil
+	}
+	return fmt.Errorf("unsupported output format %q, must be one of: json, yaml", output)
+}
+
+// table holds the rows printed when no output format is set.
+type table struct {
+	headers []string
+	rows    [][]string
+}
+
+func (t *table) addRow(cells ...string) {
+	t.rows = append(t.rows, cells)
+}
+
+// prependColumn adds a column before the others, with a cell for each row.
+func (t *table) prependColumn(header string, cell func(i int) string) {
+	t.headers = append([]string{header}, t.headers...)
+	for i := range t.rows {
+		t.rows[i] = append([]string{cell(i)}, t.rows[i]...)
+	}
+}
+
+// printObject prints the object in the output format, or the table if no
+// output format is set.
+func printObject(out io.Writer, output string, obj runtime.Object, t *table) error {
+	switch output {
+	case outputJSON, outputYAML:
+		if err := setTypeMeta(obj); err != nil {
+			return err
+		}
+		var data []byte
+		var err error
+		if output == outputJSON {
+			data, err = json.MarshalIndent(obj, "", "    ")
+			data = append(data, '\n')
+		} else {
+			data, err = yaml.Marshal(obj)
+		}
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(data)
+		return err
+	}
+	if t == nil {
+		return nil
+	}
+	if len(t.rows) == 0 {
+		_, err := fmt.Fprintln(out, "No resources found")
+		return err
+	}
+	w := tabwriter.NewWriter(out, 0, 8, 3, ' ', 0)
+	fmt.Fprintln(w, strings.Join(t.headers, "\t"))
+	for _, row := range t.rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
+
+// setTypeMeta sets the kind of the object and of its items, which are not
+// set in the objects returned by the clientset.
+func setTypeMeta(obj runtime.Object) error {
+	if err := setKind(obj); err != nil {
+		return err
+	}
+	if !meta.IsListType(obj) {
+		return nil
+	}
+	return meta.EachListItem(obj, setKind)
+}
+
+func setKind(obj runtime.Object) error {
+	gvks, _, err := scheme.Scheme.ObjectKinds(obj)
+	if err != nil {
+		return err
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvks[0])
+	return nil
+}
+
+func age(creation metav1.Time, now time.Time) string {
+	if creation.IsZero() {
+		return "<unknown>"
+	}
+	return duration.HumanDuration(now.Sub(creation.Time))
+}
+
+func valueOrNone(s string) string {
+	if s == "" {
+		return none
+	}
+	return s
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/client-go/clientset/versioned"
+)
+
+func newStopCmd(o KueuectlOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stop",
+		Short: "Stop the admission of Workloads in a ClusterQueue or LocalQueue",
+	}
+	cmd.AddCommand(newStopQueueCmd(o, "clusterqueue", []string{"cq"}, setClusterQueueStopPolicy))
+	cmd.AddCommand(newStopQueueCmd(o, "localqueue", []string{"lq"}, setLocalQueueStopPolicy))
+	return cmd
+}
+
+func newResumeCmd(o KueuectlOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume",
+		Short: "Resume the admission of Workloads in a ClusterQueue or LocalQueue",
+	}
+	cmd.AddCommand(newResumeQueueCmd(o, "clusterqueue", []string{"cq"}, setClusterQueueStopPolicy))
+	cmd.AddCommand(newResumeQueueCmd(o, "localqueue", []string{"lq"}, setLocalQueueStopPolicy))
+	return cmd
+}
+
+// setStopPolicyFunc sets the stopPolicy of the named queue.
+type setStopPolicyFunc func(ctx context.Context, o KueuectlOptions, clientset versioned.Interface, name string, policy kueue.StopPolicy) error
+
+func newStopQueueCmd(o KueuectlOptions, kind string, aliases []string, setStopPolicy setStopPolicyFunc) *cobra.Command {
+	var keepAlreadyRunning bool
+	cmd := &cobra.Command{
+		Use:     kind + " NAME",
+		Aliases: aliases,
+		Short:   fmt.Sprintf("Stop the admission of Workloads in a %s and, by default, evict the admitted Workloads", kind),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			policy := kueue.HoldAndDrain
+			if keepAlreadyRunning {
+				policy = kueue.Hold
+			}
+			clientset, err := o.ClientGetter.KueueClientset()
+			if err != nil {
+				return err
+			}
+			if err := setStopPolicy(cmd.Context(), o, clientset, args[0], policy); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(o.Out, "%s.kueue.x-k8s.io/%s stopped\n", kind, args[0])
+			return err
+		},
+	}
+	cmd.Flags().BoolVar(&keepAlreadyRunning, "keep-already-running", false, "Keep the admitted Workloads running.")
+	return cmd
+}
+
+func newResumeQueueCmd(o KueuectlOptions, kind string, aliases []string, setStopPolicy setStopPolicyFunc) *cobra.Command {
+	return &cobra.Command{
+		Use:     kind + " NAME",
+		Aliases: aliases,
+		Short:   fmt.Sprintf("Resume the admission of Workloads in a %s", kind),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientset, err := o.ClientGetter.KueueClientset()
+			if err != nil {
+				return err
+			}
+			if err := setStopPolicy(cmd.Context(), o, clientset, args[0], kueue.None); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(o.Out, "%s.kueue.x-k8s.io/%s resumed\n", kind, args[0])
+			return err
+		},
+	}
+}
+
+func stopPolicyPatch(policy kueue.StopPolicy) []byte {
+	return []byte(fmt.Sprintf(`{"spec":{"stopPolicy":%q}}`, policy))
+}
+
+func setClusterQueueStopPolicy(ctx context.Context, _ KueuectlOptions, clientset versioned.Interface, name string, policy kueue.StopPolicy) error {
+	_, err := clientset.KueueV1beta1().ClusterQueues().Patch(ctx, name, types.MergePatchType, stopPolicyPatch(policy), metav1.PatchOptions{})
+	return err
+}
+
+func setLocalQueueStopPolicy(ctx context.Context, o KueuectlOptions, clientset versioned.Interface, name string, policy kueue.StopPolicy) error {
+	namespace, err := o.ClientGetter.Namespace()
+	if err != nil {
+		return err
+	}
+	_, err = clientset.KueueV1beta1().LocalQueues(namespace).Patch(ctx, name, types.MergePatchType, stopPolicyPatch(policy), metav1.PatchOptions{})
+	return err
+}
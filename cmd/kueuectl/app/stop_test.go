@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestStopAndResume(t *testing.T) {
+	cases := map[string]struct {
+		args       []string
+		wantOutput string
+		wantCQ     kueue.StopPolicy
+		wantLQ     kueue.StopPolicy
+	}{
+		"stop cluster queue": {
+			args:       []string{"stop", "clusterqueue", "cq"},
+			wantOutput: "clusterqueue.kueue.x-k8s.io/cq stopped\n",
+			wantCQ:     kueue.HoldAndDrain,
+			wantLQ:     kueue.Hold,
+		},
+		"stop cluster queue keeping the running workloads": {
+			args:       []string{"stop", "cq", "cq", "--keep-already-running"},
+			wantOutput: "clusterqueue.kueue.x-k8s.io/cq stopped\n",
+			wantCQ:     kueue.Hold,
+			wantLQ:     kueue.Hold,
+		},
+		"stop local queue": {
+			args:       []string{"stop", "localqueue", "lq"},
+			wantOutput: "localqueue.kueue.x-k8s.io/lq stopped\n",
+			wantCQ:     kueue.None,
+			wantLQ:     kueue.HoldAndDrain,
+		},
+		"resume local queue": {
+			args:       []string{"resume", "lq", "lq"},
+			wantOutput: "localqueue.kueue.x-k8s.io/lq resumed\n",
+			wantCQ:     kueue.None,
+			wantLQ:     kueue.None,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			none, hold := kueue.None, kueue.Hold
+			cq := utiltesting.MakeClusterQueue("cq").Obj()
+			cq.Spec.StopPolicy = &none
+			lq := utiltesting.MakeLocalQueue("lq", "default").ClusterQueue("cq").Obj()
+			lq.Spec.StopPolicy = &hold
+			getter := newFakeClientGetter(cq, lq)
+			got, err := runCmd(t, getter, nil, tc.args...)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantOutput, got); diff != "" {
+				t.Errorf("Unexpected output (-want,+got):\n%s", diff)
+			}
+			ctx := context.Background()
+			gotCQ, err := getter.clientset.KueueV1beta1().ClusterQueues().Get(ctx, "cq", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Getting the ClusterQueue: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantCQ, *gotCQ.Spec.StopPolicy); diff != "" {
+				t.Errorf("Unexpected ClusterQueue stopPolicy (-want,+got):\n%s", diff)
+			}
+			gotLQ, err := getter.clientset.KueueV1beta1().LocalQueues("default").Get(ctx, "lq", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Getting the LocalQueue: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantLQ, *gotLQ.Spec.StopPolicy); diff != "" {
+				t.Errorf("Unexpected LocalQueue stopPolicy (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
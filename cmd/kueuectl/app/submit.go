@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+)
+
+func newSubmitCmd(o KueuectlOptions) *cobra.Command {
+	var (
+		filename  string
+		queueName string
+	)
+	cmd := &cobra.Command{
+		Use:   "submit -f FILENAME [--localqueue NAME]",
+		Short: "Create the jobs in the file, submitted to a LocalQueue",
+		Long: `Create the jobs in the file, submitted to a LocalQueue.
+
+The jobs are labeled with the name of the LocalQueue. Without --localqueue,
+the jobs must already have the label.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if filename == "" {
+				return errors.New("-f is required")
+			}
+			objs, err := readObjects(o.In, filename)
+			if err != nil {
+				return err
+			}
+			for _, obj := range objs {
+				if err := setQueueName(obj, queueName); err != nil {
+					return err
+				}
+			}
+			namespace, err := o.ClientGetter.Namespace()
+			if err != nil {
+				return err
+			}
+			mapper, err := o.ClientGetter.RESTMapper()
+			if err != nil {
+				return err
+			}
+			dynamicClient, err := o.ClientGetter.DynamicClient()
+			if err != nil {
+				return err
+			}
+			for _, obj := range objs {
+				gvk := obj.GroupVersionKind()
+				mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+				if err != nil {
+					return err
+				}
+				resource := dynamicClient.Resource(mapping.Resource)
+				var created *unstructured.Unstructured
+				if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+					if obj.GetNamespace() == "" {
+						obj.SetNamespace(namespace)
+					}
+					created, err = resource.Namespace(obj.GetNamespace()).Create(cmd.Context(), obj, metav1.CreateOptions{})
+				} else {
+					created, err = resource.Create(cmd.Context(), obj, metav1.CreateOptions{})
+				}
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(o.Out, "%s/%s created\n", mapping.Resource.GroupResource().String(), created.GetName())
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&filename, "filename", "f", "", "The file with the jobs to submit, or - for the standard input.")
+	cmd.Flags().StringVarP(&queueName, "localqueue", "q", "", "The LocalQueue to submit the jobs to.")
+	return cmd
+}
+
+// readObjects decodes the YAML or JSON documents of the file.
+func readObjects(stdin io.Reader, filename string) ([]*unstructured.Unstructured, error) {
+	in := stdin
+	if filename != "-" {
+		f, err := os.Open(filename)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		in = f
+	}
+	var objs []*unstructured.Unstructured
+	decoder := yaml.NewYAMLOrJSONDecoder(in, 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("decoding %s: %w", filename, err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		objs = append(objs, obj)
+	}
+	if len(objs) == 0 {
+		return nil, fmt.Errorf("no objects found in %s", filename)
+	}
+	return objs, nil
+}
+
+func setQueueName(obj *unstructured.Unstructured, queueName string) error {
+	labels := obj.GetLabels()
+	if queueName == "" {
+		if labels[constants.QueueLabel] == "" {
+			return fmt.Errorf("%s %s doesn't have the %s label, use --localqueue", obj.GetKind(), obj.GetName(), constants.QueueLabel)
+		}
+		return nil
+	}
+	if labels == nil {
+		labels = make(map[string]string, 1)
+	}
+	labels[constants.QueueLabel] = queueName
+	obj.SetLabels(labels)
+	return nil
+}
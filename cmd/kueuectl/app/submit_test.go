@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+)
+
+const sampleJobs = `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: first
+spec:
+  template:
+    spec:
+      containers:
+      - name: main
+        image: busybox
+      restartPolicy: Never
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: second
+  namespace: other
+  labels:
+    kueue.x-k8s.io/queue-name: other-queue
+spec:
+  template:
+    spec:
+      containers:
+      - name: main
+        image: busybox
+      restartPolicy: Never
+`
+
+func TestSubmit(t *testing.T) {
+	jobsFile := filepath.Join(t.TempDir(), "jobs.yaml")
+	if err := os.WriteFile(jobsFile, []byte(sampleJobs), 0o644); err != nil {
+		t.Fatalf("Writing the jobs file: %v", err)
+	}
+	cases := map[string]struct {
+		args       []string
+		stdin      string
+		wantOutput string
+		wantErr    bool
+		wantQueues map[string]string
+	}{
+		"file with the local queue": {
+			args:       []string{"submit", "-f", jobsFile, "--localqueue", "lq"},
+			wantOutput: "jobs.batch/first created\njobs.batch/second created\n",
+			wantQueues: map[string]string{"default/first": "lq", "other/second": "lq"},
+		},
+		"standard input with the label": {
+			args:       []string{"submit", "-f", "-"},
+			stdin:      sampleJobs[strings.Index(sampleJobs, "---"):],
+			wantOutput: "jobs.batch/second created\n",
+			wantQueues: map[string]string{"other/second": "other-queue"},
+		},
+		"missing label": {
+			args:    []string{"submit", "-f", jobsFile},
+			wantErr: true,
+		},
+		"missing file": {
+			args:    []string{"submit", "--localqueue", "lq"},
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			getter := newFakeClientGetter()
+			mapper := meta.NewDefaultRESTMapper(nil)
+			mapper.Add(batchv1.SchemeGroupVersion.WithKind("Job"), meta.RESTScopeNamespace)
+			getter.mapper = mapper
+			got, err := runCmd(t, getter, strings.NewReader(tc.stdin), tc.args...)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("Expected an error, got output:\n%s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantOutput, got); diff != "" {
+				t.Errorf("Unexpected output (-want,+got):\n%s", diff)
+			}
+			jobs, err := getter.dynamic.Resource(batchv1.SchemeGroupVersion.WithResource("jobs")).List(context.Background(), metav1.ListOptions{})
+			if err != nil {
+				t.Fatalf("Listing the jobs: %v", err)
+			}
+			gotQueues := make(map[string]string)
+			for _, job := range jobs.Items {
+				gotQueues[job.GetNamespace()+"/"+job.GetName()] = job.GetLabels()[constants.QueueLabel]
+			}
+			if diff := cmp.Diff(tc.wantQueues, gotQueues); diff != "" {
+				t.Errorf("Unexpected queues of the jobs (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 
@@ -48,18 +49,23 @@ import (
 	"sigs.k8s.io/kueue/pkg/cache"
 	"sigs.k8s.io/kueue/pkg/config"
 	"sigs.k8s.io/kueue/pkg/constants"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/provisioning"
 	"sigs.k8s.io/kueue/pkg/controller/core"
 	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	"sigs.k8s.io/kueue/pkg/controller/jobs/job"
 	"sigs.k8s.io/kueue/pkg/controller/jobs/noop"
+	"sigs.k8s.io/kueue/pkg/controller/jobs/pod"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/metrics"
 	"sigs.k8s.io/kueue/pkg/queue"
 	"sigs.k8s.io/kueue/pkg/scheduler"
+	"sigs.k8s.io/kueue/pkg/scheduler/preemption"
 	"sigs.k8s.io/kueue/pkg/util/cert"
 	"sigs.k8s.io/kueue/pkg/util/kubeversion"
 	"sigs.k8s.io/kueue/pkg/util/useragent"
 	"sigs.k8s.io/kueue/pkg/version"
+	"sigs.k8s.io/kueue/pkg/visibility"
 	"sigs.k8s.io/kueue/pkg/webhooks"
 
 	// Ensure linking of the job controllers.
@@ -123,6 +129,19 @@ func main() {
 
 	metrics.Register()
 
+	// The metrics server is configured before the manager is created, while the
+	// visibility handler depends on the manager, so it is only resolved when
+	// serving requests, after the manager starts.
+	var visibilityHandler http.Handler
+	if features.Enabled(features.VisibilityOnDemand) {
+		if options.Metrics.ExtraHandlers == nil {
+			options.Metrics.ExtraHandlers = make(map[string]http.Handler)
+		}
+		options.Metrics.ExtraHandlers[visibility.PathPrefix] = http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			visibilityHandler.ServeHTTP(w, req)
+		})
+	}
+
 	kubeConfig := ctrl.GetConfigOrDie()
 	if kubeConfig.UserAgent == "" {
 		kubeConfig.UserAgent = useragent.Default()
@@ -168,7 +187,10 @@ func main() {
 		cCache.CleanUpOnContext(ctx)
 	}()
 
-	setupScheduler(mgr, cCache, queues, &cfg)
+	sched := setupScheduler(mgr, cCache, queues, &cfg)
+	if features.Enabled(features.VisibilityOnDemand) {
+		visibilityHandler = visibility.WithAuthorization(visibility.NewHandler(queues, mgr.GetClient(), sched), mgr.GetClient())
+	}
 
 	setupLog.Info("Starting manager")
 	if err := mgr.Start(ctx); err != nil {
@@ -207,6 +229,12 @@ func setupControllers(ctx context.Context, mgr ctrl.Manager, cCache *cache.Cache
 		setupLog.Error(err, "Unable to create controller", "controller", failedCtrl)
 		os.Exit(1)
 	}
+	if features.Enabled(features.ProvisioningACC) {
+		if failedCtrl, err := provisioning.SetupControllers(mgr); err != nil {
+			setupLog.Error(err, "Unable to create controller", "controller", failedCtrl)
+			os.Exit(1)
+		}
+	}
 	manageJobsWithoutQueueName := cfg.ManageJobsWithoutQueueName
 
 	if failedWebhook, err := webhooks.Setup(mgr); err != nil {
@@ -220,6 +248,7 @@ func setupControllers(ctx context.Context, mgr ctrl.Manager, cCache *cache.Cache
 		jobframework.WithManageJobsWithoutQueueName(manageJobsWithoutQueueName),
 		jobframework.WithWaitForPodsReady(waitForPodsReady(cfg)),
 		jobframework.WithKubeServerVersion(serverVersionFetcher),
+		jobframework.WithIntegrationOptions(pod.FrameworkName, cfg.Integrations.PodOptions),
 	}
 	err := jobframework.ForEachIntegration(func(name string, cb jobframework.IntegrationCallbacks) error {
 		log := setupLog.WithValues("jobFrameworkName", name)
@@ -264,17 +293,29 @@ func setupProbeEndpoints(mgr ctrl.Manager) {
 	}
 }
 
-func setupScheduler(mgr ctrl.Manager, cCache *cache.Cache, queues *queue.Manager, cfg *configapi.Configuration) {
+func setupScheduler(mgr ctrl.Manager, cCache *cache.Cache, queues *queue.Manager, cfg *configapi.Configuration) *scheduler.Scheduler {
+	var victimOrderingPolicy configapi.VictimOrderingPolicy
+	if cfg.Preemption != nil {
+		victimOrderingPolicy = cfg.Preemption.VictimOrdering
+	}
+	victimOrdering, err := preemption.NewVictimOrdering(victimOrderingPolicy)
+	if err != nil {
+		setupLog.Error(err, "Unable to set up preemption")
+		os.Exit(1)
+	}
 	sched := scheduler.New(
 		queues,
 		cCache,
 		mgr.GetClient(),
 		mgr.GetEventRecorderFor(constants.AdmissionName),
+		scheduler.WithFairSharing(cfg.FairSharing != nil && cfg.FairSharing.Enable),
+		scheduler.WithVictimOrdering(victimOrdering),
 	)
 	if err := mgr.Add(sched); err != nil {
 		setupLog.Error(err, "Unable to add scheduler to manager")
 		os.Exit(1)
 	}
+	return sched
 }
 
 func setupServerVersionFetcher(mgr ctrl.Manager, kubeConfig *rest.Config) *kubeversion.ServerVersionFetcher {
@@ -349,7 +390,7 @@ func findCustomResources(ctx context.Context, mgr ctrl.Manager) sets.Set[string]
 		setupLog.Error(err, "Unable to get crd list")
 		os.Exit(1)
 	}
-	customResources := sets.New[string](job.FrameworkName)
+	customResources := sets.New[string](job.FrameworkName, pod.FrameworkName)
 	for _, crd := range crds.Items {
 		customResources.Insert(strings.Join([]string{crd.Spec.Group, crd.Spec.Names.Singular}, "/"))
 	}
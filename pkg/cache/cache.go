@@ -24,7 +24,11 @@ import (
 	"sync"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -77,6 +81,26 @@ type Cache struct {
 	assumedWorkloads  map[string]string
 	resourceFlavors   map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor
 	podsReadyTracking bool
+	admissionChecks   map[string]AdmissionCheck
+	// topologies holds the node labels of the levels of each Topology.
+	topologies map[string][]string
+	// tasFlavors holds the nodes of each flavor that refers to a Topology,
+	// sorted by domain.
+	tasFlavors map[kueue.ResourceFlavorReference]*TASFlavorSnapshot
+	// tasNodes holds the nodes and tasNodesUsage the requests of the pods
+	// running in each node without a topology assignment, which are listed
+	// in tasPods.
+	tasNodes      map[string]*tasNodeInfo
+	tasNodesUsage map[string]workload.Requests
+	tasPods       map[types.NamespacedName]tasPodInfo
+}
+
+// AdmissionCheck holds the state of an AdmissionCheck relevant to the
+// ClusterQueues using it.
+type AdmissionCheck struct {
+	// Active indicates whether the controller of the check reported it
+	// as ready to evaluate workloads.
+	Active bool
 }
 
 func New(client client.Client, opts ...Option) *Cache {
@@ -91,6 +115,12 @@ func New(client client.Client, opts ...Option) *Cache {
 		assumedWorkloads:  make(map[string]string),
 		resourceFlavors:   make(map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor),
 		podsReadyTracking: options.podsReadyTracking,
+		admissionChecks:   make(map[string]AdmissionCheck),
+		topologies:        make(map[string][]string),
+		tasFlavors:        make(map[kueue.ResourceFlavorReference]*TASFlavorSnapshot),
+		tasNodes:          make(map[string]*tasNodeInfo),
+		tasNodesUsage:     make(map[string]workload.Requests),
+		tasPods:           make(map[types.NamespacedName]tasPodInfo),
 	}
 	c.podsReadyCond.L = &c.RWMutex
 	return c
@@ -104,7 +134,7 @@ func (c *Cache) newClusterQueue(cq *kueue.ClusterQueue) (*ClusterQueue, error) {
 		localQueues:       make(map[string]*queue),
 		podsReadyTracking: c.podsReadyTracking,
 	}
-	if err := cqImpl.update(cq, c.resourceFlavors); err != nil {
+	if err := cqImpl.update(cq, c.resourceFlavors, c.admissionChecks); err != nil {
 		return nil, err
 	}
 
@@ -203,6 +233,7 @@ func (c *Cache) AddOrUpdateResourceFlavor(rf *kueue.ResourceFlavor) sets.Set[str
 	c.Lock()
 	defer c.Unlock()
 	c.resourceFlavors[kueue.ResourceFlavorReference(rf.Name)] = rf
+	c.updateTASFlavors()
 	return c.updateClusterQueues()
 }
 
@@ -210,9 +241,43 @@ func (c *Cache) DeleteResourceFlavor(rf *kueue.ResourceFlavor) sets.Set[string]
 	c.Lock()
 	defer c.Unlock()
 	delete(c.resourceFlavors, kueue.ResourceFlavorReference(rf.Name))
+	c.updateTASFlavors()
 	return c.updateClusterQueues()
 }
 
+func (c *Cache) updateClusterQueuesWithAdmissionChecks() sets.Set[string] {
+	cqs := sets.New[string]()
+
+	for _, cq := range c.clusterQueues {
+		prevStatus := cq.Status
+		cq.updateWithAdmissionChecks(c.admissionChecks)
+		if prevStatus == pending && cq.Status == active {
+			cqs.Insert(cq.Name)
+		}
+	}
+	return cqs
+}
+
+// AddOrUpdateAdmissionCheck updates the state of the AdmissionCheck and
+// returns the names of the ClusterQueues that became active.
+func (c *Cache) AddOrUpdateAdmissionCheck(ac *kueue.AdmissionCheck) sets.Set[string] {
+	c.Lock()
+	defer c.Unlock()
+	c.admissionChecks[ac.Name] = AdmissionCheck{
+		Active: apimeta.IsStatusConditionTrue(ac.Status.Conditions, kueue.AdmissionCheckActive),
+	}
+	return c.updateClusterQueuesWithAdmissionChecks()
+}
+
+// DeleteAdmissionCheck removes the AdmissionCheck and returns the names of the
+// ClusterQueues that became active.
+func (c *Cache) DeleteAdmissionCheck(ac *kueue.AdmissionCheck) sets.Set[string] {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.admissionChecks, ac.Name)
+	return c.updateClusterQueuesWithAdmissionChecks()
+}
+
 func (c *Cache) ClusterQueueActive(name string) bool {
 	return c.clusterQueueInStatus(name, active)
 }
@@ -232,6 +297,22 @@ func (c *Cache) clusterQueueInStatus(name string, status metrics.ClusterQueueSta
 	return cq != nil && cq.Status == status
 }
 
+// ClusterQueueReadiness returns the status, reason and message of the Active
+// condition of the ClusterQueue.
+func (c *Cache) ClusterQueueReadiness(name string) (metav1.ConditionStatus, string, string) {
+	c.RLock()
+	defer c.RUnlock()
+	cq := c.clusterQueues[name]
+	if cq == nil {
+		return metav1.ConditionFalse, "NotFound", "ClusterQueue not found"
+	}
+	if cq.Active() {
+		return metav1.ConditionTrue, "Ready", "Can admit new workloads"
+	}
+	reason, msg := cq.inactiveReason()
+	return metav1.ConditionFalse, reason, msg
+}
+
 func (c *Cache) TerminateClusterQueue(name string) {
 	c.Lock()
 	defer c.Unlock()
@@ -292,7 +373,7 @@ func (c *Cache) AddClusterQueue(ctx context.Context, cq *kueue.ClusterQueue) err
 		return fmt.Errorf("listing workloads that match the queue: %w", err)
 	}
 	for i, w := range workloads.Items {
-		if !workload.IsAdmitted(&w) {
+		if !workload.HasQuotaReservation(&w) {
 			continue
 		}
 		c.addOrUpdateWorkload(&workloads.Items[i])
@@ -308,7 +389,7 @@ func (c *Cache) UpdateClusterQueue(cq *kueue.ClusterQueue) error {
 	if !ok {
 		return errCqNotFound
 	}
-	if err := cqImpl.update(cq, c.resourceFlavors); err != nil {
+	if err := cqImpl.update(cq, c.resourceFlavors, c.admissionChecks); err != nil {
 		return err
 	}
 	for _, qImpl := range cqImpl.localQueues {
@@ -365,11 +446,14 @@ func (c *Cache) DeleteLocalQueue(q *kueue.LocalQueue) {
 }
 
 func (c *Cache) UpdateLocalQueue(oldQ, newQ *kueue.LocalQueue) error {
+	c.Lock()
+	defer c.Unlock()
 	if oldQ.Spec.ClusterQueue == newQ.Spec.ClusterQueue {
+		if cq, ok := c.clusterQueues[string(newQ.Spec.ClusterQueue)]; ok {
+			cq.updateLocalQueue(newQ)
+		}
 		return nil
 	}
-	c.Lock()
-	defer c.Unlock()
 	cq, ok := c.clusterQueues[string(oldQ.Spec.ClusterQueue)]
 	if ok {
 		cq.deleteLocalQueue(oldQ)
@@ -388,7 +472,7 @@ func (c *Cache) AddOrUpdateWorkload(w *kueue.Workload) bool {
 }
 
 func (c *Cache) addOrUpdateWorkload(w *kueue.Workload) bool {
-	if !workload.IsAdmitted(w) {
+	if !workload.HasQuotaReservation(w) {
 		return false
 	}
 
@@ -412,7 +496,7 @@ func (c *Cache) addOrUpdateWorkload(w *kueue.Workload) bool {
 func (c *Cache) UpdateWorkload(oldWl, newWl *kueue.Workload) error {
 	c.Lock()
 	defer c.Unlock()
-	if workload.IsAdmitted(oldWl) {
+	if workload.HasQuotaReservation(oldWl) {
 		cq, ok := c.clusterQueues[string(oldWl.Status.Admission.ClusterQueue)]
 		if !ok {
 			return fmt.Errorf("old ClusterQueue doesn't exist")
@@ -421,7 +505,7 @@ func (c *Cache) UpdateWorkload(oldWl, newWl *kueue.Workload) error {
 	}
 	c.cleanupAssumedState(oldWl)
 
-	if !workload.IsAdmitted(newWl) {
+	if !workload.HasQuotaReservation(newWl) {
 		return nil
 	}
 	cq, ok := c.clusterQueues[string(newWl.Status.Admission.ClusterQueue)]
@@ -472,7 +556,7 @@ func (c *Cache) AssumeWorkload(w *kueue.Workload) error {
 	c.Lock()
 	defer c.Unlock()
 
-	if !workload.IsAdmitted(w) {
+	if !workload.HasQuotaReservation(w) {
 		return errWorkloadNotAdmitted
 	}
 
@@ -503,7 +587,7 @@ func (c *Cache) ForgetWorkload(w *kueue.Workload) error {
 	}
 	c.cleanupAssumedState(w)
 
-	if !workload.IsAdmitted(w) {
+	if !workload.HasQuotaReservation(w) {
 		return errWorkloadNotAdmitted
 	}
 
@@ -542,12 +626,15 @@ func (c *Cache) Usage(cqObj *kueue.ClusterQueue) ([]kueue.FlavorUsage, int, erro
 					Name:  rName,
 					Total: workload.ResourceQuantity(rName, used),
 				}
-				// Enforce `borrowed=0` if the clusterQueue doesn't belong to a cohort.
+				// Enforce `borrowed=0` and `lent=0` if the clusterQueue doesn't belong to a cohort.
 				if cq.Cohort != nil {
 					borrowed := used - rQuota.Nominal
 					if borrowed > 0 {
 						rUsage.Borrowed = workload.ResourceQuantity(rName, borrowed)
 					}
+					if lent := lentQuota(cq, flvQuotas.Name, rName, rQuota); lent > 0 {
+						rUsage.Lent = workload.ResourceQuantity(rName, lent)
+					}
 				}
 				outFlvUsage.Resources = append(outFlvUsage.Resources, rUsage)
 			}
@@ -561,6 +648,41 @@ func (c *Cache) Usage(cqObj *kueue.ClusterQueue) ([]kueue.FlavorUsage, int, erro
 	return usage, len(cq.Workloads), nil
 }
 
+// lentQuota returns the part of the quota borrowed in the cohort tree that
+// the ClusterQueue lends. The borrowed quota is split between the lenders,
+// including the Cohorts with their own quota, in proportion to the quota that
+// each of them can lend, so the lent quota of the tree adds up to the borrowed
+// quota.
+func lentQuota(cq *ClusterQueue, fName kueue.ResourceFlavorReference, rName corev1.ResourceName, rQuota *ResourceQuota) int64 {
+	lendable := lendableQuota(cq.Usage[fName][rName], rQuota)
+	if lendable == 0 {
+		return 0
+	}
+	root := cq.Cohort.Root()
+	var borrowed, totalLendable int64
+	for _, member := range root.SubtreeMembers() {
+		if memberQuota := member.quota(fName, rName); memberQuota != nil {
+			used := member.Usage[fName][rName]
+			borrowed += positiveDiff(used, memberQuota.Nominal)
+			totalLendable += lendableQuota(used, memberQuota)
+		}
+	}
+	totalLendable += root.subtreeQuota(fName, rName)
+	if borrowed >= totalLendable {
+		return lendable
+	}
+	return borrowed * lendable / totalLendable
+}
+
+// lendableQuota returns the unused nominal quota, up to the lending limit.
+func lendableQuota(used int64, rQuota *ResourceQuota) int64 {
+	lendable := positiveDiff(rQuota.Nominal, used)
+	if limit := rQuota.Nominal - rQuota.guaranteed(); lendable > limit {
+		return limit
+	}
+	return lendable
+}
+
 func (c *Cache) LocalQueueUsage(qObj *kueue.LocalQueue) ([]kueue.LocalQueueFlavorUsage, error) {
 	c.RLock()
 	defer c.RUnlock()
@@ -604,7 +726,7 @@ func (c *Cache) cleanupAssumedState(w *kueue.Workload) {
 	if assumed {
 		// If the workload's assigned ClusterQueue is different from the assumed
 		// one, then we should also cleanup the assumed one.
-		if workload.IsAdmitted(w) && assumedCQName != string(w.Status.Admission.ClusterQueue) {
+		if workload.HasQuotaReservation(w) && assumedCQName != string(w.Status.Admission.ClusterQueue) {
 			if assumedCQ, exist := c.clusterQueues[assumedCQName]; exist {
 				assumedCQ.deleteWorkload(w)
 			}
@@ -614,7 +736,7 @@ func (c *Cache) cleanupAssumedState(w *kueue.Workload) {
 }
 
 func (c *Cache) clusterQueueForWorkload(w *kueue.Workload) *ClusterQueue {
-	if workload.IsAdmitted(w) {
+	if workload.HasQuotaReservation(w) {
 		return c.clusterQueues[string(w.Status.Admission.ClusterQueue)]
 	}
 	wKey := workload.Key(w)
@@ -630,11 +752,7 @@ func (c *Cache) addClusterQueueToCohort(cq *ClusterQueue, cohortName string) {
 	if cohortName == "" {
 		return
 	}
-	cohort, ok := c.cohorts[cohortName]
-	if !ok {
-		cohort = newCohort(cohortName, 1)
-		c.cohorts[cohortName] = cohort
-	}
+	cohort := c.getOrCreateCohort(cohortName)
 	cohort.Members.Insert(cq)
 	cq.Cohort = cohort
 }
@@ -644,12 +762,131 @@ func (c *Cache) deleteClusterQueueFromCohort(cq *ClusterQueue) {
 		return
 	}
 	cq.Cohort.Members.Delete(cq)
-	if cq.Cohort.Members.Len() == 0 {
-		delete(c.cohorts, cq.Cohort.Name)
-	}
+	c.cleanupCohort(cq.Cohort)
 	cq.Cohort = nil
 }
 
+func (c *Cache) getOrCreateCohort(name string) *Cohort {
+	cohort, ok := c.cohorts[name]
+	if !ok {
+		cohort = newCohort(name, 1)
+		c.cohorts[name] = cohort
+	}
+	return cohort
+}
+
+// cleanupCohort forgets the Cohort, and the Cohorts above it, as long as
+// they don't have an object, members or child Cohorts.
+func (c *Cache) cleanupCohort(cohort *Cohort) {
+	if !cohort.isEmpty() {
+		return
+	}
+	delete(c.cohorts, cohort.Name)
+	c.detachFromParent(cohort)
+}
+
+func (c *Cache) detachFromParent(cohort *Cohort) {
+	parent := cohort.Parent
+	if parent == nil {
+		return
+	}
+	parent.ChildCohorts.Delete(cohort)
+	cohort.Parent = nil
+	c.cleanupCohort(parent)
+}
+
+// AddOrUpdateCohort updates the parent and quotas of the Cohort. It returns
+// the names of the ClusterQueues in the trees the Cohort was and is part of.
+// If the new parent would create a cycle, the Cohort is left as a root and
+// an error is returned.
+func (c *Cache) AddOrUpdateCohort(apiCohort *kueue.Cohort) (sets.Set[string], error) {
+	c.Lock()
+	defer c.Unlock()
+	cohort := c.getOrCreateCohort(apiCohort.Name)
+	cqNames := cohort.Root().clusterQueueNames()
+	cohort.hasObject = true
+	cohort.ResourceGroups = newResourceGroups(apiCohort.Spec.ResourceGroups)
+
+	var err error
+	if parentName(cohort) != apiCohort.Spec.Parent {
+		c.detachFromParent(cohort)
+		if apiCohort.Spec.Parent != "" {
+			parent := c.getOrCreateCohort(apiCohort.Spec.Parent)
+			if parent.HasAncestor(cohort) {
+				c.cleanupCohort(parent)
+				err = fmt.Errorf("%w: %s can't be the parent of %s", errCohortCycle, apiCohort.Spec.Parent, apiCohort.Name)
+			} else {
+				parent.ChildCohorts.Insert(cohort)
+				cohort.Parent = parent
+			}
+		}
+	}
+	return cqNames.Union(cohort.Root().clusterQueueNames()), err
+}
+
+// DeleteCohort removes the parent and quotas given by the Cohort object. It
+// returns the names of the ClusterQueues in the tree the Cohort was part of.
+func (c *Cache) DeleteCohort(name string) sets.Set[string] {
+	c.Lock()
+	defer c.Unlock()
+	cohort, ok := c.cohorts[name]
+	if !ok {
+		return nil
+	}
+	cqNames := cohort.Root().clusterQueueNames()
+	cohort.hasObject = false
+	cohort.ResourceGroups = nil
+	c.detachFromParent(cohort)
+	c.cleanupCohort(cohort)
+	return cqNames
+}
+
+func parentName(cohort *Cohort) string {
+	if cohort.Parent == nil {
+		return ""
+	}
+	return cohort.Parent.Name
+}
+
+// AddOrUpdateTopology records the levels of the Topology and returns the
+// names of the ClusterQueues using flavors that refer to it.
+func (c *Cache) AddOrUpdateTopology(t *kueue.Topology) sets.Set[string] {
+	c.Lock()
+	defer c.Unlock()
+	levels := make([]string, len(t.Spec.Levels))
+	for i, l := range t.Spec.Levels {
+		levels[i] = l.NodeLabel
+	}
+	c.topologies[t.Name] = levels
+	c.updateTASFlavors()
+	return c.clusterQueuesUsingTopology(t.Name)
+}
+
+// DeleteTopology removes the Topology and returns the names of the
+// ClusterQueues using flavors that refer to it.
+func (c *Cache) DeleteTopology(name string) sets.Set[string] {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.topologies, name)
+	c.updateTASFlavors()
+	return c.clusterQueuesUsingTopology(name)
+}
+
+func (c *Cache) clusterQueuesUsingTopology(name string) sets.Set[string] {
+	cqs := sets.New[string]()
+	for _, rf := range c.resourceFlavors {
+		if rf.Spec.TopologyName == nil || *rf.Spec.TopologyName != name {
+			continue
+		}
+		for _, cq := range c.clusterQueues {
+			if cq.flavorInUse(rf.Name) {
+				cqs.Insert(cq.Name)
+			}
+		}
+	}
+	return cqs
+}
+
 func (c *Cache) ClusterQueuesUsingFlavor(flavor string) []string {
 	c.RLock()
 	defer c.RUnlock()
@@ -663,6 +900,31 @@ func (c *Cache) ClusterQueuesUsingFlavor(flavor string) []string {
 	return cqs
 }
 
+// ClusterQueueAdmissionChecks returns the set of AdmissionChecks required by the
+// ClusterQueue and whether the ClusterQueue was found.
+func (c *Cache) ClusterQueueAdmissionChecks(name string) (sets.Set[string], bool) {
+	c.RLock()
+	defer c.RUnlock()
+	cq := c.clusterQueues[name]
+	if cq == nil {
+		return nil, false
+	}
+	return cq.AdmissionChecks.Clone(), true
+}
+
+func (c *Cache) ClusterQueuesUsingAdmissionCheck(ac string) []string {
+	c.RLock()
+	defer c.RUnlock()
+	var cqs []string
+
+	for _, cq := range c.clusterQueues {
+		if cq.admissionCheckInUse(ac) {
+			cqs = append(cqs, cq.Name)
+		}
+	}
+	return cqs
+}
+
 func (c *Cache) MatchingClusterQueues(nsLabels map[string]string) sets.Set[string] {
 	c.RLock()
 	defer c.RUnlock()
@@ -111,8 +111,10 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage: FlavorResourceQuantities{
 						"default": {corev1.ResourceCPU: 0},
 					},
-					Status:     active,
-					Preemption: defaultPreemption,
+					Status:            active,
+					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 				"b": {
 					Name: "b",
@@ -132,8 +134,10 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage: FlavorResourceQuantities{
 						"default": {corev1.ResourceCPU: 0},
 					},
-					Status:     active,
-					Preemption: defaultPreemption,
+					Status:            active,
+					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 				"c": {
 					Name:              "c",
@@ -142,6 +146,8 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage:             FlavorResourceQuantities{},
 					Status:            active,
 					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 				"d": {
 					Name:              "d",
@@ -150,6 +156,8 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage:             FlavorResourceQuantities{},
 					Status:            active,
 					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 				"e": {
 					Name: "e",
@@ -168,8 +176,10 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage: FlavorResourceQuantities{
 						"nonexistent-flavor": {corev1.ResourceCPU: 0},
 					},
-					Status:     pending,
-					Preemption: defaultPreemption,
+					Status:            pending,
+					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 			},
 			wantCohorts: map[string]sets.Set[string]{
@@ -197,6 +207,8 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 						ReclaimWithinCohort: kueue.PreemptionPolicyLowerPriority,
 						WithinClusterQueue:  kueue.PreemptionPolicyLowerPriority,
 					},
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 			},
 		},
@@ -233,8 +245,10 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage: FlavorResourceQuantities{
 						"default": {corev1.ResourceCPU: 0},
 					},
-					Status:     active,
-					Preemption: defaultPreemption,
+					Status:            active,
+					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 				"b": {
 					Name: "b",
@@ -254,8 +268,10 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage: FlavorResourceQuantities{
 						"default": {corev1.ResourceCPU: 0},
 					},
-					Status:     active,
-					Preemption: defaultPreemption,
+					Status:            active,
+					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 				"c": {
 					Name:              "c",
@@ -264,6 +280,8 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage:             FlavorResourceQuantities{},
 					Status:            active,
 					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 				"d": {
 					Name:              "d",
@@ -272,6 +290,8 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage:             FlavorResourceQuantities{},
 					Status:            active,
 					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 				"e": {
 					Name: "e",
@@ -292,8 +312,10 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage: FlavorResourceQuantities{
 						"nonexistent-flavor": {corev1.ResourceCPU: 0},
 					},
-					Status:     pending,
-					Preemption: defaultPreemption,
+					Status:            pending,
+					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 			},
 			wantCohorts: map[string]sets.Set[string]{
@@ -354,8 +376,10 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage: FlavorResourceQuantities{
 						"default": {corev1.ResourceCPU: 0},
 					},
-					Status:     active,
-					Preemption: defaultPreemption,
+					Status:            active,
+					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 				"b": {
 					Name:              "b",
@@ -364,6 +388,8 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage:             FlavorResourceQuantities{},
 					Status:            active,
 					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 				"c": {
 					Name:              "c",
@@ -372,6 +398,8 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage:             FlavorResourceQuantities{},
 					Status:            active,
 					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 				"d": {
 					Name:              "d",
@@ -380,6 +408,8 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage:             FlavorResourceQuantities{},
 					Status:            active,
 					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 				"e": {
 					Name: "e",
@@ -400,8 +430,10 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage: FlavorResourceQuantities{
 						"default": {corev1.ResourceCPU: 0},
 					},
-					Status:     active,
-					Preemption: defaultPreemption,
+					Status:            active,
+					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 			},
 			wantCohorts: map[string]sets.Set[string]{
@@ -438,8 +470,10 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage: FlavorResourceQuantities{
 						"default": {corev1.ResourceCPU: 0},
 					},
-					Status:     active,
-					Preemption: defaultPreemption,
+					Status:            active,
+					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 				"c": {
 					Name:              "c",
@@ -448,6 +482,8 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage:             FlavorResourceQuantities{},
 					Status:            active,
 					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 				"e": {
 					Name: "e",
@@ -468,8 +504,10 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage: FlavorResourceQuantities{
 						"nonexistent-flavor": {corev1.ResourceCPU: 0},
 					},
-					Status:     pending,
-					Preemption: defaultPreemption,
+					Status:            pending,
+					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 			},
 			wantCohorts: map[string]sets.Set[string]{
@@ -505,8 +543,10 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage: FlavorResourceQuantities{
 						"default": {corev1.ResourceCPU: 0},
 					},
-					Status:     active,
-					Preemption: defaultPreemption,
+					Status:            active,
+					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 				"b": {
 					Name: "b",
@@ -526,8 +566,10 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage: FlavorResourceQuantities{
 						"default": {corev1.ResourceCPU: 0},
 					},
-					Status:     active,
-					Preemption: defaultPreemption,
+					Status:            active,
+					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 				"c": {
 					Name:              "c",
@@ -536,6 +578,8 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage:             FlavorResourceQuantities{},
 					Status:            active,
 					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 				"d": {
 					Name:              "d",
@@ -544,6 +588,8 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage:             FlavorResourceQuantities{},
 					Status:            active,
 					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 				"e": {
 					Name: "e",
@@ -562,6 +608,8 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 					Usage:             FlavorResourceQuantities{"nonexistent-flavor": {corev1.ResourceCPU: 0}},
 					Status:            active,
 					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 			},
 			wantCohorts: map[string]sets.Set[string]{
@@ -651,8 +699,10 @@ func TestCacheClusterQueueOperations(t *testing.T) {
 							"example.com/gpu": 0,
 						},
 					},
-					Status:     pending,
-					Preemption: defaultPreemption,
+					Status:            pending,
+					Preemption:        defaultPreemption,
+					FairWeight:        defaultFairWeight,
+					FlavorFungibility: defaultFlavorFungibility,
 				},
 			},
 		},
@@ -1495,6 +1545,125 @@ func TestClusterQueueUsage(t *testing.T) {
 	}
 }
 
+func TestLendingLimit(t *testing.T) {
+	ctx := context.Background()
+	cache := New(utiltesting.NewFakeClient())
+	cache.AddOrUpdateResourceFlavor(utiltesting.MakeResourceFlavor("default").Obj())
+	lender := utiltesting.MakeClusterQueue("lender").
+		Cohort("cohort").
+		ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10", "", "4").Obj()).
+		Obj()
+	borrower := utiltesting.MakeClusterQueue("borrower").
+		Cohort("cohort").
+		ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "2").Obj()).
+		Obj()
+	for _, cq := range []*kueue.ClusterQueue{lender, borrower} {
+		if err := cache.AddClusterQueue(ctx, cq); err != nil {
+			t.Fatalf("Adding ClusterQueue %s: %v", cq.Name, err)
+		}
+	}
+	lenderWl := workload.NewInfo(utiltesting.MakeWorkload("lender-wl", "").
+		Request(corev1.ResourceCPU, "3").
+		ReserveQuota(utiltesting.MakeAdmission("lender").Assignment(corev1.ResourceCPU, "default", "3").Obj()).
+		Obj())
+	borrowerWl := workload.NewInfo(utiltesting.MakeWorkload("borrower-wl", "").
+		Request(corev1.ResourceCPU, "5").
+		ReserveQuota(utiltesting.MakeAdmission("borrower").Assignment(corev1.ResourceCPU, "default", "5").Obj()).
+		Obj())
+	cache.AddOrUpdateWorkload(lenderWl.Obj)
+
+	wantAvailable := func(snapshot *Snapshot, cqName string, want int64) {
+		t.Helper()
+		if got := snapshot.ClusterQueues[cqName].Available("default", corev1.ResourceCPU); got != want {
+			t.Errorf("Unexpected available quota for ClusterQueue %s, want=%d, got=%d", cqName, want, got)
+		}
+	}
+
+	snapshot := cache.Snapshot()
+	wantRequestable := FlavorResourceQuantities{"default": {corev1.ResourceCPU: 6_000}}
+	if diff := cmp.Diff(wantRequestable, snapshot.ClusterQueues["lender"].Cohort.RequestableResources); diff != "" {
+		t.Errorf("Unexpected requestable resources in the cohort (-want,+got):\n%s", diff)
+	}
+	// 4 lent by lender and 2 from borrower, plus the 6 that lender doesn't lend
+	// and only partially uses.
+	wantAvailable(&snapshot, "lender", 9_000)
+	wantAvailable(&snapshot, "borrower", 6_000)
+
+	snapshot.AddWorkload(borrowerWl)
+	wantAvailable(&snapshot, "lender", 4_000)
+	wantAvailable(&snapshot, "borrower", 1_000)
+	snapshot.RemoveWorkload(lenderWl)
+	wantAvailable(&snapshot, "lender", 7_000)
+	wantAvailable(&snapshot, "borrower", 1_000)
+
+	cache.AddOrUpdateWorkload(borrowerWl.Obj)
+	usage, _, err := cache.Usage(lender)
+	if err != nil {
+		t.Fatalf("Couldn't get usage: %v", err)
+	}
+	wantUsage := []kueue.FlavorUsage{{
+		Name: "default",
+		Resources: []kueue.ResourceUsage{{
+			Name:  corev1.ResourceCPU,
+			Total: resource.MustParse("3"),
+			Lent:  resource.MustParse("3"),
+		}},
+	}}
+	if diff := cmp.Diff(wantUsage, usage); diff != "" {
+		t.Errorf("Unexpected usage of the lender (-want,+got):\n%s", diff)
+	}
+}
+
+func TestLentQuotaSplitBetweenLenders(t *testing.T) {
+	ctx := context.Background()
+	cache := New(utiltesting.NewFakeClient())
+	cache.AddOrUpdateResourceFlavor(utiltesting.MakeResourceFlavor("default").Obj())
+	cqs := []*kueue.ClusterQueue{
+		utiltesting.MakeClusterQueue("lender-a").
+			Cohort("cohort").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "6").Obj()).
+			Obj(),
+		utiltesting.MakeClusterQueue("lender-b").
+			Cohort("cohort").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "4").Obj()).
+			Obj(),
+		utiltesting.MakeClusterQueue("borrower").
+			Cohort("cohort").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "0").Obj()).
+			Obj(),
+	}
+	for _, cq := range cqs {
+		if err := cache.AddClusterQueue(ctx, cq); err != nil {
+			t.Fatalf("Adding ClusterQueue %s: %v", cq.Name, err)
+		}
+	}
+	cache.AddOrUpdateWorkload(utiltesting.MakeWorkload("lender-b-wl", "").
+		Request(corev1.ResourceCPU, "2").
+		ReserveQuota(utiltesting.MakeAdmission("lender-b").Assignment(corev1.ResourceCPU, "default", "2").Obj()).
+		Obj())
+	cache.AddOrUpdateWorkload(utiltesting.MakeWorkload("borrower-wl", "").
+		Request(corev1.ResourceCPU, "4").
+		ReserveQuota(utiltesting.MakeAdmission("borrower").Assignment(corev1.ResourceCPU, "default", "4").Obj()).
+		Obj())
+
+	// lender-a can lend 6 and lender-b can lend 2, so they lend 3 and 1 of
+	// the 4 borrowed.
+	wantLent := map[string]string{
+		"lender-a": "3",
+		"lender-b": "1",
+		"borrower": "0",
+	}
+	for _, cq := range cqs {
+		usage, _, err := cache.Usage(cq)
+		if err != nil {
+			t.Fatalf("Couldn't get usage of ClusterQueue %s: %v", cq.Name, err)
+		}
+		if got, want := usage[0].Resources[0].Lent, resource.MustParse(wantLent[cq.Name]); got.Cmp(want) != 0 {
+			t.Errorf("Unexpected lent quota for ClusterQueue %s, want=%s, got=%s", cq.Name, want.String(), got.String())
+		}
+	}
+}
+
 func TestLocalQueueUsage(t *testing.T) {
 	cq := *utiltesting.MakeClusterQueue("foo").
 		ResourceGroup(
@@ -2113,6 +2282,146 @@ func TestClusterQueuesUsingFlavor(t *testing.T) {
 	}
 }
 
+func TestClusterQueueReadiness(t *testing.T) {
+	baseFlavor := utiltesting.MakeResourceFlavor("flavor1").Obj()
+	baseCheck := utiltesting.MakeAdmissionCheck("check1").Active(metav1.ConditionTrue).Obj()
+	baseQueue := utiltesting.MakeClusterQueue("queue1").
+		ResourceGroup(
+			*utiltesting.MakeFlavorQuotas(baseFlavor.Name).Resource("cpu", "5").Obj()).
+		AdmissionChecks(baseCheck.Name).
+		Obj()
+
+	cases := map[string]struct {
+		clusterQueueName string
+		terminate        bool
+		stopPolicy       kueue.StopPolicy
+		flavors          []*kueue.ResourceFlavor
+		admissionChecks  []*kueue.AdmissionCheck
+		wantStatus       metav1.ConditionStatus
+		wantReason       string
+		wantMessage      string
+	}{
+		"queue not found": {
+			clusterQueueName: "queue2",
+			wantStatus:       metav1.ConditionFalse,
+			wantReason:       "NotFound",
+			wantMessage:      "ClusterQueue not found",
+		},
+		"flavor not found": {
+			clusterQueueName: "queue1",
+			admissionChecks:  []*kueue.AdmissionCheck{baseCheck},
+			wantStatus:       metav1.ConditionFalse,
+			wantReason:       "FlavorNotFound",
+			wantMessage:      "Can't admit new workloads; some flavors are not found",
+		},
+		"check not found": {
+			clusterQueueName: "queue1",
+			flavors:          []*kueue.ResourceFlavor{baseFlavor},
+			wantStatus:       metav1.ConditionFalse,
+			wantReason:       "CheckNotFoundOrInactive",
+			wantMessage:      "Can't admit new workloads; some admission checks are not found or inactive",
+		},
+		"check inactive": {
+			clusterQueueName: "queue1",
+			flavors:          []*kueue.ResourceFlavor{baseFlavor},
+			admissionChecks:  []*kueue.AdmissionCheck{utiltesting.MakeAdmissionCheck("check1").Active(metav1.ConditionFalse).Obj()},
+			wantStatus:       metav1.ConditionFalse,
+			wantReason:       "CheckNotFoundOrInactive",
+			wantMessage:      "Can't admit new workloads; some admission checks are not found or inactive",
+		},
+		"terminating": {
+			clusterQueueName: "queue1",
+			flavors:          []*kueue.ResourceFlavor{baseFlavor},
+			admissionChecks:  []*kueue.AdmissionCheck{baseCheck},
+			terminate:        true,
+			wantStatus:       metav1.ConditionFalse,
+			wantReason:       "Terminating",
+			wantMessage:      "Can't admit new workloads; clusterQueue is terminating",
+		},
+		"stopped": {
+			clusterQueueName: "queue1",
+			flavors:          []*kueue.ResourceFlavor{baseFlavor},
+			admissionChecks:  []*kueue.AdmissionCheck{baseCheck},
+			stopPolicy:       kueue.Hold,
+			wantStatus:       metav1.ConditionFalse,
+			wantReason:       "Stopped",
+			wantMessage:      "Can't admit new workloads; clusterQueue is stopped",
+		},
+		"ready": {
+			clusterQueueName: "queue1",
+			flavors:          []*kueue.ResourceFlavor{baseFlavor},
+			admissionChecks:  []*kueue.AdmissionCheck{baseCheck},
+			wantStatus:       metav1.ConditionTrue,
+			wantReason:       "Ready",
+			wantMessage:      "Can admit new workloads",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cache := New(utiltesting.NewFakeClient())
+			for _, rf := range tc.flavors {
+				cache.AddOrUpdateResourceFlavor(rf)
+			}
+			for _, ac := range tc.admissionChecks {
+				cache.AddOrUpdateAdmissionCheck(ac)
+			}
+			cq := baseQueue.DeepCopy()
+			if tc.stopPolicy != "" {
+				cq.Spec.StopPolicy = &tc.stopPolicy
+			}
+			if err := cache.AddClusterQueue(context.Background(), cq); err != nil {
+				t.Fatalf("Failed adding clusterQueue: %v", err)
+			}
+			if tc.terminate {
+				cache.TerminateClusterQueue(baseQueue.Name)
+			}
+
+			gotStatus, gotReason, gotMessage := cache.ClusterQueueReadiness(tc.clusterQueueName)
+			if diff := cmp.Diff(tc.wantStatus, gotStatus); diff != "" {
+				t.Errorf("Unexpected status (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantReason, gotReason); diff != "" {
+				t.Errorf("Unexpected reason (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantMessage, gotMessage); diff != "" {
+				t.Errorf("Unexpected message (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAdmissionCheckActivatesClusterQueues(t *testing.T) {
+	cache := New(utiltesting.NewFakeClient())
+	fooCq := utiltesting.MakeClusterQueue("fooCq").AdmissionChecks("check1").Obj()
+	barCq := utiltesting.MakeClusterQueue("barCq").AdmissionChecks("check1", "check2").Obj()
+	for _, cq := range []*kueue.ClusterQueue{fooCq, barCq} {
+		if err := cache.AddClusterQueue(context.Background(), cq); err != nil {
+			t.Fatalf("Failed adding clusterQueue %s: %v", cq.Name, err)
+		}
+	}
+
+	if diff := cmp.Diff([]string{"barCq", "fooCq"}, cache.ClusterQueuesUsingAdmissionCheck("check1"), cmpopts.SortSlices(func(a, b string) bool {
+		return a < b
+	})); diff != "" {
+		t.Errorf("Unexpected clusterQueues using the admission check (-want,+got):\n%s", diff)
+	}
+
+	activated := cache.AddOrUpdateAdmissionCheck(utiltesting.MakeAdmissionCheck("check1").Active(metav1.ConditionTrue).Obj())
+	if diff := cmp.Diff(sets.New("fooCq"), activated); diff != "" {
+		t.Errorf("Unexpected activated clusterQueues (-want,+got):\n%s", diff)
+	}
+
+	activated = cache.AddOrUpdateAdmissionCheck(utiltesting.MakeAdmissionCheck("check2").Active(metav1.ConditionTrue).Obj())
+	if diff := cmp.Diff(sets.New("barCq"), activated); diff != "" {
+		t.Errorf("Unexpected activated clusterQueues (-want,+got):\n%s", diff)
+	}
+
+	cache.DeleteAdmissionCheck(utiltesting.MakeAdmissionCheck("check1").Obj())
+	if cache.ClusterQueueActive("fooCq") || cache.ClusterQueueActive("barCq") {
+		t.Errorf("ClusterQueues should be inactive after deleting the admission check")
+	}
+}
+
 func TestMatchingClusterQueues(t *testing.T) {
 	clusterQueues := []*kueue.ClusterQueue{
 		utiltesting.MakeClusterQueue("matching1").
@@ -3,9 +3,11 @@ package cache
 import (
 	"errors"
 	"fmt"
+	"math"
 
 	corev1 "k8s.io/api/core/v1"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -32,23 +34,24 @@ type ClusterQueue struct {
 	WorkloadsNotReady sets.Set[string]
 	NamespaceSelector labels.Selector
 	Preemption        kueue.ClusterQueuePreemption
+	FlavorFungibility kueue.FlavorFungibility
 	Status            metrics.ClusterQueueStatus
+	AdmissionChecks   sets.Set[string]
+	// FairWeight is the weight of the ClusterQueue when calculating its share
+	// for fair sharing.
+	FairWeight resource.Quantity
+	// LocalQueueLimits holds the limits of the LocalQueues that set them,
+	// keyed by namespace/name. Only populated in a snapshot.
+	LocalQueueLimits map[string]*LocalQueueLimits
 
 	// The following fields are not populated in a snapshot.
 
 	// Key is localQueue's key (namespace/name).
-	localQueues       map[string]*queue
-	podsReadyTracking bool
-}
-
-// Cohort is a set of ClusterQueues that can borrow resources from each other.
-type Cohort struct {
-	Name    string
-	Members sets.Set[*ClusterQueue]
-
-	// These fields are only populated for a snapshot.
-	RequestableResources FlavorResourceQuantities
-	Usage                FlavorResourceQuantities
+	localQueues                        map[string]*queue
+	podsReadyTracking                  bool
+	hasMissingFlavors                  bool
+	hasMissingOrInactiveAdmissionCheck bool
+	isStopped                          bool
 }
 
 type ResourceGroup struct {
@@ -69,30 +72,32 @@ type FlavorQuotas struct {
 type ResourceQuota struct {
 	Nominal        int64
 	BorrowingLimit *int64
+	LendingLimit   *int64
+}
+
+// guaranteed returns the part of the nominal quota that can't be lent to
+// other ClusterQueues in the cohort.
+func (q *ResourceQuota) guaranteed() int64 {
+	if q == nil || q.LendingLimit == nil {
+		return 0
+	}
+	return q.Nominal - *q.LendingLimit
 }
 
 type FlavorResourceQuantities map[kueue.ResourceFlavorReference]map[corev1.ResourceName]int64
 
+// LocalQueueLimits holds the limits of a LocalQueue within its ClusterQueue,
+// along with the usage of the LocalQueue.
+type LocalQueueLimits struct {
+	Limits FlavorResourceQuantities
+	Usage  FlavorResourceQuantities
+}
+
 type queue struct {
 	key               string
 	admittedWorkloads int
 	usage             FlavorResourceQuantities
-}
-
-func newCohort(name string, size int) *Cohort {
-	return &Cohort{
-		Name:    name,
-		Members: make(sets.Set[*ClusterQueue], size),
-	}
-}
-
-func (c *Cohort) HasBorrowingQueues() bool {
-	for cq := range c.Members {
-		if cq.IsBorrowing() {
-			return true
-		}
-	}
-	return false
+	limits            FlavorResourceQuantities
 }
 
 func (c *ClusterQueue) IsBorrowing() bool {
@@ -114,6 +119,112 @@ func (c *ClusterQueue) IsBorrowing() bool {
 	return false
 }
 
+// Available returns the quantity of the resource in the flavor that a
+// workload in the ClusterQueue can use: the unused quota in the cohort tree,
+// up to the borrowing limits, plus the unused quota that the ClusterQueue
+// doesn't lend. It is only meaningful for a snapshot of a ClusterQueue in a
+// cohort.
+func (c *ClusterQueue) Available(fName kueue.ResourceFlavorReference, rName corev1.ResourceName) int64 {
+	return c.Cohort.Available(fName, rName) + positiveDiff(c.guaranteedQuota(fName, rName), c.Usage[fName][rName])
+}
+
+// Requestable returns the quantity of the resource in the flavor that a
+// workload in the ClusterQueue could use if nothing else was using quota in
+// the cohort tree: the quota of the tree, up to the borrowing limits of the
+// cohorts, plus the quota that the ClusterQueue doesn't lend. It is only
+// meaningful for a snapshot of a ClusterQueue in a cohort.
+func (c *ClusterQueue) Requestable(fName kueue.ResourceFlavorReference, rName corev1.ResourceName) int64 {
+	return c.Cohort.requestable(fName, rName) + c.guaranteedQuota(fName, rName)
+}
+
+// guaranteedQuota returns the part of the nominal quota of the resource in
+// the flavor that the ClusterQueue doesn't lend.
+func (c *ClusterQueue) guaranteedQuota(fName kueue.ResourceFlavorReference, rName corev1.ResourceName) int64 {
+	return c.quota(fName, rName).guaranteed()
+}
+
+func (c *ClusterQueue) quota(fName kueue.ResourceFlavorReference, rName corev1.ResourceName) *ResourceQuota {
+	rg := c.RGByResource[rName]
+	if rg == nil {
+		return nil
+	}
+	for _, flvQuotas := range rg.Flavors {
+		if flvQuotas.Name == fName {
+			return flvQuotas.Resources[rName]
+		}
+	}
+	return nil
+}
+
+// positiveDiff returns a-b if it is positive, zero otherwise.
+func positiveDiff(a, b int64) int64 {
+	if a > b {
+		return a - b
+	}
+	return 0
+}
+
+// DominantResourceShare returns a value representing the maximum of the
+// ratios of usage above nominal quota to the quota in the cohort tree, among
+// all the resources provided by the ClusterQueue, divided by its weight.
+// A value of 1000 means that the ClusterQueue, with weight 1, borrows all the
+// quota of the tree for the resource. Zero means that the usage of the
+// ClusterQueue is below its nominal quota.
+// The function also returns the resource name that yielded this value.
+// It is only meaningful for a snapshot.
+func (c *ClusterQueue) DominantResourceShare() (int, corev1.ResourceName) {
+	return c.DominantResourceShareWith(nil)
+}
+
+// DominantResourceShareWith returns the dominant resource share of the
+// ClusterQueue, as calculated by DominantResourceShare, if the given requests
+// were added to its usage.
+func (c *ClusterQueue) DominantResourceShareWith(wlReq FlavorResourceQuantities) (int, corev1.ResourceName) {
+	if c.Cohort == nil {
+		return 0, ""
+	}
+	borrowing := make(map[corev1.ResourceName]int64)
+	for _, rg := range c.ResourceGroups {
+		for _, flvQuotas := range rg.Flavors {
+			for rName, rQuota := range flvQuotas.Resources {
+				b := c.Usage[flvQuotas.Name][rName] + wlReq[flvQuotas.Name][rName] - rQuota.Nominal
+				if b > 0 {
+					borrowing[rName] += b
+				}
+			}
+		}
+	}
+	if len(borrowing) == 0 {
+		return 0, ""
+	}
+
+	root := c.Cohort.Root()
+	var drs int64 = -1
+	var dRes corev1.ResourceName
+	for rName, b := range borrowing {
+		var total int64
+		for _, resQuantities := range root.RequestableResources {
+			total += resQuantities[rName]
+		}
+		if total == 0 {
+			continue
+		}
+		ratio := b * 1000 / total
+		// Ties are broken by name for a deterministic result.
+		if ratio > drs || (ratio == drs && rName < dRes) {
+			drs = ratio
+			dRes = rName
+		}
+	}
+	if drs < 0 {
+		return 0, ""
+	}
+	if c.FairWeight.IsZero() {
+		return math.MaxInt, dRes
+	}
+	return int(drs * 1000 / c.FairWeight.MilliValue()), dRes
+}
+
 func (c *ClusterQueue) Active() bool {
 	return c.Status == active
 }
@@ -123,7 +234,14 @@ var defaultPreemption = kueue.ClusterQueuePreemption{
 	WithinClusterQueue:  kueue.PreemptionPolicyNever,
 }
 
-func (c *ClusterQueue) update(in *kueue.ClusterQueue, resourceFlavors map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor) error {
+var defaultFairWeight = resource.MustParse("1")
+
+var defaultFlavorFungibility = kueue.FlavorFungibility{
+	WhenCanBorrow:  kueue.Borrow,
+	WhenCanPreempt: kueue.TryNextFlavor,
+}
+
+func (c *ClusterQueue) update(in *kueue.ClusterQueue, resourceFlavors map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor, admissionChecks map[string]AdmissionCheck) error {
 	c.updateResourceGroups(in.Spec.ResourceGroups)
 	nsSelector, err := metav1.LabelSelectorAsSelector(in.Spec.NamespaceSelector)
 	if err != nil {
@@ -144,7 +262,10 @@ func (c *ClusterQueue) update(in *kueue.ClusterQueue, resourceFlavors map[kueue.
 		}
 	}
 	c.Usage = usedFlavorResources
+	c.AdmissionChecks = sets.New(in.Spec.AdmissionChecks...)
+	c.isStopped = in.Spec.StopPolicy != nil && *in.Spec.StopPolicy != kueue.None
 	c.UpdateWithFlavors(resourceFlavors)
+	c.updateWithAdmissionChecks(admissionChecks)
 
 	if in.Spec.Preemption != nil {
 		c.Preemption = *in.Spec.Preemption
@@ -152,13 +273,36 @@ func (c *ClusterQueue) update(in *kueue.ClusterQueue, resourceFlavors map[kueue.
 		c.Preemption = defaultPreemption
 	}
 
+	if in.Spec.FlavorFungibility != nil {
+		c.FlavorFungibility = *in.Spec.FlavorFungibility
+		if c.FlavorFungibility.WhenCanBorrow == "" {
+			c.FlavorFungibility.WhenCanBorrow = defaultFlavorFungibility.WhenCanBorrow
+		}
+		if c.FlavorFungibility.WhenCanPreempt == "" {
+			c.FlavorFungibility.WhenCanPreempt = defaultFlavorFungibility.WhenCanPreempt
+		}
+	} else {
+		c.FlavorFungibility = defaultFlavorFungibility
+	}
+
+	if in.Spec.FairSharing != nil && in.Spec.FairSharing.Weight != nil {
+		c.FairWeight = *in.Spec.FairSharing.Weight
+	} else {
+		c.FairWeight = defaultFairWeight
+	}
+
 	return nil
 }
 
 func (c *ClusterQueue) updateResourceGroups(in []kueue.ResourceGroup) {
-	c.ResourceGroups = make([]ResourceGroup, len(in))
+	c.ResourceGroups = newResourceGroups(in)
+	c.UpdateRGByResource()
+}
+
+func newResourceGroups(in []kueue.ResourceGroup) []ResourceGroup {
+	out := make([]ResourceGroup, len(in))
 	for i, rgIn := range in {
-		rg := &c.ResourceGroups[i]
+		rg := &out[i]
 		*rg = ResourceGroup{
 			CoveredResources: sets.New(rgIn.CoveredResources...),
 			Flavors:          make([]FlavorQuotas, 0, len(rgIn.Flavors)),
@@ -176,12 +320,15 @@ func (c *ClusterQueue) updateResourceGroups(in []kueue.ResourceGroup) {
 				if rIn.BorrowingLimit != nil {
 					rQuota.BorrowingLimit = pointer.Int64(workload.ResourceValue(rIn.Name, *rIn.BorrowingLimit))
 				}
+				if rIn.LendingLimit != nil {
+					rQuota.LendingLimit = pointer.Int64(workload.ResourceValue(rIn.Name, *rIn.LendingLimit))
+				}
 				fQuotas.Resources[rIn.Name] = &rQuota
 			}
 			rg.Flavors = append(rg.Flavors, fQuotas)
 		}
 	}
-	c.UpdateRGByResource()
+	return out
 }
 
 func (c *ClusterQueue) UpdateRGByResource() {
@@ -197,8 +344,26 @@ func (c *ClusterQueue) UpdateRGByResource() {
 // UpdateWithFlavors updates a ClusterQueue based on the passed ResourceFlavors set.
 // Exported only for testing.
 func (c *ClusterQueue) UpdateWithFlavors(flavors map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor) {
+	c.hasMissingFlavors = c.updateLabelKeys(flavors)
+	c.updateQueueStatus()
+}
+
+// updateWithAdmissionChecks updates a ClusterQueue based on the passed AdmissionChecks set.
+func (c *ClusterQueue) updateWithAdmissionChecks(checks map[string]AdmissionCheck) {
+	hasMissing := false
+	for acName := range c.AdmissionChecks {
+		if ac, found := checks[acName]; !found || !ac.Active {
+			hasMissing = true
+			break
+		}
+	}
+	c.hasMissingOrInactiveAdmissionCheck = hasMissing
+	c.updateQueueStatus()
+}
+
+func (c *ClusterQueue) updateQueueStatus() {
 	status := active
-	if flavorNotFound := c.updateLabelKeys(flavors); flavorNotFound {
+	if c.hasMissingFlavors || c.hasMissingOrInactiveAdmissionCheck || c.isStopped {
 		status = pending
 	}
 
@@ -208,6 +373,26 @@ func (c *ClusterQueue) UpdateWithFlavors(flavors map[kueue.ResourceFlavorReferen
 	metrics.ReportClusterQueueStatus(c.Name, c.Status)
 }
 
+// inactiveReason returns the reason and message explaining why the
+// ClusterQueue is not active.
+func (c *ClusterQueue) inactiveReason() (string, string) {
+	switch c.Status {
+	case terminating:
+		return "Terminating", "Can't admit new workloads; clusterQueue is terminating"
+	case pending:
+		if c.isStopped {
+			return "Stopped", "Can't admit new workloads; clusterQueue is stopped"
+		}
+		if c.hasMissingFlavors {
+			return "FlavorNotFound", "Can't admit new workloads; some flavors are not found"
+		}
+		if c.hasMissingOrInactiveAdmissionCheck {
+			return "CheckNotFoundOrInactive", "Can't admit new workloads; some admission checks are not found or inactive"
+		}
+	}
+	return "Unknown", "Can't admit new workloads."
+}
+
 func (c *ClusterQueue) updateLabelKeys(flavors map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor) bool {
 	var flavorNotFound bool
 	for i := range c.ResourceGroups {
@@ -300,6 +485,7 @@ func (c *ClusterQueue) addLocalQueue(q *kueue.LocalQueue) error {
 		key:               qKey,
 		admittedWorkloads: 0,
 		usage:             make(FlavorResourceQuantities),
+		limits:            flavorLimits(q),
 	}
 	if err := qImpl.resetFlavorsAndResources(c.Usage); err != nil {
 		return err
@@ -314,11 +500,21 @@ func (c *ClusterQueue) addLocalQueue(q *kueue.LocalQueue) error {
 	return nil
 }
 
+func (c *ClusterQueue) updateLocalQueue(q *kueue.LocalQueue) {
+	if qImpl, ok := c.localQueues[queueKey(q)]; ok {
+		qImpl.limits = flavorLimits(q)
+	}
+}
+
 func (c *ClusterQueue) deleteLocalQueue(q *kueue.LocalQueue) {
 	qKey := queueKey(q)
 	delete(c.localQueues, qKey)
 }
 
+func (c *ClusterQueue) admissionCheckInUse(check string) bool {
+	return c.AdmissionChecks.Has(check)
+}
+
 func (c *ClusterQueue) flavorInUse(flavor string) bool {
 	for _, rg := range c.ResourceGroups {
 		for _, f := range rg.Flavors {
@@ -345,6 +541,21 @@ func (q *queue) resetFlavorsAndResources(cqUsage FlavorResourceQuantities) error
 	return nil
 }
 
+func flavorLimits(q *kueue.LocalQueue) FlavorResourceQuantities {
+	if len(q.Spec.FlavorLimits) == 0 {
+		return nil
+	}
+	limits := make(FlavorResourceQuantities, len(q.Spec.FlavorLimits))
+	for _, fl := range q.Spec.FlavorLimits {
+		resLimits := make(map[corev1.ResourceName]int64, len(fl.Resources))
+		for _, rl := range fl.Resources {
+			resLimits[rl.Name] = workload.ResourceValue(rl.Name, rl.Max)
+		}
+		limits[fl.Name] = resLimits
+	}
+	return limits
+}
+
 func workloadBelongsToLocalQueue(wl *kueue.Workload, q *kueue.LocalQueue) bool {
 	return wl.Namespace == q.Namespace && wl.Spec.QueueName == q.Name
 }
@@ -1,8 +1,12 @@
 package cache
 
 import (
+	"math"
 	"testing"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/metrics"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
@@ -66,3 +70,168 @@ func TestClusterQueueUpdateWithFlavors(t *testing.T) {
 		})
 	}
 }
+
+func TestDominantResourceShare(t *testing.T) {
+	cases := map[string]struct {
+		cq      ClusterQueue
+		wlReq   FlavorResourceQuantities
+		wantDRS int
+		wantRes corev1.ResourceName
+	}{
+		"no cohort": {
+			cq: ClusterQueue{
+				FairWeight:        defaultFairWeight,
+				FlavorFungibility: defaultFlavorFungibility,
+				Usage: FlavorResourceQuantities{
+					"default": {corev1.ResourceCPU: 2_000},
+				},
+				ResourceGroups: []ResourceGroup{{
+					Flavors: []FlavorQuotas{{
+						Name: "default",
+						Resources: map[corev1.ResourceName]*ResourceQuota{
+							corev1.ResourceCPU: {Nominal: 1_000},
+						},
+					}},
+				}},
+			},
+		},
+		"usage below nominal": {
+			cq: ClusterQueue{
+				FairWeight:        defaultFairWeight,
+				FlavorFungibility: defaultFlavorFungibility,
+				Usage: FlavorResourceQuantities{
+					"default": {corev1.ResourceCPU: 1_000},
+				},
+				ResourceGroups: []ResourceGroup{{
+					Flavors: []FlavorQuotas{{
+						Name: "default",
+						Resources: map[corev1.ResourceName]*ResourceQuota{
+							corev1.ResourceCPU: {Nominal: 2_000},
+						},
+					}},
+				}},
+				Cohort: &Cohort{
+					RequestableResources: FlavorResourceQuantities{
+						"default": {corev1.ResourceCPU: 10_000},
+					},
+				},
+			},
+		},
+		"borrowing the dominant resource": {
+			cq: ClusterQueue{
+				FairWeight:        defaultFairWeight,
+				FlavorFungibility: defaultFlavorFungibility,
+				Usage: FlavorResourceQuantities{
+					"on-demand": {corev1.ResourceCPU: 6_000, corev1.ResourceMemory: 6 * utiltesting.Gi},
+					"spot":      {corev1.ResourceCPU: 2_000},
+				},
+				ResourceGroups: []ResourceGroup{{
+					Flavors: []FlavorQuotas{
+						{
+							Name: "on-demand",
+							Resources: map[corev1.ResourceName]*ResourceQuota{
+								corev1.ResourceCPU:    {Nominal: 4_000},
+								corev1.ResourceMemory: {Nominal: 4 * utiltesting.Gi},
+							},
+						},
+						{
+							Name: "spot",
+							Resources: map[corev1.ResourceName]*ResourceQuota{
+								corev1.ResourceCPU: {Nominal: 1_000},
+							},
+						},
+					},
+				}},
+				Cohort: &Cohort{
+					RequestableResources: FlavorResourceQuantities{
+						"on-demand": {corev1.ResourceCPU: 10_000, corev1.ResourceMemory: 20 * utiltesting.Gi},
+						"spot":      {corev1.ResourceCPU: 10_000},
+					},
+				},
+			},
+			// Borrowing 3 out of 20 CPUs and 2Gi out of 20Gi of memory.
+			wantDRS: 150,
+			wantRes: corev1.ResourceCPU,
+		},
+		"borrowing with the workload requests": {
+			cq: ClusterQueue{
+				FairWeight:        defaultFairWeight,
+				FlavorFungibility: defaultFlavorFungibility,
+				Usage: FlavorResourceQuantities{
+					"default": {corev1.ResourceCPU: 1_000},
+				},
+				ResourceGroups: []ResourceGroup{{
+					Flavors: []FlavorQuotas{{
+						Name: "default",
+						Resources: map[corev1.ResourceName]*ResourceQuota{
+							corev1.ResourceCPU: {Nominal: 2_000},
+						},
+					}},
+				}},
+				Cohort: &Cohort{
+					RequestableResources: FlavorResourceQuantities{
+						"default": {corev1.ResourceCPU: 10_000},
+					},
+				},
+			},
+			wlReq: FlavorResourceQuantities{
+				"default": {corev1.ResourceCPU: 3_000},
+			},
+			wantDRS: 200,
+			wantRes: corev1.ResourceCPU,
+		},
+		"weighted share": {
+			cq: ClusterQueue{
+				FairWeight: resource.MustParse("2"),
+				Usage: FlavorResourceQuantities{
+					"default": {corev1.ResourceCPU: 4_000},
+				},
+				ResourceGroups: []ResourceGroup{{
+					Flavors: []FlavorQuotas{{
+						Name: "default",
+						Resources: map[corev1.ResourceName]*ResourceQuota{
+							corev1.ResourceCPU: {Nominal: 2_000},
+						},
+					}},
+				}},
+				Cohort: &Cohort{
+					RequestableResources: FlavorResourceQuantities{
+						"default": {corev1.ResourceCPU: 10_000},
+					},
+				},
+			},
+			wantDRS: 100,
+			wantRes: corev1.ResourceCPU,
+		},
+		"zero weight": {
+			cq: ClusterQueue{
+				Usage: FlavorResourceQuantities{
+					"default": {corev1.ResourceCPU: 4_000},
+				},
+				ResourceGroups: []ResourceGroup{{
+					Flavors: []FlavorQuotas{{
+						Name: "default",
+						Resources: map[corev1.ResourceName]*ResourceQuota{
+							corev1.ResourceCPU: {Nominal: 2_000},
+						},
+					}},
+				}},
+				Cohort: &Cohort{
+					RequestableResources: FlavorResourceQuantities{
+						"default": {corev1.ResourceCPU: 10_000},
+					},
+				},
+			},
+			wantDRS: math.MaxInt,
+			wantRes: corev1.ResourceCPU,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			drs, res := tc.cq.DominantResourceShareWith(tc.wlReq)
+			if drs != tc.wantDRS || res != tc.wantRes {
+				t.Errorf("DominantResourceShareWith(_) = (%d, %q), want (%d, %q)", drs, res, tc.wantDRS, tc.wantRes)
+			}
+		})
+	}
+}
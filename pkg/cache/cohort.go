@@ -0,0 +1,233 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+var (
+	errCohortCycle = errors.New("cohort hierarchy would have a cycle")
+)
+
+// Cohort is a set of ClusterQueues that can borrow resources from each other.
+// Cohorts can be arranged in a tree, in which case the ClusterQueues can
+// borrow unused resources from the whole tree.
+type Cohort struct {
+	Name    string
+	Members sets.Set[*ClusterQueue]
+	// Parent is the Cohort above this one in the tree, nil for a root.
+	Parent *Cohort
+	// ChildCohorts are the Cohorts having this one as parent.
+	ChildCohorts sets.Set[*Cohort]
+	// ResourceGroups holds the quotas that the Cohort provides on top of the
+	// quotas of its members and child Cohorts.
+	ResourceGroups []ResourceGroup
+
+	// The following fields are not populated in a snapshot.
+
+	// hasObject indicates whether the Cohort is backed by a Cohort object.
+	// Otherwise, it only exists because it is referenced by ClusterQueues or
+	// other Cohorts.
+	hasObject bool
+
+	// These fields are only populated for a snapshot. They account for the
+	// whole subtree of the Cohort.
+	RequestableResources FlavorResourceQuantities
+	Usage                FlavorResourceQuantities
+}
+
+func newCohort(name string, size int) *Cohort {
+	return &Cohort{
+		Name:         name,
+		Members:      make(sets.Set[*ClusterQueue], size),
+		ChildCohorts: sets.New[*Cohort](),
+	}
+}
+
+// HasBorrowingQueues returns whether any ClusterQueue in the Cohort, or in
+// the Cohorts below it, is borrowing.
+func (c *Cohort) HasBorrowingQueues() bool {
+	for cq := range c.Members {
+		if cq.IsBorrowing() {
+			return true
+		}
+	}
+	for child := range c.ChildCohorts {
+		if child.HasBorrowingQueues() {
+			return true
+		}
+	}
+	return false
+}
+
+// Root returns the Cohort at the top of the tree this Cohort belongs to.
+func (c *Cohort) Root() *Cohort {
+	for c.Parent != nil {
+		c = c.Parent
+	}
+	return c
+}
+
+// SubtreeMembers returns the ClusterQueues of the Cohort and of the Cohorts
+// below it.
+func (c *Cohort) SubtreeMembers() []*ClusterQueue {
+	members := make([]*ClusterQueue, 0, c.Members.Len())
+	for cq := range c.Members {
+		members = append(members, cq)
+	}
+	for child := range c.ChildCohorts {
+		members = append(members, child.SubtreeMembers()...)
+	}
+	return members
+}
+
+// HasAncestor returns whether the other Cohort is this one or is above it
+// in the tree.
+func (c *Cohort) HasAncestor(other *Cohort) bool {
+	for ; c != nil; c = c.Parent {
+		if c == other {
+			return true
+		}
+	}
+	return false
+}
+
+// Available returns the quantity of the resource in the flavor that is not
+// used in the tree and that ClusterQueues in this Cohort can borrow, given
+// the borrowing limits of this Cohort and the Cohorts above it.
+func (c *Cohort) Available(fName kueue.ResourceFlavorReference, rName corev1.ResourceName) int64 {
+	available := c.RequestableResources[fName][rName] - c.Usage[fName][rName]
+	if c.Parent == nil {
+		return available
+	}
+	parentAvailable := c.Parent.Available(fName, rName)
+	if rQuota := c.quota(fName, rName); rQuota != nil && rQuota.BorrowingLimit != nil {
+		if withLimit := available + *rQuota.BorrowingLimit; withLimit < parentAvailable {
+			return withLimit
+		}
+	}
+	return parentAvailable
+}
+
+// requestable returns the quantity of the resource in the flavor that
+// ClusterQueues in this Cohort could borrow if nothing was used in the tree,
+// given the borrowing limits of this Cohort and the Cohorts above it.
+func (c *Cohort) requestable(fName kueue.ResourceFlavorReference, rName corev1.ResourceName) int64 {
+	requestable := c.RequestableResources[fName][rName]
+	if c.Parent == nil {
+		return requestable
+	}
+	parentRequestable := c.Parent.requestable(fName, rName)
+	if rQuota := c.quota(fName, rName); rQuota != nil && rQuota.BorrowingLimit != nil {
+		if withLimit := requestable + *rQuota.BorrowingLimit; withLimit < parentRequestable {
+			return withLimit
+		}
+	}
+	return parentRequestable
+}
+
+func (c *Cohort) quota(fName kueue.ResourceFlavorReference, rName corev1.ResourceName) *ResourceQuota {
+	for _, rg := range c.ResourceGroups {
+		if !rg.CoveredResources.Has(rName) {
+			continue
+		}
+		for _, flvQuotas := range rg.Flavors {
+			if flvQuotas.Name == fName {
+				return flvQuotas.Resources[rName]
+			}
+		}
+	}
+	return nil
+}
+
+// subtreeQuota returns the nominal quota of the resource in the flavor that
+// the Cohort and the Cohorts below it provide.
+func (c *Cohort) subtreeQuota(fName kueue.ResourceFlavorReference, rName corev1.ResourceName) int64 {
+	var total int64
+	if rQuota := c.quota(fName, rName); rQuota != nil {
+		total += rQuota.Nominal
+	}
+	for child := range c.ChildCohorts {
+		total += child.subtreeQuota(fName, rName)
+	}
+	return total
+}
+
+func (c *Cohort) isEmpty() bool {
+	return !c.hasObject && c.Members.Len() == 0 && c.ChildCohorts.Len() == 0
+}
+
+func (c *Cohort) clusterQueueNames() sets.Set[string] {
+	names := sets.New[string]()
+	for _, cq := range c.SubtreeMembers() {
+		names.Insert(cq.Name)
+	}
+	return names
+}
+
+// snapshot creates a copy of the Cohort without its members and tree links.
+func (c *Cohort) snapshot() *Cohort {
+	cc := newCohort(c.Name, c.Members.Len())
+	cc.ResourceGroups = c.ResourceGroups // Shallow copy is enough.
+	return cc
+}
+
+// accumulateResources calculates the requestable resources and usage of the
+// subtree of the Cohort.
+func (c *Cohort) accumulateResources() {
+	c.RequestableResources = make(FlavorResourceQuantities)
+	c.Usage = make(FlavorResourceQuantities)
+	for child := range c.ChildCohorts {
+		child.accumulateResources()
+		addQuantities(c.RequestableResources, child.RequestableResources)
+		addQuantities(c.Usage, child.Usage)
+	}
+	for cq := range c.Members {
+		cq.accumulateResources(c)
+	}
+	for _, rg := range c.ResourceGroups {
+		for _, flvQuotas := range rg.Flavors {
+			for rName, rQuota := range flvQuotas.Resources {
+				addQuantity(c.RequestableResources, flvQuotas.Name, rName, rQuota.Nominal)
+				addQuantity(c.Usage, flvQuotas.Name, rName, 0)
+			}
+		}
+	}
+}
+
+func addQuantities(dst, src FlavorResourceQuantities) {
+	for fName, resQuantities := range src {
+		for rName, v := range resQuantities {
+			addQuantity(dst, fName, rName, v)
+		}
+	}
+}
+
+func addQuantity(dst FlavorResourceQuantities, fName kueue.ResourceFlavorReference, rName corev1.ResourceName, v int64) {
+	res := dst[fName]
+	if res == nil {
+		res = make(map[corev1.ResourceName]int64)
+		dst[fName] = res
+	}
+	res[rName] += v
+}
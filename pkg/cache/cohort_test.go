@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestCohortHierarchy(t *testing.T) {
+	ctx := context.Background()
+	cache := New(utiltesting.NewFakeClient())
+	cache.AddOrUpdateResourceFlavor(utiltesting.MakeResourceFlavor("default").Obj())
+	clusterQueues := []*kueue.ClusterQueue{
+		utiltesting.MakeClusterQueue("a").
+			Cohort("team-a").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "2").Obj()).
+			Obj(),
+		utiltesting.MakeClusterQueue("b").
+			Cohort("team-b").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "3").Obj()).
+			Obj(),
+		utiltesting.MakeClusterQueue("c").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "4").Obj()).
+			Obj(),
+	}
+	for _, cq := range clusterQueues {
+		if err := cache.AddClusterQueue(ctx, cq); err != nil {
+			t.Fatalf("Adding ClusterQueue %s: %v", cq.Name, err)
+		}
+	}
+	cache.AddOrUpdateWorkload(utiltesting.MakeWorkload("wl", "").
+		Request(corev1.ResourceCPU, "1").
+		ReserveQuota(utiltesting.MakeAdmission("b").Assignment(corev1.ResourceCPU, "default", "1").Obj()).
+		Obj())
+
+	wantCohortNames := func(want ...string) {
+		t.Helper()
+		if diff := cmp.Diff(sets.New(want...), sets.KeySet(cache.cohorts)); diff != "" {
+			t.Errorf("Unexpected cohorts (-want,+got):\n%s", diff)
+		}
+	}
+	wantAvailable := func(cqName string, want int64) {
+		t.Helper()
+		snapshot := cache.Snapshot()
+		cq := snapshot.ClusterQueues[cqName]
+		if got := cq.Cohort.Available("default", corev1.ResourceCPU); got != want {
+			t.Errorf("Unexpected available quota for ClusterQueue %s, want=%d, got=%d", cqName, want, got)
+		}
+	}
+	wantCQNames := func(got sets.Set[string], want ...string) {
+		t.Helper()
+		if diff := cmp.Diff(sets.New(want...), got); diff != "" {
+			t.Errorf("Unexpected affected ClusterQueues (-want,+got):\n%s", diff)
+		}
+	}
+
+	wantCohortNames("team-a", "team-b")
+	wantAvailable("a", 2000)
+
+	cqNames, err := cache.AddOrUpdateCohort(utiltesting.MakeCohort("team-a").Parent("division").Obj())
+	if err != nil {
+		t.Fatalf("Adding cohort: %v", err)
+	}
+	wantCQNames(cqNames, "a")
+	cqNames, err = cache.AddOrUpdateCohort(utiltesting.MakeCohort("team-b").Parent("division").Obj())
+	if err != nil {
+		t.Fatalf("Adding cohort: %v", err)
+	}
+	wantCQNames(cqNames, "a", "b")
+	cqNames, err = cache.AddOrUpdateCohort(utiltesting.MakeCohort("division").
+		ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "5").Obj()).
+		Obj())
+	if err != nil {
+		t.Fatalf("Adding cohort: %v", err)
+	}
+	wantCQNames(cqNames, "a", "b")
+	wantCohortNames("team-a", "team-b", "division")
+	// 2 + 3 + 5 in the tree, 1 used by b.
+	wantAvailable("a", 9000)
+
+	_, err = cache.AddOrUpdateCohort(utiltesting.MakeCohort("division").Parent("team-a").Obj())
+	if !errors.Is(err, errCohortCycle) {
+		t.Errorf("Unexpected error for a cycle: %v", err)
+	}
+	if cache.cohorts["division"].Parent != nil {
+		t.Errorf("Cohort division got a parent in a cycle")
+	}
+	// The quota of division was removed by the failed update.
+	wantAvailable("a", 4000)
+	if _, err := cache.AddOrUpdateCohort(utiltesting.MakeCohort("team-b").
+		Parent("division").
+		ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "0", "1").Obj()).
+		Obj()); err != nil {
+		t.Fatalf("Updating cohort: %v", err)
+	}
+	// team-b can only borrow 1 from division on top of its 3.
+	wantAvailable("b", 3000)
+
+	wantCQNames(cache.DeleteCohort("team-b"), "a", "b")
+	wantAvailable("a", 2000)
+	wantAvailable("b", 2000)
+
+	cache.DeleteClusterQueue(clusterQueues[0])
+	wantCohortNames("team-a", "team-b", "division")
+	wantCQNames(cache.DeleteCohort("team-a"))
+	wantCohortNames("team-b", "division")
+}
@@ -28,6 +28,9 @@ type Snapshot struct {
 	ClusterQueues            map[string]*ClusterQueue
 	ResourceFlavors          map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor
 	InactiveClusterQueueSets sets.Set[string]
+	// TASFlavors holds the free capacity of the nodes of the flavors that
+	// refer to a Topology.
+	TASFlavors map[kueue.ResourceFlavorReference]*TASFlavorSnapshot
 }
 
 // RemoveWorkload removes a workload from its corresponding ClusterQueue and
@@ -35,9 +38,9 @@ type Snapshot struct {
 func (s *Snapshot) RemoveWorkload(wl *workload.Info) {
 	cq := s.ClusterQueues[wl.ClusterQueue]
 	delete(cq.Workloads, workload.Key(wl.Obj))
-	updateUsage(wl, cq.Usage, -1)
-	if cq.Cohort != nil {
-		updateUsage(wl, cq.Cohort.Usage, -1)
+	cq.updateUsageWithCohorts(wl, -1)
+	if lq := cq.LocalQueueLimits[workload.QueueKey(wl.Obj)]; lq != nil {
+		updateUsage(wl, lq.Usage, -1)
 	}
 }
 
@@ -46,9 +49,9 @@ func (s *Snapshot) RemoveWorkload(wl *workload.Info) {
 func (s *Snapshot) AddWorkload(wl *workload.Info) {
 	cq := s.ClusterQueues[wl.ClusterQueue]
 	cq.Workloads[workload.Key(wl.Obj)] = wl
-	updateUsage(wl, cq.Usage, 1)
-	if cq.Cohort != nil {
-		updateUsage(wl, cq.Cohort.Usage, 1)
+	cq.updateUsageWithCohorts(wl, 1)
+	if lq := cq.LocalQueueLimits[workload.QueueKey(wl.Obj)]; lq != nil {
+		updateUsage(wl, lq.Usage, 1)
 	}
 }
 
@@ -72,20 +75,53 @@ func (c *Cache) Snapshot() Snapshot {
 		// Shallow copy is enough
 		snap.ResourceFlavors[name] = rf
 	}
+	cohortCopies := make(map[*Cohort]*Cohort, len(c.cohorts))
 	for _, cohort := range c.cohorts {
-		cohortCopy := newCohort(cohort.Name, cohort.Members.Len())
+		cohortCopies[cohort] = cohort.snapshot()
+	}
+	for cohort, cohortCopy := range cohortCopies {
+		if cohort.Parent != nil {
+			cohortCopy.Parent = cohortCopies[cohort.Parent]
+			cohortCopy.Parent.ChildCohorts.Insert(cohortCopy)
+		}
 		for cq := range cohort.Members {
 			if cq.Active() {
 				cqCopy := snap.ClusterQueues[cq.Name]
-				cqCopy.accumulateResources(cohortCopy)
 				cqCopy.Cohort = cohortCopy
 				cohortCopy.Members.Insert(cqCopy)
 			}
 		}
 	}
+	for _, cohortCopy := range cohortCopies {
+		if cohortCopy.Parent == nil {
+			cohortCopy.accumulateResources()
+		}
+	}
+	c.snapshotTASFlavors(&snap)
 	return snap
 }
 
+// snapshotTASFlavors copies the nodes of the flavors that refer to a
+// Topology, discounting the topology assignments of the admitted workloads.
+func (c *Cache) snapshotTASFlavors(snap *Snapshot) {
+	if len(c.tasFlavors) == 0 {
+		return
+	}
+	snap.TASFlavors = make(map[kueue.ResourceFlavorReference]*TASFlavorSnapshot, len(c.tasFlavors))
+	for name, tasFlavor := range c.tasFlavors {
+		snap.TASFlavors[name] = c.snapshotTASFlavor(tasFlavor)
+	}
+	for _, cq := range c.clusterQueues {
+		for _, wl := range cq.Workloads {
+			for _, u := range tasUsages(wl) {
+				if tasFlavor := snap.TASFlavors[u.Flavor]; tasFlavor != nil {
+					tasFlavor.AddUsage(u)
+				}
+			}
+		}
+	}
+}
+
 // snapshot creates a copy of ClusterQueue that includes references to immutable
 // objects and deep copies of changing ones. A reference to the cohort is not included.
 func (c *ClusterQueue) snapshot() *ClusterQueue {
@@ -96,23 +132,44 @@ func (c *ClusterQueue) snapshot() *ClusterQueue {
 		Usage:             make(FlavorResourceQuantities, len(c.Usage)),
 		Workloads:         make(map[string]*workload.Info, len(c.Workloads)),
 		Preemption:        c.Preemption,
+		FlavorFungibility: c.FlavorFungibility,
 		NamespaceSelector: c.NamespaceSelector,
 		Status:            c.Status,
+		AdmissionChecks:   c.AdmissionChecks.Clone(),
+		FairWeight:        c.FairWeight,
 	}
-	for fName, rUsage := range c.Usage {
-		rUsageCopy := make(map[corev1.ResourceName]int64, len(rUsage))
-		for k, v := range rUsage {
-			rUsageCopy[k] = v
-		}
-		cc.Usage[fName] = rUsageCopy
-	}
+	copyQuantities(cc.Usage, c.Usage)
 	for k, v := range c.Workloads {
 		// Shallow copy is enough.
 		cc.Workloads[k] = v
 	}
+	for k, q := range c.localQueues {
+		if len(q.limits) == 0 {
+			continue
+		}
+		if cc.LocalQueueLimits == nil {
+			cc.LocalQueueLimits = make(map[string]*LocalQueueLimits)
+		}
+		lq := &LocalQueueLimits{
+			Limits: q.limits, // Shallow copy is enough.
+			Usage:  make(FlavorResourceQuantities, len(q.usage)),
+		}
+		copyQuantities(lq.Usage, q.usage)
+		cc.LocalQueueLimits[k] = lq
+	}
 	return cc
 }
 
+func copyQuantities(dst, src FlavorResourceQuantities) {
+	for fName, rUsage := range src {
+		rUsageCopy := make(map[corev1.ResourceName]int64, len(rUsage))
+		for k, v := range rUsage {
+			rUsageCopy[k] = v
+		}
+		dst[fName] = rUsageCopy
+	}
+}
+
 func (c *ClusterQueue) accumulateResources(cohort *Cohort) {
 	if cohort.RequestableResources == nil {
 		cohort.RequestableResources = make(FlavorResourceQuantities, len(c.ResourceGroups))
@@ -125,7 +182,8 @@ func (c *ClusterQueue) accumulateResources(cohort *Cohort) {
 				cohort.RequestableResources[flvQuotas.Name] = res
 			}
 			for rName, rQuota := range flvQuotas.Resources {
-				res[rName] += rQuota.Nominal
+				// The guaranteed quota can't be borrowed by other ClusterQueues.
+				res[rName] += rQuota.Nominal - rQuota.guaranteed()
 			}
 		}
 	}
@@ -139,7 +197,38 @@ func (c *ClusterQueue) accumulateResources(cohort *Cohort) {
 			cohort.Usage[fName] = used
 		}
 		for res, val := range resUsages {
-			used[res] += val
+			// Only the usage above the guaranteed quota consumes quota of the cohort.
+			used[res] += positiveDiff(val, c.guaranteedQuota(fName, res))
+		}
+	}
+}
+
+// updateUsageWithCohorts updates the usage of the ClusterQueue and of its
+// cohorts with the workload. Only the changes of the usage above the
+// guaranteed quota of the ClusterQueue are applied to the cohorts.
+func (c *ClusterQueue) updateUsageWithCohorts(wi *workload.Info, m int64) {
+	for _, ps := range wi.TotalRequests {
+		for rName, fName := range ps.Flavors {
+			v, found := ps.Requests[rName]
+			if !found {
+				continue
+			}
+			flvUsage := c.Usage[fName]
+			before, found := flvUsage[rName]
+			if !found {
+				continue
+			}
+			after := before + v*m
+			flvUsage[rName] = after
+			guaranteed := c.guaranteedQuota(fName, rName)
+			delta := positiveDiff(after, guaranteed) - positiveDiff(before, guaranteed)
+			for cohort := c.Cohort; cohort != nil; cohort = cohort.Parent {
+				if cohortUsage, found := cohort.Usage[fName]; found {
+					if _, found := cohortUsage[rName]; found {
+						cohortUsage[rName] += delta
+					}
+				}
+			}
 		}
 	}
 }
@@ -34,9 +34,9 @@ import (
 
 var snapCmpOpts = []cmp.Option{
 	cmpopts.EquateEmpty(),
-	cmpopts.IgnoreUnexported(ClusterQueue{}),
+	cmpopts.IgnoreUnexported(ClusterQueue{}, Cohort{}),
 	cmpopts.IgnoreFields(ClusterQueue{}, "RGByResource"),
-	cmpopts.IgnoreFields(Cohort{}, "Members"), // avoid recursion.
+	cmpopts.IgnoreFields(Cohort{}, "Members", "Parent", "ChildCohorts"), // avoid recursion.
 	cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime"),
 }
 
@@ -70,7 +70,9 @@ func TestSnapshot(t *testing.T) {
 								utiltesting.MakeWorkload("alpha", "").
 									Admit(&kueue.Admission{ClusterQueue: "a"}).Obj()),
 						},
-						Preemption: defaultPreemption,
+						Preemption:        defaultPreemption,
+						FairWeight:        defaultFairWeight,
+						FlavorFungibility: defaultFlavorFungibility,
 					},
 					"b": {
 						Name:              "b",
@@ -81,7 +83,9 @@ func TestSnapshot(t *testing.T) {
 								utiltesting.MakeWorkload("beta", "").
 									Admit(&kueue.Admission{ClusterQueue: "b"}).Obj()),
 						},
-						Preemption: defaultPreemption,
+						Preemption:        defaultPreemption,
+						FairWeight:        defaultFairWeight,
+						FlavorFungibility: defaultFlavorFungibility,
 					},
 				},
 			},
@@ -256,6 +260,8 @@ func TestSnapshot(t *testing.T) {
 									Obj()),
 							},
 							Preemption:        defaultPreemption,
+							FairWeight:        defaultFairWeight,
+							FlavorFungibility: defaultFlavorFungibility,
 							NamespaceSelector: labels.Everything(),
 							Status:            active,
 						},
@@ -317,6 +323,8 @@ func TestSnapshot(t *testing.T) {
 									Obj()),
 							},
 							Preemption:        defaultPreemption,
+							FairWeight:        defaultFairWeight,
+							FlavorFungibility: defaultFlavorFungibility,
 							NamespaceSelector: labels.Everything(),
 							Status:            active,
 						},
@@ -339,6 +347,8 @@ func TestSnapshot(t *testing.T) {
 								},
 							},
 							Preemption:        defaultPreemption,
+							FairWeight:        defaultFairWeight,
+							FlavorFungibility: defaultFlavorFungibility,
 							NamespaceSelector: labels.Everything(),
 							Status:            active,
 						},
@@ -370,6 +380,32 @@ func TestSnapshot(t *testing.T) {
 							ReclaimWithinCohort: kueue.PreemptionPolicyAny,
 							WithinClusterQueue:  kueue.PreemptionPolicyLowerPriority,
 						},
+						FairWeight:        defaultFairWeight,
+						FlavorFungibility: defaultFlavorFungibility,
+					},
+				},
+			},
+		},
+		"clusterQueues with flavor fungibility": {
+			cqs: []*kueue.ClusterQueue{
+				utiltesting.MakeClusterQueue("with-fungibility").
+					FlavorFungibility(kueue.FlavorFungibility{
+						WhenCanPreempt: kueue.Preempt,
+					}).Obj(),
+			},
+			wantSnapshot: Snapshot{
+				ClusterQueues: map[string]*ClusterQueue{
+					"with-fungibility": {
+						Name:              "with-fungibility",
+						NamespaceSelector: labels.Everything(),
+						Status:            active,
+						Workloads:         map[string]*workload.Info{},
+						Preemption:        defaultPreemption,
+						FairWeight:        defaultFairWeight,
+						FlavorFungibility: kueue.FlavorFungibility{
+							WhenCanBorrow:  kueue.Borrow,
+							WhenCanPreempt: kueue.Preempt,
+						},
 					},
 				},
 			},
@@ -615,7 +651,7 @@ func TestSnapshotAddRemoveWorkload(t *testing.T) {
 		},
 	}
 	cmpOpts := append(snapCmpOpts,
-		cmpopts.IgnoreFields(ClusterQueue{}, "NamespaceSelector", "Preemption", "Status"),
+		cmpopts.IgnoreFields(ClusterQueue{}, "NamespaceSelector", "Preemption", "FlavorFungibility", "Status", "FairWeight"),
 		cmpopts.IgnoreFields(Snapshot{}, "ResourceFlavors"),
 		cmpopts.IgnoreTypes(&workload.Info{}))
 	for name, tc := range cases {
@@ -0,0 +1,314 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// TASFlavorSnapshot holds the free capacity of the nodes of a ResourceFlavor
+// that has a Topology, for topology-aware scheduling.
+type TASFlavorSnapshot struct {
+	// Levels are the node labels of the levels of the Topology, from the
+	// highest to the lowest.
+	Levels []string
+
+	// nodes are sorted by their values for the levels, so that the nodes of
+	// a domain are contiguous.
+	nodes []tasNode
+}
+
+type tasNode struct {
+	name   string
+	values []string
+	free   workload.Requests
+}
+
+// TASUsage is the usage of the pods of a podset placed in the domains of the
+// Topology of a ResourceFlavor.
+type TASUsage struct {
+	Flavor     kueue.ResourceFlavorReference
+	Assignment *kueue.TopologyAssignment
+	PerPod     workload.Requests
+}
+
+// TopologyRequest describes the placement requested by a podset.
+type TopologyRequest struct {
+	// Level is the node label of the requested level.
+	Level string
+	// Required indicates that the pods must be placed in a single domain.
+	Required bool
+	Count    int32
+	PerPod   workload.Requests
+}
+
+// NewTASFlavorSnapshot returns an empty snapshot for a Topology with the
+// given levels.
+func NewTASFlavorSnapshot(levels []string) *TASFlavorSnapshot {
+	return &TASFlavorSnapshot{Levels: levels}
+}
+
+// AddNode adds the allocatable capacity of a node. Unschedulable nodes and
+// nodes missing a label for any of the levels are ignored.
+func (s *TASFlavorSnapshot) AddNode(node *corev1.Node) {
+	if node.Spec.Unschedulable {
+		return
+	}
+	s.insertNode(node.Name, node.Labels, workload.NewRequests(node.Status.Allocatable))
+}
+
+// insertNode inserts the node next to the nodes of its domain. Nodes missing
+// a label for any of the levels are ignored.
+func (s *TASFlavorSnapshot) insertNode(name string, labels map[string]string, free workload.Requests) {
+	values := make([]string, len(s.Levels))
+	for i, level := range s.Levels {
+		v, found := labels[level]
+		if !found {
+			return
+		}
+		values[i] = v
+	}
+	id := domainID(values)
+	idx := sort.Search(len(s.nodes), func(i int) bool {
+		return domainID(s.nodes[i].values) > id
+	})
+	s.nodes = append(s.nodes, tasNode{})
+	copy(s.nodes[idx+1:], s.nodes[idx:])
+	s.nodes[idx] = tasNode{
+		name:   name,
+		values: values,
+		free:   free,
+	}
+}
+
+// removeNode removes the node, if present.
+func (s *TASFlavorSnapshot) removeNode(name string) {
+	for i := range s.nodes {
+		if s.nodes[i].name == name {
+			s.nodes = append(s.nodes[:i], s.nodes[i+1:]...)
+			return
+		}
+	}
+}
+
+func domainID(values []string) string {
+	return strings.Join(values, ",")
+}
+
+// levelIndex returns the index of the level in the Topology, or -1.
+func (s *TASFlavorSnapshot) levelIndex(level string) int {
+	for i, l := range s.Levels {
+		if l == level {
+			return i
+		}
+	}
+	return -1
+}
+
+// HasLevel returns whether the Topology of the flavor has the level.
+func (s *TASFlavorSnapshot) HasLevel(level string) bool {
+	return s.levelIndex(level) >= 0
+}
+
+// freeCapacity returns a copy of the free capacity of the nodes with the
+// usages applied.
+func (s *TASFlavorSnapshot) freeCapacity(usages []TASUsage) ([]workload.Requests, bool) {
+	free := make([]workload.Requests, len(s.nodes))
+	for i := range s.nodes {
+		free[i] = make(workload.Requests, len(s.nodes[i].free))
+		for rName, v := range s.nodes[i].free {
+			free[i][rName] = v
+		}
+	}
+	for _, u := range usages {
+		if !s.place(free, u.Assignment, u.PerPod) {
+			return free, false
+		}
+	}
+	return free, true
+}
+
+// place places the pods of the assignment in the nodes of each domain, in
+// order, subtracting their requests from the free capacity. It returns false
+// if not all the pods fit.
+func (s *TASFlavorSnapshot) place(free []workload.Requests, ta *kueue.TopologyAssignment, perPod workload.Requests) bool {
+	fits := true
+	for _, d := range ta.Domains {
+		remaining := d.Count
+		for i := range s.nodes {
+			if remaining == 0 {
+				break
+			}
+			if !hasPrefix(s.nodes[i].values, d.Values) {
+				continue
+			}
+			n := podsFitting(free[i], perPod)
+			if n > remaining {
+				n = remaining
+			}
+			for rName, v := range perPod {
+				if rName != corev1.ResourcePods {
+					free[i][rName] -= v * int64(n)
+				}
+			}
+			free[i][corev1.ResourcePods] -= int64(n)
+			remaining -= n
+		}
+		if remaining > 0 {
+			fits = false
+		}
+	}
+	return fits
+}
+
+func hasPrefix(values, prefix []string) bool {
+	if len(prefix) > len(values) {
+		return false
+	}
+	for i := range prefix {
+		if values[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// podsFitting returns how many pods with the requests fit in the free
+// capacity of a node.
+func podsFitting(free, perPod workload.Requests) int32 {
+	fitting := int64(free[corev1.ResourcePods])
+	for rName, v := range perPod {
+		if rName == corev1.ResourcePods || v <= 0 {
+			continue
+		}
+		if n := free[rName] / v; n < fitting {
+			fitting = n
+		}
+	}
+	if fitting < 0 {
+		return 0
+	}
+	return int32(fitting)
+}
+
+// Fits returns whether the usages fit in the free capacity of the nodes.
+func (s *TASFlavorSnapshot) Fits(usages []TASUsage) bool {
+	_, fits := s.freeCapacity(usages)
+	return fits
+}
+
+// AddUsage subtracts the usage from the free capacity of the nodes.
+func (s *TASFlavorSnapshot) AddUsage(u TASUsage) {
+	free, _ := s.freeCapacity([]TASUsage{u})
+	for i := range s.nodes {
+		s.nodes[i].free = free[i]
+	}
+}
+
+// FindTopologyAssignment finds the domains of the requested level to place
+// the pods, given the usages assumed for other podsets. It returns a reason
+// if the pods don't fit.
+// The smallest domain where all the pods fit is preferred, to reduce
+// fragmentation. If the placement is not required and no single domain is
+// big enough, the pods are spread over the biggest domains.
+func (s *TASFlavorSnapshot) FindTopologyAssignment(req TopologyRequest, assumed []TASUsage) (*kueue.TopologyAssignment, string) {
+	levelIdx := s.levelIndex(req.Level)
+	if levelIdx < 0 {
+		return nil, fmt.Sprintf("topology level %s not found", req.Level)
+	}
+	free, _ := s.freeCapacity(assumed)
+	type domain struct {
+		values   []string
+		capacity int32
+	}
+	var domains []*domain
+	var total int32
+	for i := range s.nodes {
+		values := s.nodes[i].values[:levelIdx+1]
+		if len(domains) == 0 || domainID(domains[len(domains)-1].values) != domainID(values) {
+			domains = append(domains, &domain{values: values})
+		}
+		n := podsFitting(free[i], req.PerPod)
+		domains[len(domains)-1].capacity += n
+		total += n
+	}
+
+	var best *domain
+	for _, d := range domains {
+		if d.capacity >= req.Count && (best == nil || d.capacity < best.capacity) {
+			best = d
+		}
+	}
+	ta := &kueue.TopologyAssignment{Levels: s.Levels[:levelIdx+1]}
+	if best != nil {
+		ta.Domains = []kueue.TopologyDomainAssignment{{Values: best.values, Count: req.Count}}
+		return ta, ""
+	}
+	if req.Required || total < req.Count {
+		return nil, fmt.Sprintf("not enough free capacity for %d pods in a domain of topology level %s", req.Count, req.Level)
+	}
+	sort.SliceStable(domains, func(i, j int) bool {
+		return domains[i].capacity > domains[j].capacity
+	})
+	remaining := req.Count
+	for _, d := range domains {
+		if remaining == 0 {
+			break
+		}
+		n := d.capacity
+		if n > remaining {
+			n = remaining
+		}
+		ta.Domains = append(ta.Domains, kueue.TopologyDomainAssignment{Values: d.values, Count: n})
+		remaining -= n
+	}
+	return ta, ""
+}
+
+// tasUsages returns the usages of the podsets of the workload that have a
+// topology assignment.
+func tasUsages(wl *workload.Info) []TASUsage {
+	if wl.Obj.Status.Admission == nil {
+		return nil
+	}
+	var usages []TASUsage
+	for i, psa := range wl.Obj.Status.Admission.PodSetAssignments {
+		if psa.TopologyAssignment == nil || i >= len(wl.TotalRequests) {
+			continue
+		}
+		flavors := sets.New[kueue.ResourceFlavorReference]()
+		for _, f := range psa.Flavors {
+			flavors.Insert(f)
+		}
+		for _, f := range sets.List(flavors) {
+			usages = append(usages, TASUsage{
+				Flavor:     f,
+				Assignment: psa.TopologyAssignment,
+				PerPod:     wl.TotalRequests[i].SinglePodRequests(),
+			})
+		}
+	}
+	return usages
+}
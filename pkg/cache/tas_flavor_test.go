@@ -0,0 +1,238 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+func makeTASNode(name string, labels map[string]string, cpu string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:  resource.MustParse(cpu),
+				corev1.ResourcePods: resource.MustParse("10"),
+			},
+		},
+	}
+}
+
+func makeTASPod(name, node, cpu string, labels map[string]string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", Labels: labels},
+		Spec: corev1.PodSpec{
+			NodeName: node,
+			Containers: []corev1.Container{{
+				Name: "c",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+				},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+func TestTASFlavorSnapshot(t *testing.T) {
+	ctx := context.Background()
+	const (
+		block = "cloud.com/block"
+		rack  = "cloud.com/rack"
+	)
+	unschedulable := makeTASNode("n4", map[string]string{"pool": "tas", block: "b2", rack: "r1"}, "4")
+	unschedulable.Spec.Unschedulable = true
+	finished := makeTASPod("p3", "n3", "2", nil)
+	finished.Status.Phase = corev1.PodSucceeded
+	cache := New(utiltesting.NewFakeClient())
+	// Nodes added before and after the flavor refers to the Topology.
+	cache.AddOrUpdateNode(makeTASNode("n1", map[string]string{"pool": "tas", block: "b1", rack: "r1"}, "4"))
+	cache.AddOrUpdateNode(makeTASNode("n2", map[string]string{"pool": "tas", block: "b1", rack: "r2"}, "4"))
+	cache.AddOrUpdateResourceFlavor(utiltesting.MakeResourceFlavor("tas").Label("pool", "tas").TopologyName("default").Obj())
+	cache.AddOrUpdateResourceFlavor(utiltesting.MakeResourceFlavor("default").Obj())
+	cache.AddOrUpdateTopology(utiltesting.MakeTopology("default", block, rack).Obj())
+	for _, node := range []*corev1.Node{
+		makeTASNode("n3", map[string]string{"pool": "tas", block: "b2", rack: "r1"}, "4"),
+		unschedulable,
+		makeTASNode("n5", map[string]string{"pool": "other", block: "b3", rack: "r1"}, "4"),
+		makeTASNode("n6", map[string]string{"pool": "tas", block: "b3"}, "4"),
+	} {
+		cache.AddOrUpdateNode(node)
+	}
+	for _, pod := range []*corev1.Pod{
+		makeTASPod("p1", "n1", "2", nil),
+		makeTASPod("p2", "n2", "2", map[string]string{kueue.TopologySchedulingLabel: "true"}),
+		finished,
+		makeTASPod("p4", "", "2", nil),
+	} {
+		cache.AddOrUpdatePod(pod)
+	}
+	cq := utiltesting.MakeClusterQueue("cq").
+		ResourceGroup(*utiltesting.MakeFlavorQuotas("tas").Resource(corev1.ResourceCPU, "100").Obj()).
+		Obj()
+	if err := cache.AddClusterQueue(ctx, cq); err != nil {
+		t.Fatalf("Adding ClusterQueue: %v", err)
+	}
+	cache.AddOrUpdateWorkload(utiltesting.MakeWorkload("wl", "ns").
+		Request(corev1.ResourceCPU, "3").
+		ReserveQuota(utiltesting.MakeAdmission("cq").
+			Assignment(corev1.ResourceCPU, "tas", "3").
+			TopologyAssignment(&kueue.TopologyAssignment{
+				Levels:  []string{block, rack},
+				Domains: []kueue.TopologyDomainAssignment{{Values: []string{"b2", "r1"}, Count: 1}},
+			}).
+			Obj()).
+		Obj())
+
+	snapshot := cache.Snapshot()
+	if _, found := snapshot.TASFlavors["default"]; found {
+		t.Errorf("Unexpected topology snapshot for a flavor without topology")
+	}
+	tasFlavor := snapshot.TASFlavors["tas"]
+	if tasFlavor == nil {
+		t.Fatalf("Missing topology snapshot for flavor tas")
+	}
+	wantNodes := []tasNode{
+		{name: "n1", values: []string{"b1", "r1"}, free: workload.Requests{corev1.ResourceCPU: 2_000, corev1.ResourcePods: 9}},
+		{name: "n2", values: []string{"b1", "r2"}, free: workload.Requests{corev1.ResourceCPU: 4_000, corev1.ResourcePods: 10}},
+		{name: "n3", values: []string{"b2", "r1"}, free: workload.Requests{corev1.ResourceCPU: 1_000, corev1.ResourcePods: 9}},
+	}
+	if diff := cmp.Diff(wantNodes, tasFlavor.nodes, cmp.AllowUnexported(tasNode{})); diff != "" {
+		t.Errorf("Unexpected nodes (-want,+got):\n%s", diff)
+	}
+
+	perPod := workload.Requests{corev1.ResourceCPU: 1_000}
+	cases := map[string]struct {
+		request   TopologyRequest
+		assumed   []TASUsage
+		want      *kueue.TopologyAssignment
+		wantFound bool
+	}{
+		"required rack, smallest domain that fits": {
+			request: TopologyRequest{Level: rack, Required: true, Count: 2, PerPod: perPod},
+			want: &kueue.TopologyAssignment{
+				Levels:  []string{block, rack},
+				Domains: []kueue.TopologyDomainAssignment{{Values: []string{"b1", "r1"}, Count: 2}},
+			},
+			wantFound: true,
+		},
+		"required block": {
+			request: TopologyRequest{Level: block, Required: true, Count: 6, PerPod: perPod},
+			want: &kueue.TopologyAssignment{
+				Levels:  []string{block},
+				Domains: []kueue.TopologyDomainAssignment{{Values: []string{"b1"}, Count: 6}},
+			},
+			wantFound: true,
+		},
+		"required rack doesn't fit": {
+			request: TopologyRequest{Level: rack, Required: true, Count: 5, PerPod: perPod},
+		},
+		"preferred rack spreads over the biggest domains": {
+			request: TopologyRequest{Level: rack, Count: 5, PerPod: perPod},
+			want: &kueue.TopologyAssignment{
+				Levels: []string{block, rack},
+				Domains: []kueue.TopologyDomainAssignment{
+					{Values: []string{"b1", "r2"}, Count: 4},
+					{Values: []string{"b1", "r1"}, Count: 1},
+				},
+			},
+			wantFound: true,
+		},
+		"preferred rack doesn't fit in all domains": {
+			request: TopologyRequest{Level: rack, Count: 8, PerPod: perPod},
+		},
+		"assumed usage of other podsets": {
+			request: TopologyRequest{Level: rack, Required: true, Count: 2, PerPod: perPod},
+			assumed: []TASUsage{{
+				Flavor: "tas",
+				Assignment: &kueue.TopologyAssignment{
+					Levels:  []string{block, rack},
+					Domains: []kueue.TopologyDomainAssignment{{Values: []string{"b1", "r1"}, Count: 1}},
+				},
+				PerPod: perPod,
+			}},
+			want: &kueue.TopologyAssignment{
+				Levels:  []string{block, rack},
+				Domains: []kueue.TopologyDomainAssignment{{Values: []string{"b1", "r2"}, Count: 2}},
+			},
+			wantFound: true,
+		},
+		"unknown level": {
+			request: TopologyRequest{Level: "cloud.com/zone", Count: 1, PerPod: perPod},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, reason := tasFlavor.FindTopologyAssignment(tc.request, tc.assumed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected topology assignment (-want,+got):\n%s", diff)
+			}
+			if found := reason == ""; found != tc.wantFound {
+				t.Errorf("Unexpected reason %q", reason)
+			}
+		})
+	}
+}
+
+func TestTASFlavorSnapshotNodeAndPodEvents(t *testing.T) {
+	const rack = "cloud.com/rack"
+	cache := New(utiltesting.NewFakeClient())
+	cache.AddOrUpdateResourceFlavor(utiltesting.MakeResourceFlavor("tas").TopologyName("default").Obj())
+	cache.AddOrUpdateTopology(utiltesting.MakeTopology("default", rack).Obj())
+	cache.AddOrUpdateNode(makeTASNode("n1", map[string]string{rack: "r1"}, "4"))
+	cache.AddOrUpdateNode(makeTASNode("n2", map[string]string{rack: "r2"}, "4"))
+	cache.AddOrUpdateNode(makeTASNode("n3", map[string]string{rack: "r3"}, "4"))
+	cache.AddOrUpdatePod(makeTASPod("p1", "n1", "1", nil))
+	cache.AddOrUpdatePod(makeTASPod("p2", "n1", "2", nil))
+	cache.AddOrUpdatePod(makeTASPod("p3", "n3", "1", nil))
+
+	cache.AddOrUpdateNode(makeTASNode("n1", map[string]string{rack: "r3"}, "8"))
+	unschedulable := makeTASNode("n2", map[string]string{rack: "r2"}, "4")
+	unschedulable.Spec.Unschedulable = true
+	cache.AddOrUpdateNode(unschedulable)
+	finished := makeTASPod("p1", "n1", "1", nil)
+	finished.Status.Phase = corev1.PodSucceeded
+	cache.AddOrUpdatePod(finished)
+	cache.DeletePod(types.NamespacedName{Namespace: "ns", Name: "p3"})
+
+	wantNodes := []tasNode{
+		{name: "n1", values: []string{"r3"}, free: workload.Requests{corev1.ResourceCPU: 6_000, corev1.ResourcePods: 9}},
+		{name: "n3", values: []string{"r3"}, free: workload.Requests{corev1.ResourceCPU: 4_000, corev1.ResourcePods: 10}},
+	}
+	snapshot := cache.Snapshot()
+	if diff := cmp.Diff(wantNodes, snapshot.TASFlavors["tas"].nodes, cmp.AllowUnexported(tasNode{}), cmpopts.SortSlices(func(a, b tasNode) bool { return a.name < b.name })); diff != "" {
+		t.Errorf("Unexpected nodes (-want,+got):\n%s", diff)
+	}
+
+	cache.DeleteNode("n3")
+	snapshot = cache.Snapshot()
+	if diff := cmp.Diff(wantNodes[:1], snapshot.TASFlavors["tas"].nodes, cmp.AllowUnexported(tasNode{})); diff != "" {
+		t.Errorf("Unexpected nodes after deleting a node (-want,+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/util/limitrange"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// tasNodeInfo holds the state of a node relevant to topology-aware scheduling.
+type tasNodeInfo struct {
+	labels        map[string]string
+	unschedulable bool
+	allocatable   workload.Requests
+}
+
+// tasPodInfo holds the node and the requests of a pod that is counted in the
+// usage of the node.
+type tasPodInfo struct {
+	nodeName string
+	requests workload.Requests
+}
+
+// AddOrUpdateNode records the allocatable capacity of the node and places it
+// in the flavors that refer to a Topology.
+func (c *Cache) AddOrUpdateNode(node *corev1.Node) {
+	c.Lock()
+	defer c.Unlock()
+	info := &tasNodeInfo{
+		labels:        node.Labels,
+		unschedulable: node.Spec.Unschedulable,
+		allocatable:   workload.NewRequests(node.Status.Allocatable),
+	}
+	old, found := c.tasNodes[node.Name]
+	c.tasNodes[node.Name] = info
+	if found && old.unschedulable == info.unschedulable && equality.Semantic.DeepEqual(old.labels, info.labels) {
+		return
+	}
+	for name, tasFlavor := range c.tasFlavors {
+		tasFlavor.removeNode(node.Name)
+		if c.tasFlavorHasNode(c.resourceFlavors[name], info) {
+			tasFlavor.insertNode(node.Name, info.labels, nil)
+		}
+	}
+}
+
+// DeleteNode removes the node from the flavors that refer to a Topology.
+func (c *Cache) DeleteNode(name string) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.tasNodes, name)
+	for _, tasFlavor := range c.tasFlavors {
+		tasFlavor.removeNode(name)
+	}
+}
+
+// AddOrUpdatePod records the requests of the pod in the usage of its node.
+// Pods that are not bound to a node or that finished are not counted, nor are
+// the pods with a topology assignment, as the admitted workloads account for
+// them.
+func (c *Cache) AddOrUpdatePod(pod *corev1.Pod) {
+	c.Lock()
+	defer c.Unlock()
+	key := types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
+	c.removeTASPod(key)
+	if pod.Spec.NodeName == "" || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return
+	}
+	if _, found := pod.Labels[kueue.TopologySchedulingLabel]; found {
+		return
+	}
+	requests := workload.NewRequests(limitrange.TotalRequests(&pod.Spec))
+	requests[corev1.ResourcePods] = 1
+	c.tasPods[key] = tasPodInfo{nodeName: pod.Spec.NodeName, requests: requests}
+	usage, found := c.tasNodesUsage[pod.Spec.NodeName]
+	if !found {
+		usage = make(workload.Requests, len(requests))
+		c.tasNodesUsage[pod.Spec.NodeName] = usage
+	}
+	for rName, v := range requests {
+		usage[rName] += v
+	}
+}
+
+// DeletePod removes the requests of the pod from the usage of its node.
+func (c *Cache) DeletePod(key types.NamespacedName) {
+	c.Lock()
+	defer c.Unlock()
+	c.removeTASPod(key)
+}
+
+func (c *Cache) removeTASPod(key types.NamespacedName) {
+	pod, found := c.tasPods[key]
+	if !found {
+		return
+	}
+	delete(c.tasPods, key)
+	usage := c.tasNodesUsage[pod.nodeName]
+	for rName, v := range pod.requests {
+		usage[rName] -= v
+	}
+	if usage[corev1.ResourcePods] <= 0 {
+		delete(c.tasNodesUsage, pod.nodeName)
+	}
+}
+
+// updateTASFlavors places the nodes in the flavors that refer to a Topology.
+// It is called when the flavors or the Topologies change.
+func (c *Cache) updateTASFlavors() {
+	c.tasFlavors = make(map[kueue.ResourceFlavorReference]*TASFlavorSnapshot)
+	for name, rf := range c.resourceFlavors {
+		if rf.Spec.TopologyName == nil {
+			continue
+		}
+		levels, found := c.topologies[*rf.Spec.TopologyName]
+		if !found {
+			continue
+		}
+		tasFlavor := NewTASFlavorSnapshot(levels)
+		for nodeName, info := range c.tasNodes {
+			if c.tasFlavorHasNode(rf, info) {
+				tasFlavor.insertNode(nodeName, info.labels, nil)
+			}
+		}
+		c.tasFlavors[name] = tasFlavor
+	}
+}
+
+func (c *Cache) tasFlavorHasNode(rf *kueue.ResourceFlavor, info *tasNodeInfo) bool {
+	return !info.unschedulable && labels.SelectorFromSet(rf.Spec.NodeLabels).Matches(labels.Set(info.labels))
+}
+
+// snapshotTASFlavor copies the nodes of the flavor, with their free capacity
+// given by the allocatable capacity minus the usage of their pods.
+func (c *Cache) snapshotTASFlavor(tasFlavor *TASFlavorSnapshot) *TASFlavorSnapshot {
+	s := &TASFlavorSnapshot{
+		Levels: tasFlavor.Levels,
+		nodes:  make([]tasNode, len(tasFlavor.nodes)),
+	}
+	for i, n := range tasFlavor.nodes {
+		free := make(workload.Requests, len(c.tasNodes[n.name].allocatable))
+		for rName, v := range c.tasNodes[n.name].allocatable {
+			free[rName] = v
+		}
+		for rName, v := range c.tasNodesUsage[n.name] {
+			free[rName] -= v
+		}
+		s.nodes[i] = tasNode{name: n.name, values: n.values, free: free}
+	}
+	return s
+}
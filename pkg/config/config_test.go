@@ -28,10 +28,13 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/utils/pointer"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	runtimeconfig "sigs.k8s.io/controller-runtime/pkg/config"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	configapi "sigs.k8s.io/kueue/apis/config/v1beta1"
@@ -219,15 +222,31 @@ kind: Configuration
 integrations:
   frameworks: 
   - batch/job
+  podOptions:
+    namespaceSelector:
+      matchLabels:
+        kueue-managed: "true"
+`), os.FileMode(0600)); err != nil {
+		t.Fatal(err)
+	}
+
+	invalidVictimOrderingConfig := filepath.Join(tmpDir, "invalid-victim-ordering.yaml")
+	if err := os.WriteFile(invalidVictimOrderingConfig, []byte(`
+apiVersion: config.kueue.x-k8s.io/v1beta1
+kind: Configuration
+preemption:
+  victimOrdering: MostPods
 `), os.FileMode(0600)); err != nil {
 		t.Fatal(err)
 	}
 
 	defaultControlOptions := ctrl.Options{
 		HealthProbeBindAddress: configapi.DefaultHealthProbeBindAddress,
-		MetricsBindAddress:     configapi.DefaultMetricsBindAddress,
-		LeaderElectionID:       configapi.DefaultLeaderElectionID,
-		LeaderElection:         true,
+		Metrics: metricsserver.Options{
+			BindAddress: configapi.DefaultMetricsBindAddress,
+		},
+		LeaderElectionID: configapi.DefaultLeaderElectionID,
+		LeaderElection:   true,
 		WebhookServer: &webhook.DefaultServer{
 			Options: webhook.Options{
 				Port: configapi.DefaultWebhookPort,
@@ -243,6 +262,7 @@ integrations:
 
 	ctrlOptsCmpOpts := []cmp.Option{
 		cmpopts.IgnoreUnexported(ctrl.Options{}),
+		cmpopts.IgnoreUnexported(cache.Options{}),
 		cmpopts.IgnoreUnexported(webhook.DefaultServer{}),
 		cmpopts.IgnoreFields(ctrl.Options{}, "Scheme", "Logger"),
 	}
@@ -258,8 +278,24 @@ integrations:
 		Burst: pointer.Int32(configapi.DefaultClientConnectionBurst),
 	}
 
+	podsIntegrationOptions := func(namespace string) *configapi.PodIntegrationOptions {
+		return &configapi.PodIntegrationOptions{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{
+						Key:      "kubernetes.io/metadata.name",
+						Operator: metav1.LabelSelectorOpNotIn,
+						Values:   []string{"kube-system", namespace},
+					},
+				},
+			},
+			PodSelector: &metav1.LabelSelector{},
+		}
+	}
+
 	defaultIntegrations := &configapi.Integrations{
 		Frameworks: []string{job.FrameworkName},
+		PodOptions: podsIntegrationOptions(configapi.DefaultNamespace),
 	}
 
 	testcases := []struct {
@@ -280,9 +316,11 @@ integrations:
 			},
 			wantOptions: ctrl.Options{
 				HealthProbeBindAddress: configapi.DefaultHealthProbeBindAddress,
-				MetricsBindAddress:     configapi.DefaultMetricsBindAddress,
-				LeaderElectionID:       "",
-				LeaderElection:         false,
+				Metrics: metricsserver.Options{
+					BindAddress: configapi.DefaultMetricsBindAddress,
+				},
+				LeaderElectionID: "",
+				LeaderElection:   false,
 				WebhookServer: &webhook.DefaultServer{
 					Options: webhook.Options{
 						Port: configapi.DefaultWebhookPort,
@@ -299,6 +337,14 @@ integrations:
 				Err:  errors.New("is a directory"),
 			},
 		},
+		{
+			name:       "invalid victim ordering",
+			configFile: invalidVictimOrderingConfig,
+			wantError: field.ErrorList{
+				field.NotSupported(field.NewPath("preemption", "victimOrdering"), configapi.VictimOrderingPolicy("MostPods"),
+					[]string{"FewestPods", "LeastRuntimeLost", "LowestPriority", "PreemptionCost"}),
+			}.ToAggregate(),
+		},
 		{
 			name:       "namespace overwrite config",
 			configFile: namespaceOverWriteConfig,
@@ -311,7 +357,10 @@ integrations:
 				ManageJobsWithoutQueueName: false,
 				InternalCertManagement:     enableDefaultInternalCertManagement,
 				ClientConnection:           defaultClientConnection,
-				Integrations:               defaultIntegrations,
+				Integrations: &configapi.Integrations{
+					Frameworks: []string{job.FrameworkName},
+					PodOptions: podsIntegrationOptions("kueue-tenant-a"),
+				},
 			},
 			wantOptions: defaultControlOptions,
 		},
@@ -331,9 +380,11 @@ integrations:
 			},
 			wantOptions: ctrl.Options{
 				HealthProbeBindAddress: ":38081",
-				MetricsBindAddress:     ":38080",
-				LeaderElection:         true,
-				LeaderElectionID:       "test-id",
+				Metrics: metricsserver.Options{
+					BindAddress: ":38080",
+				},
+				LeaderElection:   true,
+				LeaderElectionID: "test-id",
 				WebhookServer: &webhook.DefaultServer{
 					Options: webhook.Options{
 						Port: 9444,
@@ -395,9 +446,11 @@ integrations:
 			},
 			wantOptions: ctrl.Options{
 				HealthProbeBindAddress: configapi.DefaultHealthProbeBindAddress,
-				MetricsBindAddress:     configapi.DefaultMetricsBindAddress,
-				LeaderElectionID:       "",
-				LeaderElection:         false,
+				Metrics: metricsserver.Options{
+					BindAddress: configapi.DefaultMetricsBindAddress,
+				},
+				LeaderElectionID: "",
+				LeaderElection:   false,
 				WebhookServer: &webhook.DefaultServer{
 					Options: webhook.Options{
 						Port: configapi.DefaultWebhookPort,
@@ -426,7 +479,9 @@ integrations:
 			},
 			wantOptions: ctrl.Options{
 				HealthProbeBindAddress: configapi.DefaultHealthProbeBindAddress,
-				MetricsBindAddress:     configapi.DefaultMetricsBindAddress,
+				Metrics: metricsserver.Options{
+					BindAddress: configapi.DefaultMetricsBindAddress,
+				},
 				WebhookServer: &webhook.DefaultServer{
 					Options: webhook.Options{
 						Port: configapi.DefaultWebhookPort,
@@ -471,10 +526,12 @@ integrations:
 				Integrations: defaultIntegrations,
 			},
 			wantOptions: ctrl.Options{
-				HealthProbeBindAddress:     configapi.DefaultHealthProbeBindAddress,
-				ReadinessEndpointName:      "ready",
-				LivenessEndpointName:       "live",
-				MetricsBindAddress:         configapi.DefaultMetricsBindAddress,
+				HealthProbeBindAddress: configapi.DefaultHealthProbeBindAddress,
+				ReadinessEndpointName:  "ready",
+				LivenessEndpointName:   "live",
+				Metrics: metricsserver.Options{
+					BindAddress: configapi.DefaultMetricsBindAddress,
+				},
 				PprofBindAddress:           ":8082",
 				LeaderElection:             true,
 				LeaderElectionID:           configapi.DefaultLeaderElectionID,
@@ -514,11 +571,19 @@ integrations:
 					// referencing job.FrameworkName ensures the link of job package
 					// therefore the batch/framework should be registered
 					Frameworks: []string{job.FrameworkName},
+					PodOptions: &configapi.PodIntegrationOptions{
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"kueue-managed": "true"},
+						},
+						PodSelector: &metav1.LabelSelector{},
+					},
 				},
 			},
 			wantOptions: ctrl.Options{
 				HealthProbeBindAddress: configapi.DefaultHealthProbeBindAddress,
-				MetricsBindAddress:     configapi.DefaultMetricsBindAddress,
+				Metrics: metricsserver.Options{
+					BindAddress: configapi.DefaultMetricsBindAddress,
+				},
 				WebhookServer: &webhook.DefaultServer{
 					Options: webhook.Options{
 						Port: configapi.DefaultWebhookPort,
@@ -609,6 +674,18 @@ func TestEncode(t *testing.T) {
 				"manageJobsWithoutQueueName": false,
 				"integrations": map[string]any{
 					"frameworks": []any{"batch/job"},
+					"podOptions": map[string]any{
+						"namespaceSelector": map[string]any{
+							"matchExpressions": []any{
+								map[string]any{
+									"key":      "kubernetes.io/metadata.name",
+									"operator": "NotIn",
+									"values":   []any{"kube-system", "kueue-system"},
+								},
+							},
+						},
+						"podSelector": map[string]any{},
+					},
 				},
 			},
 		},
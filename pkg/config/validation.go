@@ -0,0 +1,53 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta1"
+)
+
+var (
+	preemptionPath     = field.NewPath("preemption")
+	victimOrderingPath = preemptionPath.Child("victimOrdering")
+
+	validVictimOrderingPolicies = sets.New(
+		string(configapi.LowestPriorityFirst),
+		string(configapi.LeastRuntimeLost),
+		string(configapi.FewestPods),
+		string(configapi.LowestPreemptionCost),
+	)
+)
+
+func validate(c *configapi.Configuration) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validatePreemption(c)...)
+	return allErrs
+}
+
+func validatePreemption(c *configapi.Configuration) field.ErrorList {
+	var allErrs field.ErrorList
+	if c.Preemption == nil {
+		return allErrs
+	}
+	if policy := c.Preemption.VictimOrdering; policy != "" && !validVictimOrderingPolicies.Has(string(policy)) {
+		allErrs = append(allErrs, field.NotSupported(victimOrderingPath, policy, sets.List(validVictimOrderingPolicies)))
+	}
+	return allErrs
+}
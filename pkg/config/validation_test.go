@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta1"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := map[string]struct {
+		cfg     *configapi.Configuration
+		wantErr field.ErrorList
+	}{
+		"empty": {
+			cfg: &configapi.Configuration{},
+		},
+		"empty victim ordering": {
+			cfg: &configapi.Configuration{
+				Preemption: &configapi.Preemption{},
+			},
+		},
+		"supported victim ordering": {
+			cfg: &configapi.Configuration{
+				Preemption: &configapi.Preemption{
+					VictimOrdering: configapi.LeastRuntimeLost,
+				},
+			},
+		},
+		"unsupported victim ordering": {
+			cfg: &configapi.Configuration{
+				Preemption: &configapi.Preemption{
+					VictimOrdering: "MostPods",
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "preemption.victimOrdering",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.wantErr, validate(tc.cfg), cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail")); diff != "" {
+				t.Errorf("Unexpected returned error (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
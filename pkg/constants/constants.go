@@ -21,10 +21,11 @@ import (
 )
 
 const (
-	KueueName          = "kueue"
-	JobControllerName  = KueueName + "-job-controller"
-	AdmissionName      = KueueName + "-admission"
-	ReclaimablePodsMgr = KueueName + "-reclaimable-pods"
+	KueueName              = "kueue"
+	JobControllerName      = KueueName + "-job-controller"
+	WorkloadControllerName = KueueName + "-workload-controller"
+	AdmissionName          = KueueName + "-admission"
+	ReclaimablePodsMgr     = KueueName + "-reclaimable-pods"
 
 	// UpdatesBatchPeriod is the batch period to hold workload updates
 	// before syncing a Queue and ClusterQueue objects.
@@ -34,4 +35,12 @@ const (
 	// that do not specify any priority class and there is no priority class
 	// marked as default.
 	DefaultPriority = 0
+
+	// WorkloadPriorityClassSource represents the priority class source
+	// of the workloads whose priority is taken from a WorkloadPriorityClass.
+	WorkloadPriorityClassSource = "kueue.x-k8s.io/workloadpriorityclass"
+
+	// PodPriorityClassSource represents the priority class source
+	// of the workloads whose priority is taken from a pod PriorityClass.
+	PodPriorityClassSource = "scheduling.k8s.io/priorityclass"
 )
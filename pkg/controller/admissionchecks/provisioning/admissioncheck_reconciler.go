@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioning
+
+import (
+	"context"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+const (
+	// ConfigKind is the kind of the parameters object the AdmissionChecks
+	// handled by this controller should reference.
+	ConfigKind = "ProvisioningRequestConfig"
+)
+
+// AdmissionCheckReconciler maintains the Active condition of the
+// AdmissionChecks handled by this controller. A check is active when it
+// references an existing ProvisioningRequestConfig.
+type AdmissionCheckReconciler struct {
+	client client.Client
+	helper *Controller
+}
+
+func NewAdmissionCheckReconciler(client client.Client) *AdmissionCheckReconciler {
+	return &AdmissionCheckReconciler{
+		client: client,
+		helper: &Controller{client: client},
+	}
+}
+
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks,verbs=get;list;watch
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks/status,verbs=get;update;patch
+
+func (r *AdmissionCheckReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	ac := &kueue.AdmissionCheck{}
+	if err := r.client.Get(ctx, req.NamespacedName, ac); err != nil || ac.Spec.ControllerName != ControllerName {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	log := ctrl.LoggerFrom(ctx).WithValues("admissionCheck", klog.KObj(ac))
+	log.V(2).Info("Reconciling AdmissionCheck")
+
+	newCondition := metav1.Condition{
+		Type:    kueue.AdmissionCheckActive,
+		Status:  metav1.ConditionTrue,
+		Reason:  "Active",
+		Message: "The admission check is active",
+	}
+	if _, err := r.helper.configForCheck(ctx, ac); err != nil {
+		newCondition.Status = metav1.ConditionFalse
+		newCondition.Reason = "BadParametersRef"
+		newCondition.Message = err.Error()
+	}
+
+	oldCondition := apimeta.FindStatusCondition(ac.Status.Conditions, kueue.AdmissionCheckActive)
+	if oldCondition != nil && oldCondition.Status == newCondition.Status && oldCondition.Reason == newCondition.Reason && oldCondition.Message == newCondition.Message {
+		return reconcile.Result{}, nil
+	}
+	apimeta.SetStatusCondition(&ac.Status.Conditions, newCondition)
+	return reconcile.Result{}, r.client.Status().Update(ctx, ac)
+}
+
+// checksUsingConfig returns the requests for the AdmissionChecks referencing
+// the ProvisioningRequestConfig.
+func (r *AdmissionCheckReconciler) checksUsingConfig(ctx context.Context, obj client.Object) []reconcile.Request {
+	var list kueue.AdmissionCheckList
+	if err := r.client.List(ctx, &list); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Listing AdmissionChecks")
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range list.Items {
+		ac := &list.Items[i]
+		if ac.Spec.ControllerName != ControllerName || ac.Spec.Parameters == nil || ac.Spec.Parameters.Name != obj.GetName() {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: ac.Name}})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AdmissionCheckReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("provisioningrequest_admissioncheck").
+		For(&kueue.AdmissionCheck{}).
+		Watches(&kueue.ProvisioningRequestConfig{}, handler.EnqueueRequestsFromMapFunc(r.checksUsingConfig)).
+		Complete(r)
+}
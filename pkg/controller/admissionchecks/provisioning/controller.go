@@ -0,0 +1,352 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioning
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/util/maps"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const (
+	// ControllerName is the name the AdmissionChecks handled by this
+	// controller should use in .spec.controllerName.
+	ControllerName = "kueue.x-k8s.io/provisioning-request"
+)
+
+// Controller creates a ProvisioningRequest for every Workload that reserved
+// quota in a ClusterQueue using an AdmissionCheck managed by this controller,
+// and keeps the state of the check in sync with the status of the request.
+type Controller struct {
+	client client.Client
+	record record.EventRecorder
+}
+
+func NewController(client client.Client, record record.EventRecorder) *Controller {
+	return &Controller{
+		client: client,
+		record: record,
+	}
+}
+
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=provisioningrequests,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=provisioningrequests/status,verbs=get
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=provisioningrequestconfigs,verbs=get;list;watch
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;watch;update;patch
+
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	var wl kueue.Workload
+	if err := c.client.Get(ctx, req.NamespacedName, &wl); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	log := ctrl.LoggerFrom(ctx).WithValues("workload", klog.KObj(&wl))
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	checks, err := c.relevantChecks(ctx, &wl)
+	if err != nil || len(checks) == 0 {
+		return reconcile.Result{}, err
+	}
+	log.V(2).Info("Reconciling Workload")
+
+	if !workload.HasQuotaReservation(&wl) || apimeta.IsStatusConditionTrue(wl.Status.Conditions, kueue.WorkloadFinished) {
+		return reconcile.Result{}, c.deleteRequests(ctx, &wl, checks)
+	}
+
+	requests, err := c.syncRequests(ctx, &wl, checks)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{}, c.syncCheckStates(ctx, &wl, requests)
+}
+
+// RequestName returns the name of the ProvisioningRequest created for the
+// workload and admission check.
+func RequestName(workloadName, checkName string) string {
+	return fmt.Sprintf("%s-%s", workloadName, checkName)
+}
+
+// relevantChecks returns the admission checks of the workload that are
+// handled by this controller.
+func (c *Controller) relevantChecks(ctx context.Context, wl *kueue.Workload) (map[string]*kueue.AdmissionCheck, error) {
+	checks := make(map[string]*kueue.AdmissionCheck)
+	for i := range wl.Status.AdmissionChecks {
+		name := wl.Status.AdmissionChecks[i].Name
+		ac := &kueue.AdmissionCheck{}
+		if err := c.client.Get(ctx, types.NamespacedName{Name: name}, ac); err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				return nil, err
+			}
+			continue
+		}
+		if ac.Spec.ControllerName == ControllerName {
+			checks[name] = ac
+		}
+	}
+	return checks, nil
+}
+
+func (c *Controller) deleteRequests(ctx context.Context, wl *kueue.Workload, checks map[string]*kueue.AdmissionCheck) error {
+	log := ctrl.LoggerFrom(ctx)
+	for checkName := range checks {
+		req := &kueue.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      RequestName(wl.Name, checkName),
+				Namespace: wl.Namespace,
+			},
+		}
+		if err := c.client.Delete(ctx, req); err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				return err
+			}
+			continue
+		}
+		log.V(2).Info("Deleted ProvisioningRequest", "provisioningRequest", klog.KObj(req))
+	}
+	return nil
+}
+
+// syncRequests makes sure that every pending check has a ProvisioningRequest
+// created after the current quota reservation of the workload.
+// Returns the requests indexed by the check name.
+func (c *Controller) syncRequests(ctx context.Context, wl *kueue.Workload, checks map[string]*kueue.AdmissionCheck) (map[string]*kueue.ProvisioningRequest, error) {
+	log := ctrl.LoggerFrom(ctx)
+	requests := make(map[string]*kueue.ProvisioningRequest, len(checks))
+	for checkName, ac := range checks {
+		req := &kueue.ProvisioningRequest{}
+		key := types.NamespacedName{Namespace: wl.Namespace, Name: RequestName(wl.Name, checkName)}
+		err := c.client.Get(ctx, key, req)
+		if client.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+		if err == nil && !isStale(req, wl) {
+			requests[checkName] = req
+			continue
+		}
+		if err == nil {
+			// The request was created for a previous quota reservation.
+			log.V(2).Info("Deleting stale ProvisioningRequest", "provisioningRequest", klog.KObj(req))
+			if err := c.client.Delete(ctx, req); client.IgnoreNotFound(err) != nil {
+				return nil, err
+			}
+		}
+
+		state := workload.FindAdmissionCheck(wl.Status.AdmissionChecks, checkName)
+		if state == nil || state.State != kueue.CheckStatePending {
+			continue
+		}
+		config, err := c.configForCheck(ctx, ac)
+		if err != nil {
+			log.V(2).Info("Unable to get the ProvisioningRequestConfig", "admissionCheck", checkName, "error", err)
+			continue
+		}
+		req, err = c.newRequest(ctx, wl, checkName, config)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.client.Create(ctx, req); err != nil {
+			return nil, err
+		}
+		log.V(2).Info("Created ProvisioningRequest", "provisioningRequest", klog.KObj(req))
+		c.record.Eventf(wl, corev1.EventTypeNormal, "ProvisioningRequestCreated", "Created ProvisioningRequest: %q", req.Name)
+		requests[checkName] = req
+	}
+	return requests, nil
+}
+
+// isStale returns true if the request was created before the current quota
+// reservation of the workload.
+func isStale(req *kueue.ProvisioningRequest, wl *kueue.Workload) bool {
+	cond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadQuotaReserved)
+	return cond != nil && req.CreationTimestamp.Before(&cond.LastTransitionTime)
+}
+
+func (c *Controller) configForCheck(ctx context.Context, ac *kueue.AdmissionCheck) (*kueue.ProvisioningRequestConfig, error) {
+	ref := ac.Spec.Parameters
+	if ref == nil {
+		return nil, fmt.Errorf("missing parameters")
+	}
+	if ref.APIGroup != kueue.GroupVersion.Group || ref.Kind != ConfigKind {
+		return nil, fmt.Errorf("unsupported parameters %s/%s", ref.APIGroup, ref.Kind)
+	}
+	config := &kueue.ProvisioningRequestConfig{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: ref.Name}, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// newRequest builds the ProvisioningRequest for the workload from its
+// podSetAssignments. The pods are requested with the node labels of the
+// assigned flavors.
+func (c *Controller) newRequest(ctx context.Context, wl *kueue.Workload, checkName string, config *kueue.ProvisioningRequestConfig) (*kueue.ProvisioningRequest, error) {
+	req := &kueue.ProvisioningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RequestName(wl.Name, checkName),
+			Namespace: wl.Namespace,
+		},
+		Spec: kueue.ProvisioningRequestSpec{
+			ProvisioningClassName: config.Spec.ProvisioningClassName,
+			Parameters:            maps.Clone(config.Spec.Parameters),
+		},
+	}
+	for i := range wl.Status.Admission.PodSetAssignments {
+		psa := &wl.Status.Admission.PodSetAssignments[i]
+		ps := findPodSet(wl, psa.Name)
+		if ps == nil {
+			continue
+		}
+		count := pointer.Int32Deref(psa.Count, ps.Count)
+		if count == 0 {
+			continue
+		}
+		template := ps.Template.DeepCopy()
+		nodeLabels, err := c.flavorsNodeLabels(ctx, psa)
+		if err != nil {
+			return nil, err
+		}
+		template.Spec.NodeSelector = maps.MergeKeepFirst(nodeLabels, template.Spec.NodeSelector)
+		req.Spec.PodSets = append(req.Spec.PodSets, kueue.ProvisioningRequestPodSet{
+			Name:     psa.Name,
+			Template: *template,
+			Count:    count,
+		})
+	}
+	if err := controllerutil.SetControllerReference(wl, req, c.client.Scheme()); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func findPodSet(wl *kueue.Workload, name string) *kueue.PodSet {
+	for i := range wl.Spec.PodSets {
+		if wl.Spec.PodSets[i].Name == name {
+			return &wl.Spec.PodSets[i]
+		}
+	}
+	return nil
+}
+
+func (c *Controller) flavorsNodeLabels(ctx context.Context, psa *kueue.PodSetAssignment) (map[string]string, error) {
+	names := make([]string, 0, len(psa.Flavors))
+	for _, flv := range psa.Flavors {
+		names = append(names, string(flv))
+	}
+	sort.Strings(names)
+	var labels map[string]string
+	for i, name := range names {
+		if i > 0 && names[i-1] == name {
+			continue
+		}
+		flv := &kueue.ResourceFlavor{}
+		if err := c.client.Get(ctx, types.NamespacedName{Name: name}, flv); err != nil {
+			return nil, err
+		}
+		labels = maps.MergeKeepFirst(labels, flv.Spec.NodeLabels)
+	}
+	return labels, nil
+}
+
+// syncCheckStates updates the admission checks of the workload based on the
+// status of their ProvisioningRequests.
+func (c *Controller) syncCheckStates(ctx context.Context, wl *kueue.Workload, requests map[string]*kueue.ProvisioningRequest) error {
+	log := ctrl.LoggerFrom(ctx)
+	wlPatch := workload.BaseSSAWorkload(wl)
+	updated := false
+	for checkName, req := range requests {
+		current := workload.FindAdmissionCheck(wl.Status.AdmissionChecks, checkName)
+		if current == nil {
+			continue
+		}
+		newCheck := checkStateFromRequest(checkName, req)
+		if current.State == newCheck.State && current.Message == newCheck.Message && equality.Semantic.DeepEqual(current.PodSetUpdates, newCheck.PodSetUpdates) {
+			continue
+		}
+		updated = true
+		wlPatch.Status.AdmissionChecks = append(wlPatch.Status.AdmissionChecks, *current.DeepCopy())
+		workload.SetAdmissionCheckState(&wlPatch.Status.AdmissionChecks, newCheck)
+		log.V(2).Info("Updating admission check", "admissionCheck", checkName, "state", newCheck.State)
+	}
+	if !updated {
+		return nil
+	}
+	return c.client.Status().Patch(ctx, wlPatch, client.Apply, client.FieldOwner(ControllerName), client.ForceOwnership)
+}
+
+// checkStateFromRequest maps the status of the ProvisioningRequest to the
+// state of the admission check:
+//
+// - Failed: Rejected, the capacity will not be available.
+// - CapacityRevoked: Retry, the workload should release its quota and try again.
+// - Provisioned: Ready, the podSetUpdates of the request are passed to the workload.
+// - Otherwise, the check stays Pending.
+func checkStateFromRequest(checkName string, req *kueue.ProvisioningRequest) kueue.AdmissionCheckState {
+	state := kueue.AdmissionCheckState{
+		Name:    checkName,
+		State:   kueue.CheckStatePending,
+		Message: fmt.Sprintf("Waiting for ProvisioningRequest %q to be provisioned", req.Name),
+	}
+	switch {
+	case apimeta.IsStatusConditionTrue(req.Status.Conditions, kueue.ProvisioningRequestFailed):
+		state.State = kueue.CheckStateRejected
+		state.Message = conditionMessage(req, kueue.ProvisioningRequestFailed)
+	case apimeta.IsStatusConditionTrue(req.Status.Conditions, kueue.ProvisioningRequestCapacityRevoked):
+		state.State = kueue.CheckStateRetry
+		state.Message = conditionMessage(req, kueue.ProvisioningRequestCapacityRevoked)
+	case apimeta.IsStatusConditionTrue(req.Status.Conditions, kueue.ProvisioningRequestProvisioned):
+		state.State = kueue.CheckStateReady
+		state.Message = conditionMessage(req, kueue.ProvisioningRequestProvisioned)
+		state.PodSetUpdates = append([]kueue.PodSetUpdate(nil), req.Status.PodSetUpdates...)
+	}
+	return state
+}
+
+func conditionMessage(req *kueue.ProvisioningRequest, conditionType string) string {
+	cond := apimeta.FindStatusCondition(req.Status.Conditions, conditionType)
+	if cond.Message != "" {
+		return cond.Message
+	}
+	return fmt.Sprintf("ProvisioningRequest %q is %s", req.Name, conditionType)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("provisioningrequest_workload").
+		For(&kueue.Workload{}).
+		Owns(&kueue.ProvisioningRequest{}).
+		Complete(c)
+}
@@ -0,0 +1,347 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioning
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+const testNamespace = "ns"
+
+// fakeProvision acts as a local provisioner, marking the request as
+// provisioned and asking for the pods to land on the provisioned nodes.
+func fakeProvision(req *kueue.ProvisioningRequest, nodePool string) {
+	apimeta.SetStatusCondition(&req.Status.Conditions, metav1.Condition{
+		Type:    kueue.ProvisioningRequestProvisioned,
+		Status:  metav1.ConditionTrue,
+		Reason:  "Provisioned",
+		Message: "Capacity is available",
+	})
+	for _, ps := range req.Spec.PodSets {
+		req.Status.PodSetUpdates = append(req.Status.PodSetUpdates, kueue.PodSetUpdate{
+			Name:         ps.Name,
+			Annotations:  map[string]string{"provisioning.example.com/consume": req.Name},
+			NodeSelector: map[string]string{"node-pool": nodePool},
+		})
+	}
+}
+
+func setRequestCondition(req *kueue.ProvisioningRequest, conditionType, message string) {
+	apimeta.SetStatusCondition(&req.Status.Conditions, metav1.Condition{
+		Type:    conditionType,
+		Status:  metav1.ConditionTrue,
+		Reason:  "ByTest",
+		Message: message,
+	})
+}
+
+func TestReconcile(t *testing.T) {
+	baseConfig := &kueue.ProvisioningRequestConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config1"},
+		Spec: kueue.ProvisioningRequestConfigSpec{
+			ProvisioningClassName: "class1",
+			Parameters:            map[string]string{"p1": "v1"},
+		},
+	}
+	baseCheck := utiltesting.MakeAdmissionCheck("check1").
+		ControllerName(ControllerName).
+		Parameters(kueue.GroupVersion.Group, ConfigKind, "config1").
+		Obj()
+	otherCheck := utiltesting.MakeAdmissionCheck("other").
+		ControllerName("other-controller").
+		Obj()
+	flavor := utiltesting.MakeResourceFlavor("flv").Label("instance-type", "big").Obj()
+	baseWorkload := utiltesting.MakeWorkload("wl", testNamespace).
+		PodSets(
+			*utiltesting.MakePodSet("ps1", 4).Request(corev1.ResourceCPU, "1").Obj(),
+			*utiltesting.MakePodSet("ps2", 3).Request(corev1.ResourceCPU, "1").NodeSelector(map[string]string{"zone": "a"}).Obj(),
+		).
+		ReserveQuota(utiltesting.MakeAdmission("cq", "ps1", "ps2").Obj()).
+		AdmissionCheck(kueue.AdmissionCheckState{Name: "check1", State: kueue.CheckStatePending}).
+		AdmissionCheck(kueue.AdmissionCheckState{Name: "other", State: kueue.CheckStatePending}).
+		Obj()
+	baseWorkload.Status.Admission.PodSetAssignments[0].Flavors[corev1.ResourceCPU] = "flv"
+	baseWorkload.Status.Admission.PodSetAssignments[0].Count = pointer.Int32(4)
+	baseWorkload.Status.Admission.PodSetAssignments[1].Flavors[corev1.ResourceCPU] = "flv"
+	baseWorkload.Status.Admission.PodSetAssignments[1].Count = pointer.Int32(2)
+
+	wantTemplate := func(ps int, nodeSelector map[string]string) corev1.PodTemplateSpec {
+		template := baseWorkload.Spec.PodSets[ps].Template.DeepCopy()
+		template.Spec.NodeSelector = nodeSelector
+		return *template
+	}
+	baseRequest := &kueue.ProvisioningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "wl-check1",
+			Namespace: testNamespace,
+		},
+		Spec: kueue.ProvisioningRequestSpec{
+			ProvisioningClassName: "class1",
+			Parameters:            map[string]string{"p1": "v1"},
+			PodSets: []kueue.ProvisioningRequestPodSet{
+				{
+					Name:     "ps1",
+					Template: wantTemplate(0, map[string]string{"instance-type": "big"}),
+					Count:    4,
+				},
+				{
+					Name:     "ps2",
+					Template: wantTemplate(1, map[string]string{"instance-type": "big", "zone": "a"}),
+					Count:    2,
+				},
+			},
+		},
+	}
+	existingRequest := baseRequest.DeepCopy()
+	existingRequest.CreationTimestamp = metav1.NewTime(time.Now().Add(time.Minute))
+	staleRequest := baseRequest.DeepCopy()
+	staleRequest.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+	staleRequest.Spec.ProvisioningClassName = "old-class"
+
+	cases := map[string]struct {
+		workload     *kueue.Workload
+		requests     []kueue.ProvisioningRequest
+		prepare      func(req *kueue.ProvisioningRequest)
+		wantRequests []kueue.ProvisioningRequest
+		wantChecks   []kueue.AdmissionCheckState
+	}{
+		"create the request": {
+			workload:     baseWorkload.DeepCopy(),
+			wantRequests: []kueue.ProvisioningRequest{*baseRequest.DeepCopy()},
+			wantChecks: []kueue.AdmissionCheckState{{
+				Name:    "check1",
+				State:   kueue.CheckStatePending,
+				Message: `Waiting for ProvisioningRequest "wl-check1" to be provisioned`,
+			}},
+		},
+		"no quota reservation, delete the request": {
+			workload: utiltesting.MakeWorkload("wl", testNamespace).
+				AdmissionCheck(kueue.AdmissionCheckState{Name: "check1", State: kueue.CheckStatePending}).
+				Obj(),
+			requests: []kueue.ProvisioningRequest{*existingRequest.DeepCopy()},
+		},
+		"provisioned, the check is ready": {
+			workload: baseWorkload.DeepCopy(),
+			requests: []kueue.ProvisioningRequest{*existingRequest.DeepCopy()},
+			prepare: func(req *kueue.ProvisioningRequest) {
+				fakeProvision(req, "pool1")
+			},
+			wantChecks: []kueue.AdmissionCheckState{{
+				Name:    "check1",
+				State:   kueue.CheckStateReady,
+				Message: "Capacity is available",
+				PodSetUpdates: []kueue.PodSetUpdate{
+					{
+						Name:         "ps1",
+						Annotations:  map[string]string{"provisioning.example.com/consume": "wl-check1"},
+						NodeSelector: map[string]string{"node-pool": "pool1"},
+					},
+					{
+						Name:         "ps2",
+						Annotations:  map[string]string{"provisioning.example.com/consume": "wl-check1"},
+						NodeSelector: map[string]string{"node-pool": "pool1"},
+					},
+				},
+			}},
+		},
+		"failed, the check is rejected": {
+			workload: baseWorkload.DeepCopy(),
+			requests: []kueue.ProvisioningRequest{*existingRequest.DeepCopy()},
+			prepare: func(req *kueue.ProvisioningRequest) {
+				setRequestCondition(req, kueue.ProvisioningRequestFailed, "out of stock")
+			},
+			wantChecks: []kueue.AdmissionCheckState{{
+				Name:    "check1",
+				State:   kueue.CheckStateRejected,
+				Message: "out of stock",
+			}},
+		},
+		"capacity revoked, the check is retried": {
+			workload: utiltesting.MakeWorkload("wl", testNamespace).
+				ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+				AdmissionCheck(kueue.AdmissionCheckState{Name: "check1", State: kueue.CheckStateReady}).
+				Obj(),
+			requests: []kueue.ProvisioningRequest{*existingRequest.DeepCopy()},
+			prepare: func(req *kueue.ProvisioningRequest) {
+				fakeProvision(req, "pool1")
+				setRequestCondition(req, kueue.ProvisioningRequestCapacityRevoked, "")
+			},
+			wantChecks: []kueue.AdmissionCheckState{{
+				Name:    "check1",
+				State:   kueue.CheckStateRetry,
+				Message: `ProvisioningRequest "wl-check1" is CapacityRevoked`,
+			}},
+		},
+		"stale request is replaced": {
+			workload:     baseWorkload.DeepCopy(),
+			requests:     []kueue.ProvisioningRequest{*staleRequest},
+			wantRequests: []kueue.ProvisioningRequest{*baseRequest.DeepCopy()},
+			wantChecks: []kueue.AdmissionCheckState{{
+				Name:    "check1",
+				State:   kueue.CheckStatePending,
+				Message: `Waiting for ProvisioningRequest "wl-check1" to be provisioned`,
+			}},
+		},
+		"not pending check, the request is not created": {
+			workload: utiltesting.MakeWorkload("wl", testNamespace).
+				ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+				AdmissionCheck(kueue.AdmissionCheckState{Name: "check1", State: kueue.CheckStateRetry}).
+				Obj(),
+		},
+		"checks of other controllers are ignored": {
+			workload: utiltesting.MakeWorkload("wl", testNamespace).
+				ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+				AdmissionCheck(kueue.AdmissionCheckState{Name: "other", State: kueue.CheckStatePending}).
+				Obj(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var gotChecks []kueue.AdmissionCheckState
+			builder := utiltesting.NewClientBuilder().
+				WithObjects(tc.workload, baseConfig.DeepCopy(), baseCheck.DeepCopy(), otherCheck.DeepCopy(), flavor.DeepCopy()).
+				WithStatusSubresource(tc.workload).
+				WithInterceptorFuncs(interceptor.Funcs{
+					SubResourcePatch: func(_ context.Context, _ client.Client, _ string, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+						if wl, isWl := obj.(*kueue.Workload); isWl {
+							gotChecks = wl.Status.AdmissionChecks
+						}
+						return nil
+					},
+				})
+			for i := range tc.requests {
+				req := tc.requests[i].DeepCopy()
+				if tc.prepare != nil {
+					tc.prepare(req)
+				}
+				builder = builder.WithObjects(req)
+			}
+			cl := builder.Build()
+			ctx := context.Background()
+
+			controller := NewController(cl, record.NewFakeRecorder(10))
+			_, err := controller.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(tc.workload)})
+			if err != nil {
+				t.Fatalf("Reconcile returned error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.wantChecks, gotChecks, cmpopts.IgnoreFields(kueue.AdmissionCheckState{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("Unexpected admission checks (-want,+got):\n%s", diff)
+			}
+
+			if tc.wantRequests == nil && (tc.prepare != nil || tc.requests == nil) {
+				// The existing requests are left untouched.
+				return
+			}
+			var gotRequests kueue.ProvisioningRequestList
+			if err := cl.List(ctx, &gotRequests); err != nil {
+				t.Fatalf("Listing requests: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantRequests, gotRequests.Items, cmpopts.EquateEmpty(),
+				cmpopts.IgnoreFields(metav1.ObjectMeta{}, "ResourceVersion", "OwnerReferences", "CreationTimestamp")); diff != "" {
+				t.Errorf("Unexpected requests (-want,+got):\n%s", diff)
+			}
+			for _, req := range gotRequests.Items {
+				if owner := metav1.GetControllerOf(&req); owner == nil || owner.Name != tc.workload.Name {
+					t.Errorf("Request %s is not owned by the workload", req.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestReconcileAdmissionCheck(t *testing.T) {
+	config := &kueue.ProvisioningRequestConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config1"},
+		Spec: kueue.ProvisioningRequestConfigSpec{
+			ProvisioningClassName: "class1",
+		},
+	}
+	cases := map[string]struct {
+		check      *kueue.AdmissionCheck
+		wantStatus metav1.ConditionStatus
+	}{
+		"existing config": {
+			check: utiltesting.MakeAdmissionCheck("check1").
+				ControllerName(ControllerName).
+				Parameters(kueue.GroupVersion.Group, ConfigKind, "config1").
+				Obj(),
+			wantStatus: metav1.ConditionTrue,
+		},
+		"missing config": {
+			check: utiltesting.MakeAdmissionCheck("check1").
+				ControllerName(ControllerName).
+				Parameters(kueue.GroupVersion.Group, ConfigKind, "config2").
+				Obj(),
+			wantStatus: metav1.ConditionFalse,
+		},
+		"no parameters": {
+			check: utiltesting.MakeAdmissionCheck("check1").
+				ControllerName(ControllerName).
+				Obj(),
+			wantStatus: metav1.ConditionFalse,
+		},
+		"other controller": {
+			check: utiltesting.MakeAdmissionCheck("check1").
+				ControllerName("other-controller").
+				Obj(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(tc.check, config.DeepCopy()).
+				WithStatusSubresource(tc.check).
+				Build()
+			ctx := context.Background()
+			r := NewAdmissionCheckReconciler(cl)
+			_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: tc.check.Name}})
+			if err != nil {
+				t.Fatalf("Reconcile returned error: %v", err)
+			}
+			var gotCheck kueue.AdmissionCheck
+			if err := cl.Get(ctx, types.NamespacedName{Name: tc.check.Name}, &gotCheck); err != nil {
+				t.Fatalf("Getting the check: %v", err)
+			}
+			var gotStatus metav1.ConditionStatus
+			if cond := apimeta.FindStatusCondition(gotCheck.Status.Conditions, kueue.AdmissionCheckActive); cond != nil {
+				gotStatus = cond.Status
+			}
+			if gotStatus != tc.wantStatus {
+				t.Errorf("Unexpected Active condition status, want=%q, got=%q", tc.wantStatus, gotStatus)
+			}
+		})
+	}
+}
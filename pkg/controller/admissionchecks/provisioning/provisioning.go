@@ -0,0 +1,34 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioning
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// SetupControllers sets up the provisioning request admission check
+// controllers. It returns the name of the controller that failed to create
+// and an error, if any.
+func SetupControllers(mgr ctrl.Manager) (string, error) {
+	if err := NewAdmissionCheckReconciler(mgr.GetClient()).SetupWithManager(mgr); err != nil {
+		return "ProvisioningRequestAdmissionCheck", err
+	}
+	if err := NewController(mgr.GetClient(), mgr.GetEventRecorderFor(ControllerName)).SetupWithManager(mgr); err != nil {
+		return "ProvisioningRequestWorkload", err
+	}
+	return "", nil
+}
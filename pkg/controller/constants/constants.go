@@ -36,4 +36,37 @@ const (
 	// JobUIDLabel is the label key in the workload resource, that holds the UID of
 	// the owner job.
 	JobUIDLabel = "kueue.x-k8s.io/job-uid"
+
+	// WorkloadPriorityClassLabel is the label key in the job that holds the
+	// name of the WorkloadPriorityClass used to compute the priority of the
+	// workload.
+	WorkloadPriorityClassLabel = "kueue.x-k8s.io/priority-class"
+
+	// WorkloadActiveAnnotation is the annotation key in the job that controls
+	// the .spec.active field of its workload. Setting it to "false" deactivates
+	// the workload. The workload keeps the last value copied from the job in
+	// the same annotation.
+	WorkloadActiveAnnotation = "kueue.x-k8s.io/workload-active"
+
+	// MaxExecTimeSecondsLabel is the label key in the job that holds the
+	// maximum execution time, in seconds, of its workload.
+	MaxExecTimeSecondsLabel = "kueue.x-k8s.io/max-exec-time-seconds"
+
+	// PreemptionCostAnnotation is the annotation key in the job, copied to its
+	// workload whenever it changes, that holds the cost of preempting the
+	// workload, used by the PreemptionCost victim ordering policy.
+	PreemptionCostAnnotation = "kueue.x-k8s.io/preemption-cost"
+
+	// PreemptionGracePeriodSecondsLabel is the label key in the job that holds
+	// the preemption grace period, in seconds, of its workload.
+	PreemptionGracePeriodSecondsLabel = "kueue.x-k8s.io/preemption-grace-period-seconds"
+
+	// PreemptionDeadlineAnnotation is the annotation key set in the pods of a
+	// job whose workload is pending preemption. The value is the time, in
+	// RFC3339 format, when the workload is evicted.
+	PreemptionDeadlineAnnotation = "kueue.x-k8s.io/preemption-deadline"
+
+	// ManagedByKueueLabel is the label key set by the webhook on the plain
+	// pods that are managed by Kueue.
+	ManagedByKueueLabel = "kueue.x-k8s.io/managed"
 )
@@ -0,0 +1,251 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/cache"
+	"sigs.k8s.io/kueue/pkg/queue"
+)
+
+type AdmissionCheckUpdateWatcher interface {
+	NotifyAdmissionCheckUpdate(oldAc, newAc *kueue.AdmissionCheck)
+}
+
+// AdmissionCheckReconciler reconciles a AdmissionCheck object
+type AdmissionCheckReconciler struct {
+	log        logr.Logger
+	qManager   *queue.Manager
+	cache      *cache.Cache
+	client     client.Client
+	cqUpdateCh chan event.GenericEvent
+	watchers   []AdmissionCheckUpdateWatcher
+}
+
+func NewAdmissionCheckReconciler(
+	client client.Client,
+	qMgr *queue.Manager,
+	cache *cache.Cache,
+) *AdmissionCheckReconciler {
+	return &AdmissionCheckReconciler{
+		log:        ctrl.Log.WithName("admissioncheck-reconciler"),
+		qManager:   qMgr,
+		cache:      cache,
+		client:     client,
+		cqUpdateCh: make(chan event.GenericEvent, updateChBuffer),
+	}
+}
+
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks,verbs=get;list;watch;update;patch;delete
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks/finalizers,verbs=update
+
+func (r *AdmissionCheckReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var ac kueue.AdmissionCheck
+	if err := r.client.Get(ctx, req.NamespacedName, &ac); err != nil {
+		// we'll ignore not-found errors, since there is nothing to do.
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	log := ctrl.LoggerFrom(ctx).WithValues("admissionCheck", klog.KObj(&ac))
+	ctx = ctrl.LoggerInto(ctx, log)
+	log.V(2).Info("Reconciling AdmissionCheck")
+
+	if ac.DeletionTimestamp.IsZero() {
+		if controllerutil.AddFinalizer(&ac, kueue.ResourceInUseFinalizerName) {
+			if err := r.client.Update(ctx, &ac); err != nil {
+				return ctrl.Result{}, client.IgnoreNotFound(err)
+			}
+			log.V(5).Info("Added finalizer")
+		}
+	} else {
+		if controllerutil.ContainsFinalizer(&ac, kueue.ResourceInUseFinalizerName) {
+			if cqs := r.cache.ClusterQueuesUsingAdmissionCheck(ac.Name); len(cqs) != 0 {
+				log.V(3).Info("admissionCheck is still in use", "ClusterQueues", cqs)
+				// We avoid to return error here to prevent backoff requeue, which is passive and wasteful.
+				// Instead, we drive the removal of finalizer by ClusterQueue Update/Delete events
+				// when the admissionCheck is no longer in use.
+				return ctrl.Result{}, nil
+			}
+
+			controllerutil.RemoveFinalizer(&ac, kueue.ResourceInUseFinalizerName)
+			if err := r.client.Update(ctx, &ac); err != nil {
+				return ctrl.Result{}, client.IgnoreNotFound(err)
+			}
+			log.V(5).Info("Removed finalizer")
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *AdmissionCheckReconciler) AddUpdateWatchers(watchers ...AdmissionCheckUpdateWatcher) {
+	r.watchers = append(r.watchers, watchers...)
+}
+
+func (r *AdmissionCheckReconciler) notifyWatchers(oldAc, newAc *kueue.AdmissionCheck) {
+	for _, w := range r.watchers {
+		w.NotifyAdmissionCheckUpdate(oldAc, newAc)
+	}
+}
+
+func (r *AdmissionCheckReconciler) Create(e event.CreateEvent) bool {
+	ac, isAc := e.Object.(*kueue.AdmissionCheck)
+	if !isAc {
+		return false
+	}
+	defer r.notifyWatchers(nil, ac)
+
+	log := r.log.WithValues("admissionCheck", klog.KObj(ac))
+	log.V(2).Info("AdmissionCheck create event")
+
+	if cqNames := r.cache.AddOrUpdateAdmissionCheck(ac); len(cqNames) > 0 {
+		r.qManager.QueueInadmissibleWorkloads(context.Background(), cqNames)
+		// The ClusterQueues that became active should be evaluated by the scheduler,
+		// regardless of having inadmissible workloads.
+		r.qManager.Broadcast()
+	}
+	return true
+}
+
+func (r *AdmissionCheckReconciler) Update(e event.UpdateEvent) bool {
+	oldAc, isAc := e.ObjectOld.(*kueue.AdmissionCheck)
+	if !isAc {
+		return false
+	}
+	newAc, isAc := e.ObjectNew.(*kueue.AdmissionCheck)
+	if !isAc {
+		return false
+	}
+	defer r.notifyWatchers(oldAc, newAc)
+
+	log := r.log.WithValues("admissionCheck", klog.KObj(newAc))
+	log.V(2).Info("AdmissionCheck update event")
+
+	if newAc.DeletionTimestamp != nil {
+		return true
+	}
+
+	if cqNames := r.cache.AddOrUpdateAdmissionCheck(newAc); len(cqNames) > 0 {
+		r.qManager.QueueInadmissibleWorkloads(context.Background(), cqNames)
+		r.qManager.Broadcast()
+	}
+	return false
+}
+
+func (r *AdmissionCheckReconciler) Delete(e event.DeleteEvent) bool {
+	ac, isAc := e.Object.(*kueue.AdmissionCheck)
+	if !isAc {
+		return false
+	}
+	defer r.notifyWatchers(ac, nil)
+
+	log := r.log.WithValues("admissionCheck", klog.KObj(ac))
+	log.V(2).Info("AdmissionCheck delete event")
+
+	if cqNames := r.cache.DeleteAdmissionCheck(ac); len(cqNames) > 0 {
+		r.qManager.QueueInadmissibleWorkloads(context.Background(), cqNames)
+	}
+	return false
+}
+
+func (r *AdmissionCheckReconciler) Generic(e event.GenericEvent) bool {
+	r.log.V(2).Info("Got generic event", "obj", klog.KObj(e.Object), "kind", e.Object.GetObjectKind().GroupVersionKind())
+	return true
+}
+
+// NotifyClusterQueueUpdate will listen for the update/delete events of clusterQueues
+// to help verifying whether admissionChecks are no longer in use by clusterQueues.
+func (r *AdmissionCheckReconciler) NotifyClusterQueueUpdate(oldCQ, newCQ *kueue.ClusterQueue) {
+	// if oldCQ is nil, it's a create event.
+	if oldCQ == nil {
+		return
+	}
+
+	// if newCQ is nil, it's a delete event.
+	if newCQ == nil {
+		r.cqUpdateCh <- event.GenericEvent{Object: oldCQ}
+		return
+	}
+
+	if !sets.New(oldCQ.Spec.AdmissionChecks...).Equal(sets.New(newCQ.Spec.AdmissionChecks...)) {
+		r.cqUpdateCh <- event.GenericEvent{Object: oldCQ}
+	}
+}
+
+// acCqHandler signals the controller to reconcile the admissionChecks
+// that are no longer used by the clusterQueue in the event.
+// Since the events come from a channel Source, only the Generic handler will
+// receive events.
+type acCqHandler struct {
+	cache *cache.Cache
+}
+
+func (h *acCqHandler) Create(context.Context, event.CreateEvent, workqueue.RateLimitingInterface) {
+}
+
+func (h *acCqHandler) Update(context.Context, event.UpdateEvent, workqueue.RateLimitingInterface) {
+}
+
+func (h *acCqHandler) Delete(context.Context, event.DeleteEvent, workqueue.RateLimitingInterface) {
+}
+
+// Generic accepts update/delete events from clusterQueue via channel.
+// Only the admissionChecks of the old clusterQueue are checked, since the
+// new ones are in use.
+func (h *acCqHandler) Generic(_ context.Context, e event.GenericEvent, q workqueue.RateLimitingInterface) {
+	cq := e.Object.(*kueue.ClusterQueue)
+	if cq.Name == "" {
+		return
+	}
+
+	for _, ac := range cq.Spec.AdmissionChecks {
+		if cqs := h.cache.ClusterQueuesUsingAdmissionCheck(ac); len(cqs) == 0 {
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name: ac,
+				},
+			}
+			q.Add(req)
+		}
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AdmissionCheckReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	handler := acCqHandler{
+		cache: r.cache,
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&kueue.AdmissionCheck{}).
+		WatchesRawSource(&source.Channel{Source: r.cqUpdateCh}, &handler).
+		WithEventFilter(r).
+		Complete(r)
+}
@@ -57,6 +57,7 @@ type ClusterQueueReconciler struct {
 	cache                 *cache.Cache
 	wlUpdateCh            chan event.GenericEvent
 	rfUpdateCh            chan event.GenericEvent
+	acUpdateCh            chan event.GenericEvent
 	watchers              []ClusterQueueUpdateWatcher
 	reportResourceMetrics bool
 }
@@ -75,6 +76,7 @@ func NewClusterQueueReconciler(
 		cache:                 cache,
 		wlUpdateCh:            make(chan event.GenericEvent, updateChBuffer),
 		rfUpdateCh:            make(chan event.GenericEvent, updateChBuffer),
+		acUpdateCh:            make(chan event.GenericEvent, updateChBuffer),
 		watchers:              watchers,
 		reportResourceMetrics: resourceMetrics,
 	}
@@ -124,21 +126,9 @@ func (r *ClusterQueueReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	}
 
 	newCQObj := cqObj.DeepCopy()
-	if r.cache.ClusterQueueActive(newCQObj.Name) {
-		msg := "Can admit new workloads"
-		if err := r.updateCqStatusIfChanged(ctx, newCQObj, metav1.ConditionTrue, "Ready", msg); err != nil {
-			return ctrl.Result{}, client.IgnoreNotFound(err)
-		}
-	} else if r.cache.ClusterQueueTerminating(newCQObj.Name) {
-		msg := "Can't admit new workloads; clusterQueue is terminating"
-		if err := r.updateCqStatusIfChanged(ctx, newCQObj, metav1.ConditionFalse, "Terminating", msg); err != nil {
-			return ctrl.Result{}, client.IgnoreNotFound(err)
-		}
-	} else {
-		msg := "Can't admit new workloads; some flavors are not found"
-		if err := r.updateCqStatusIfChanged(ctx, newCQObj, metav1.ConditionFalse, "FlavorNotFound", msg); err != nil {
-			return ctrl.Result{}, client.IgnoreNotFound(err)
-		}
+	cqCondition, reason, msg := r.cache.ClusterQueueReadiness(newCQObj.Name)
+	if err := r.updateCqStatusIfChanged(ctx, newCQObj, cqCondition, reason, msg); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
 	return ctrl.Result{}, nil
@@ -178,6 +168,23 @@ func (r *ClusterQueueReconciler) NotifyResourceFlavorUpdate(oldRF, newRF *kueue.
 	}
 }
 
+// NotifyAdmissionCheckUpdate triggers the reconciliation of the ClusterQueues
+// using the AdmissionCheck, so that their readiness is refreshed.
+func (r *ClusterQueueReconciler) NotifyAdmissionCheckUpdate(oldAc, newAc *kueue.AdmissionCheck) {
+	switch {
+	case oldAc != nil && newAc != nil:
+		if oldAc.Spec.ControllerName == newAc.Spec.ControllerName &&
+			meta.IsStatusConditionTrue(oldAc.Status.Conditions, kueue.AdmissionCheckActive) == meta.IsStatusConditionTrue(newAc.Status.Conditions, kueue.AdmissionCheckActive) {
+			return
+		}
+		r.acUpdateCh <- event.GenericEvent{Object: newAc}
+	case oldAc != nil:
+		r.acUpdateCh <- event.GenericEvent{Object: oldAc}
+	case newAc != nil:
+		r.acUpdateCh <- event.GenericEvent{Object: newAc}
+	}
+}
+
 // Event handlers return true to signal the controller to reconcile the
 // ClusterQueue associated with the event.
 
@@ -372,7 +379,7 @@ func (h *cqWorkloadHandler) Generic(_ context.Context, e event.GenericEvent, q w
 
 func (h *cqWorkloadHandler) requestForWorkloadClusterQueue(w *kueue.Workload) *reconcile.Request {
 	var name string
-	if workload.IsAdmitted(w) {
+	if workload.HasQuotaReservation(w) {
 		name = string(w.Status.Admission.ClusterQueue)
 	} else {
 		var ok bool
@@ -447,6 +454,34 @@ func (h *cqResourceFlavorHandler) Generic(_ context.Context, e event.GenericEven
 	}
 }
 
+type cqAdmissionCheckHandler struct {
+	cache *cache.Cache
+}
+
+func (h *cqAdmissionCheckHandler) Create(context.Context, event.CreateEvent, workqueue.RateLimitingInterface) {
+}
+
+func (h *cqAdmissionCheckHandler) Update(context.Context, event.UpdateEvent, workqueue.RateLimitingInterface) {
+}
+
+func (h *cqAdmissionCheckHandler) Delete(context.Context, event.DeleteEvent, workqueue.RateLimitingInterface) {
+}
+
+func (h *cqAdmissionCheckHandler) Generic(_ context.Context, e event.GenericEvent, q workqueue.RateLimitingInterface) {
+	ac, ok := e.Object.(*kueue.AdmissionCheck)
+	if !ok {
+		return
+	}
+
+	for _, cq := range h.cache.ClusterQueuesUsingAdmissionCheck(ac.Name) {
+		req := reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name: cq,
+			}}
+		q.Add(req)
+	}
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *ClusterQueueReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	wHandler := cqWorkloadHandler{
@@ -459,11 +494,15 @@ func (r *ClusterQueueReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	rfHandler := cqResourceFlavorHandler{
 		cache: r.cache,
 	}
+	acHandler := cqAdmissionCheckHandler{
+		cache: r.cache,
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&kueue.ClusterQueue{}).
 		Watches(&corev1.Namespace{}, &nsHandler).
 		WatchesRawSource(&source.Channel{Source: r.wlUpdateCh}, &wHandler).
 		WatchesRawSource(&source.Channel{Source: r.rfUpdateCh}, &rfHandler).
+		WatchesRawSource(&source.Channel{Source: r.acUpdateCh}, &acHandler).
 		WithEventFilter(r).
 		Complete(r)
 }
@@ -0,0 +1,84 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/cache"
+	"sigs.k8s.io/kueue/pkg/queue"
+)
+
+// CohortReconciler reconciles a Cohort object
+type CohortReconciler struct {
+	qManager *queue.Manager
+	cache    *cache.Cache
+	client   client.Client
+}
+
+func NewCohortReconciler(
+	client client.Client,
+	qMgr *queue.Manager,
+	cache *cache.Cache,
+) *CohortReconciler {
+	return &CohortReconciler{
+		qManager: qMgr,
+		cache:    cache,
+		client:   client,
+	}
+}
+
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=cohorts,verbs=get;list;watch
+
+func (r *CohortReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx).WithValues("cohort", klog.KRef("", req.Name))
+	ctx = ctrl.LoggerInto(ctx, log)
+	log.V(2).Info("Reconciling Cohort")
+
+	var cohort kueue.Cohort
+	if err := r.client.Get(ctx, req.NamespacedName, &cohort); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, err
+		}
+		r.qManager.DeleteCohort(req.Name)
+		r.qManager.QueueInadmissibleWorkloads(ctx, r.cache.DeleteCohort(req.Name))
+		return ctrl.Result{}, nil
+	}
+
+	cqNames, err := r.cache.AddOrUpdateCohort(&cohort)
+	if err == nil {
+		r.qManager.AddOrUpdateCohort(&cohort)
+	} else {
+		// The parent is not set, so that the workloads in the rest of the tree
+		// are not affected by the invalid hierarchy.
+		r.qManager.DeleteCohort(cohort.Name)
+	}
+	r.qManager.QueueInadmissibleWorkloads(ctx, cqNames)
+	return ctrl.Result{}, err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CohortReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&kueue.Cohort{}).
+		Complete(r)
+}
@@ -23,6 +23,8 @@ import (
 
 	config "sigs.k8s.io/kueue/apis/config/v1beta1"
 	"sigs.k8s.io/kueue/pkg/cache"
+	"sigs.k8s.io/kueue/pkg/constants"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/queue"
 )
 
@@ -35,17 +37,36 @@ func SetupControllers(mgr ctrl.Manager, qManager *queue.Manager, cc *cache.Cache
 	if err := rfRec.SetupWithManager(mgr); err != nil {
 		return "ResourceFlavor", err
 	}
+	acRec := NewAdmissionCheckReconciler(mgr.GetClient(), qManager, cc)
+	if err := acRec.SetupWithManager(mgr); err != nil {
+		return "AdmissionCheck", err
+	}
+	if err := NewCohortReconciler(mgr.GetClient(), qManager, cc).SetupWithManager(mgr); err != nil {
+		return "Cohort", err
+	}
+	if err := NewTopologyReconciler(mgr.GetClient(), qManager, cc).SetupWithManager(mgr); err != nil {
+		return "Topology", err
+	}
+	if features.Enabled(features.TopologyAwareScheduling) {
+		if err := NewNodeReconciler(mgr.GetClient(), cc).SetupWithManager(mgr); err != nil {
+			return "Node", err
+		}
+		if err := NewPodReconciler(mgr.GetClient(), cc).SetupWithManager(mgr); err != nil {
+			return "Pod", err
+		}
+	}
 	qRec := NewLocalQueueReconciler(mgr.GetClient(), qManager, cc)
 	if err := qRec.SetupWithManager(mgr); err != nil {
 		return "LocalQueue", err
 	}
 
-	cqRec := NewClusterQueueReconciler(mgr.GetClient(), qManager, cc, cfg.Metrics.EnableClusterQueueResources, rfRec)
+	cqRec := NewClusterQueueReconciler(mgr.GetClient(), qManager, cc, cfg.Metrics.EnableClusterQueueResources, rfRec, acRec)
 	rfRec.AddUpdateWatcher(cqRec)
+	acRec.AddUpdateWatchers(cqRec)
 	if err := cqRec.SetupWithManager(mgr); err != nil {
 		return "ClusterQueue", err
 	}
-	if err := NewWorkloadReconciler(mgr.GetClient(), qManager, cc, WithWorkloadUpdateWatchers(qRec, cqRec), WithPodsReadyTimeout(podsReadyTimeout(cfg))).SetupWithManager(mgr); err != nil {
+	if err := NewWorkloadReconciler(mgr.GetClient(), qManager, cc, mgr.GetEventRecorderFor(constants.WorkloadControllerName), WithWorkloadUpdateWatchers(qRec, cqRec), WithPodsReadyTimeout(podsReadyTimeout(cfg)), WithRequeuingStrategy(requeuingStrategy(cfg))).SetupWithManager(mgr); err != nil {
 		return "Workload", err
 	}
 	return "", nil
@@ -57,3 +78,10 @@ func podsReadyTimeout(cfg *config.Configuration) *time.Duration {
 	}
 	return nil
 }
+
+func requeuingStrategy(cfg *config.Configuration) *config.RequeuingStrategy {
+	if cfg.WaitForPodsReady != nil && cfg.WaitForPodsReady.Enable {
+		return cfg.WaitForPodsReady.RequeuingStrategy
+	}
+	return nil
+}
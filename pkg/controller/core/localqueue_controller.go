@@ -25,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -92,6 +93,11 @@ func (r *LocalQueueReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	ctx = ctrl.LoggerInto(ctx, log)
 	log.V(2).Info("Reconciling LocalQueue")
 
+	if queueObj.Spec.StopPolicy != nil && *queueObj.Spec.StopPolicy != kueue.None {
+		err := r.UpdateStatusIfChanged(ctx, &queueObj, metav1.ConditionFalse, "Stopped", "LocalQueue is stopped")
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
 	var cq kueue.ClusterQueue
 	err := r.client.Get(ctx, client.ObjectKey{Name: string(queueObj.Spec.ClusterQueue)}, &cq)
 	if err != nil {
@@ -153,6 +159,10 @@ func (r *LocalQueueReconciler) Update(e event.UpdateEvent) bool {
 	if err := r.cache.UpdateLocalQueue(oldQ, q); err != nil {
 		log.Error(err, "Failed to update localQueue in the cache")
 	}
+	if !equality.Semantic.DeepEqual(oldQ.Spec.FlavorLimits, q.Spec.FlavorLimits) {
+		// Workloads held back by the old limits might fit now.
+		r.queues.QueueInadmissibleWorkloads(context.Background(), sets.New(string(q.Spec.ClusterQueue)))
+	}
 	return true
 }
 
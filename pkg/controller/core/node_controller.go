@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/kueue/pkg/cache"
+)
+
+// NodeReconciler keeps the capacity of the nodes in the cache up to date,
+// for topology-aware scheduling.
+type NodeReconciler struct {
+	cache  *cache.Cache
+	client client.Client
+}
+
+func NewNodeReconciler(client client.Client, cache *cache.Cache) *NodeReconciler {
+	return &NodeReconciler{
+		cache:  cache,
+		client: client,
+	}
+}
+
+//+kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+
+func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx).WithValues("node", klog.KRef("", req.Name))
+	log.V(3).Info("Reconciling Node")
+
+	var node corev1.Node
+	if err := r.client.Get(ctx, req.NamespacedName, &node); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, err
+		}
+		r.cache.DeleteNode(req.Name)
+		return ctrl.Result{}, nil
+	}
+	r.cache.AddOrUpdateNode(&node)
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NodeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("topology_node").
+		For(&corev1.Node{}).
+		Complete(r)
+}
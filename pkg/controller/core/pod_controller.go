@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/kueue/pkg/cache"
+)
+
+// PodReconciler keeps the usage of the nodes in the cache up to date with the
+// requests of the pods running in them, for topology-aware scheduling.
+type PodReconciler struct {
+	cache  *cache.Cache
+	client client.Client
+}
+
+func NewPodReconciler(client client.Client, cache *cache.Cache) *PodReconciler {
+	return &PodReconciler{
+		cache:  cache,
+		client: client,
+	}
+}
+
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+
+func (r *PodReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx).WithValues("pod", klog.KRef(req.Namespace, req.Name))
+	log.V(3).Info("Reconciling Pod")
+
+	var pod corev1.Pod
+	if err := r.client.Get(ctx, req.NamespacedName, &pod); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, err
+		}
+		r.cache.DeletePod(req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+	r.cache.AddOrUpdatePod(&pod)
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *PodReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("topology_pod").
+		For(&corev1.Pod{}).
+		Complete(r)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/cache"
+	"sigs.k8s.io/kueue/pkg/queue"
+)
+
+// TopologyReconciler reconciles a Topology object
+type TopologyReconciler struct {
+	qManager *queue.Manager
+	cache    *cache.Cache
+	client   client.Client
+}
+
+func NewTopologyReconciler(
+	client client.Client,
+	qMgr *queue.Manager,
+	cache *cache.Cache,
+) *TopologyReconciler {
+	return &TopologyReconciler{
+		qManager: qMgr,
+		cache:    cache,
+		client:   client,
+	}
+}
+
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=topologies,verbs=get;list;watch
+
+func (r *TopologyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx).WithValues("topology", klog.KRef("", req.Name))
+	ctx = ctrl.LoggerInto(ctx, log)
+	log.V(2).Info("Reconciling Topology")
+
+	var topology kueue.Topology
+	if err := r.client.Get(ctx, req.NamespacedName, &topology); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, err
+		}
+		r.qManager.QueueInadmissibleWorkloads(ctx, r.cache.DeleteTopology(req.Name))
+		return ctrl.Result{}, nil
+	}
+
+	r.qManager.QueueInadmissibleWorkloads(ctx, r.cache.AddOrUpdateTopology(&topology))
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TopologyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&kueue.Topology{}).
+		Complete(r)
+}
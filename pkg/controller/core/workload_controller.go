@@ -27,16 +27,22 @@ import (
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/pointer"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	config "sigs.k8s.io/kueue/apis/config/v1beta1"
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/cache"
 	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
+	"sigs.k8s.io/kueue/pkg/metrics"
 	"sigs.k8s.io/kueue/pkg/queue"
 	"sigs.k8s.io/kueue/pkg/util/limitrange"
 	"sigs.k8s.io/kueue/pkg/util/resource"
@@ -45,9 +51,9 @@ import (
 
 const (
 	// statuses for logging purposes
-	pending  = "pending"
-	admitted = "admitted"
-	finished = "finished"
+	pending       = "pending"
+	quotaReserved = "quotaReserved"
+	finished      = "finished"
 )
 
 var (
@@ -55,8 +61,9 @@ var (
 )
 
 type options struct {
-	watchers         []WorkloadUpdateWatcher
-	podsReadyTimeout *time.Duration
+	watchers          []WorkloadUpdateWatcher
+	podsReadyTimeout  *time.Duration
+	requeuingStrategy *config.RequeuingStrategy
 }
 
 // Option configures the reconciler.
@@ -70,6 +77,15 @@ func WithPodsReadyTimeout(value *time.Duration) Option {
 	}
 }
 
+// WithRequeuingStrategy indicates how the controller delays the requeuing
+// of the workloads evicted for exceeding the PodsReady timeout, and when it
+// deactivates them.
+func WithRequeuingStrategy(value *config.RequeuingStrategy) Option {
+	return func(o *options) {
+		o.requeuingStrategy = value
+	}
+}
+
 // WithWorkloadUpdateWatchers allows to specify the workload update watchers
 func WithWorkloadUpdateWatchers(value ...WorkloadUpdateWatcher) Option {
 	return func(o *options) {
@@ -85,27 +101,31 @@ type WorkloadUpdateWatcher interface {
 
 // WorkloadReconciler reconciles a Workload object
 type WorkloadReconciler struct {
-	log              logr.Logger
-	queues           *queue.Manager
-	cache            *cache.Cache
-	client           client.Client
-	watchers         []WorkloadUpdateWatcher
-	podsReadyTimeout *time.Duration
+	log               logr.Logger
+	queues            *queue.Manager
+	cache             *cache.Cache
+	client            client.Client
+	watchers          []WorkloadUpdateWatcher
+	podsReadyTimeout  *time.Duration
+	requeuingStrategy *config.RequeuingStrategy
+	recorder          record.EventRecorder
 }
 
-func NewWorkloadReconciler(client client.Client, queues *queue.Manager, cache *cache.Cache, opts ...Option) *WorkloadReconciler {
+func NewWorkloadReconciler(client client.Client, queues *queue.Manager, cache *cache.Cache, recorder record.EventRecorder, opts ...Option) *WorkloadReconciler {
 	options := defaultOptions
 	for _, opt := range opts {
 		opt(&options)
 	}
 
 	return &WorkloadReconciler{
-		log:              ctrl.Log.WithName("workload-reconciler"),
-		client:           client,
-		queues:           queues,
-		cache:            cache,
-		watchers:         options.watchers,
-		podsReadyTimeout: options.podsReadyTimeout,
+		log:               ctrl.Log.WithName("workload-reconciler"),
+		client:            client,
+		queues:            queues,
+		cache:             cache,
+		watchers:          options.watchers,
+		podsReadyTimeout:  options.podsReadyTimeout,
+		requeuingStrategy: options.requeuingStrategy,
+		recorder:          recorder,
 	}
 }
 
@@ -129,13 +149,65 @@ func (r *WorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	if apimeta.IsStatusConditionTrue(wl.Status.Conditions, kueue.WorkloadFinished) {
 		return ctrl.Result{}, nil
 	}
-	if workload.IsAdmitted(&wl) {
-		return r.reconcileNotReadyTimeout(ctx, req, &wl)
+
+	if !workload.IsActive(&wl) {
+		return r.reconcileInactive(ctx, &wl)
+	}
+
+	if workload.HasQuotaReservation(&wl) {
+		if evictionTriggered, err := r.reconcileCheckBasedEviction(ctx, &wl); evictionTriggered || err != nil {
+			return ctrl.Result{}, err
+		}
+
+		if evictionTriggered, err := r.reconcileStoppedQueueEviction(ctx, &wl); evictionTriggered || err != nil {
+			return ctrl.Result{}, err
+		}
+
+		// The evictions above, like the deactivation, don't wait for the
+		// preemption grace period.
+		if evictionTriggered, remainingTime, err := r.reconcilePreemptionPending(ctx, &wl); evictionTriggered || remainingTime > 0 || err != nil {
+			// Recheck the workload once the grace period ends.
+			return ctrl.Result{RequeueAfter: remainingTime}, err
+		}
+
+		if updated, err := r.reconcileSyncAdmissionChecks(ctx, &wl); updated || err != nil {
+			return ctrl.Result{}, err
+		}
+
+		if !workload.IsAdmitted(&wl) {
+			if workload.SyncAdmittedCondition(&wl) {
+				if err := workload.ApplyAdmissionStatus(ctx, r.client, &wl, true); err != nil {
+					return ctrl.Result{}, client.IgnoreNotFound(err)
+				}
+				if workload.IsAdmitted(&wl) {
+					quotaReservedCond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadQuotaReserved)
+					waitTime := time.Since(quotaReservedCond.LastTransitionTime.Time)
+					r.recorder.Eventf(&wl, corev1.EventTypeNormal, "Admitted", "Admitted by ClusterQueue %v, wait time since reservation was %.0fs", wl.Status.Admission.ClusterQueue, waitTime.Seconds())
+					metrics.AdmittedWorkload(wl.Status.Admission.ClusterQueue, time.Since(wl.CreationTimestamp.Time))
+				}
+			}
+			return ctrl.Result{}, nil
+		}
+
+		evictionTriggered, remainingTime, err := r.reconcileMaxExecutionTime(ctx, &wl)
+		if evictionTriggered || err != nil {
+			return ctrl.Result{}, err
+		}
+		result, err := r.reconcileNotReadyTimeout(ctx, req, &wl)
+		if err == nil && remainingTime > 0 && (result.RequeueAfter == 0 || remainingTime < result.RequeueAfter) {
+			// Recheck the workload once the maximum execution time is reached.
+			result.RequeueAfter = remainingTime
+		}
+		return result, err
+	}
+
+	if workload.IsWaitingForRequeue(&wl) {
+		return r.reconcileRequeueAt(ctx, &wl)
 	}
 
 	if !r.queues.QueueForWorkloadExists(&wl) {
 		log.V(3).Info("Workload is inadmissible because of missing LocalQueue", "localQueue", klog.KRef(wl.Namespace, wl.Spec.QueueName))
-		workload.UnsetAdmissionWithCondition(&wl, "Inadmissible", fmt.Sprintf("LocalQueue %s doesn't exist", wl.Spec.QueueName))
+		workload.UnsetQuotaReservationWithCondition(&wl, "Inadmissible", fmt.Sprintf("LocalQueue %s doesn't exist", wl.Spec.QueueName))
 		err := workload.ApplyAdmissionStatus(ctx, r.client, &wl, true)
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
@@ -143,20 +215,178 @@ func (r *WorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	cqName, cqOk := r.queues.ClusterQueueForWorkload(&wl)
 	if !cqOk {
 		log.V(3).Info("Workload is inadmissible because of missing ClusterQueue", "clusterQueue", klog.KRef("", cqName))
-		workload.UnsetAdmissionWithCondition(&wl, "Inadmissible", fmt.Sprintf("ClusterQueue %s doesn't exist", cqName))
+		workload.UnsetQuotaReservationWithCondition(&wl, "Inadmissible", fmt.Sprintf("ClusterQueue %s doesn't exist", cqName))
 		err := workload.ApplyAdmissionStatus(ctx, r.client, &wl, true)
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
 	if !r.cache.ClusterQueueActive(cqName) {
 		log.V(3).Info("Workload is inadmissible because ClusterQueue is inactive", "clusterQueue", klog.KRef("", cqName))
-		workload.UnsetAdmissionWithCondition(&wl, "Inadmissible", fmt.Sprintf("ClusterQueue %s is inactive", cqName))
+		workload.UnsetQuotaReservationWithCondition(&wl, "Inadmissible", fmt.Sprintf("ClusterQueue %s is inactive", cqName))
 		err := workload.ApplyAdmissionStatus(ctx, r.client, &wl, true)
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 	return ctrl.Result{}, nil
 }
 
+// reconcileCheckBasedEviction evicts the workload if at least one of its
+// admission checks is in the Retry or Rejected state.
+func (r *WorkloadReconciler) reconcileCheckBasedEviction(ctx context.Context, wl *kueue.Workload) (bool, error) {
+	if apimeta.IsStatusConditionTrue(wl.Status.Conditions, kueue.WorkloadEvicted) || !workload.HasRetryOrRejectedChecks(wl) {
+		return false, nil
+	}
+	log := ctrl.LoggerFrom(ctx)
+	log.V(3).Info("Workload is evicted due to admission checks")
+	workload.SetEvictedCondition(wl, kueue.WorkloadEvictedByAdmissionCheck, "At least one admission check is false")
+	err := workload.ApplyAdmissionStatus(ctx, r.client, wl, true)
+	return true, client.IgnoreNotFound(err)
+}
+
+// reconcileInactive evicts the workload if it has quota reserved. Once the
+// job is stopped, the workload stays out of the queues until it's activated
+// again. The requeue state is reset, so that the workload starts over with
+// its backoff when activated.
+func (r *WorkloadReconciler) reconcileInactive(ctx context.Context, wl *kueue.Workload) (ctrl.Result, error) {
+	evict := workload.HasQuotaReservation(wl) && !apimeta.IsStatusConditionTrue(wl.Status.Conditions, kueue.WorkloadEvicted)
+	if !evict && wl.Status.RequeueState == nil {
+		return ctrl.Result{}, nil
+	}
+	log := ctrl.LoggerFrom(ctx)
+	if evict {
+		log.V(3).Info("Workload is evicted because it's inactive")
+		workload.SetEvictedCondition(wl, kueue.WorkloadEvictedByDeactivation, "The workload is deactivated")
+	}
+	wl.Status.RequeueState = nil
+	err := workload.ApplyAdmissionStatus(ctx, r.client, wl, true)
+	return ctrl.Result{}, client.IgnoreNotFound(err)
+}
+
+// reconcileRequeueAt keeps the workload out of the queues until its
+// requeueAt time. Once the time passes, requeueAt is cleared, which queues
+// the workload again.
+func (r *WorkloadReconciler) reconcileRequeueAt(ctx context.Context, wl *kueue.Workload) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if waitFor := wl.Status.RequeueState.RequeueAt.Sub(realClock.Now()); waitFor > 0 {
+		log.V(4).Info("Workload is waiting to be requeued", "requeueAfter", waitFor)
+		return ctrl.Result{RequeueAfter: waitFor}, nil
+	}
+	log.V(3).Info("Workload backoff finished, requeuing")
+	wl.Status.RequeueState.RequeueAt = nil
+	err := workload.ApplyAdmissionStatus(ctx, r.client, wl, true)
+	return ctrl.Result{}, client.IgnoreNotFound(err)
+}
+
+// reconcileMaxExecutionTime evicts the admitted workload if it exceeded its
+// maximum execution time. Otherwise, it returns the remaining execution time,
+// if the workload has a maximum.
+func (r *WorkloadReconciler) reconcileMaxExecutionTime(ctx context.Context, wl *kueue.Workload) (bool, time.Duration, error) {
+	if apimeta.IsStatusConditionTrue(wl.Status.Conditions, kueue.WorkloadEvicted) {
+		return false, 0, nil
+	}
+	remaining, limited := remainingExecutionTime(wl, realClock)
+	if !limited || remaining > 0 {
+		return false, remaining, nil
+	}
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Workload is evicted for exceeding its maximum execution time")
+	workload.SetEvictedCondition(wl, kueue.WorkloadEvictedByMaximumExecutionTime, fmt.Sprintf("The maximum execution time (%ds) exceeded", *wl.Spec.MaximumExecutionTimeSeconds))
+	err := workload.ApplyAdmissionStatus(ctx, r.client, wl, true)
+	return true, 0, client.IgnoreNotFound(err)
+}
+
+// remainingExecutionTime returns the time left before the workload exceeds its
+// maximum execution time, and whether the workload has a maximum.
+func remainingExecutionTime(wl *kueue.Workload, clock clock.Clock) (time.Duration, bool) {
+	if wl.Spec.MaximumExecutionTimeSeconds == nil {
+		return 0, false
+	}
+	remaining := time.Duration(*wl.Spec.MaximumExecutionTimeSeconds)*time.Second - workload.ExecutionTime(wl, clock.Now())
+	if remaining < 0 {
+		remaining = 0
+	}
+	return remaining, true
+}
+
+// reconcilePreemptionPending evicts the workload once the grace period of its
+// pending preemption ends. It returns the time left in the grace period.
+func (r *WorkloadReconciler) reconcilePreemptionPending(ctx context.Context, wl *kueue.Workload) (bool, time.Duration, error) {
+	if !workload.IsPreemptionPending(wl) {
+		return false, 0, nil
+	}
+	if remaining := remainingPreemptionGracePeriod(wl, realClock); remaining > 0 {
+		return false, remaining, nil
+	}
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Workload is evicted at the end of its preemption grace period")
+	cond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadPreemptionPending)
+	workload.SetEvictedCondition(wl, kueue.WorkloadEvictedByPreemption, cond.Message)
+	err := workload.ApplyAdmissionStatus(ctx, r.client, wl, true)
+	return true, 0, client.IgnoreNotFound(err)
+}
+
+// remainingPreemptionGracePeriod returns the time left before a workload
+// pending preemption is evicted.
+func remainingPreemptionGracePeriod(wl *kueue.Workload, clock clock.Clock) time.Duration {
+	if wl.Status.PreemptionDeadline == nil {
+		return 0
+	}
+	if remaining := wl.Status.PreemptionDeadline.Sub(clock.Now()); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// reconcileStoppedQueueEviction evicts the workload if its ClusterQueue or its
+// LocalQueue is stopped with the HoldAndDrain policy.
+func (r *WorkloadReconciler) reconcileStoppedQueueEviction(ctx context.Context, wl *kueue.Workload) (bool, error) {
+	if apimeta.IsStatusConditionTrue(wl.Status.Conditions, kueue.WorkloadEvicted) {
+		return false, nil
+	}
+	var cq kueue.ClusterQueue
+	if err := r.client.Get(ctx, types.NamespacedName{Name: string(wl.Status.Admission.ClusterQueue)}, &cq); client.IgnoreNotFound(err) != nil {
+		return false, err
+	}
+	var lq kueue.LocalQueue
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: wl.Namespace, Name: wl.Spec.QueueName}, &lq); client.IgnoreNotFound(err) != nil {
+		return false, err
+	}
+	var reason, msg string
+	switch {
+	case isDrained(cq.Spec.StopPolicy):
+		reason, msg = kueue.WorkloadEvictedByClusterQueueStopped, "The ClusterQueue is stopped"
+	case isDrained(lq.Spec.StopPolicy):
+		reason, msg = kueue.WorkloadEvictedByLocalQueueStopped, "The LocalQueue is stopped"
+	default:
+		return false, nil
+	}
+	log := ctrl.LoggerFrom(ctx)
+	log.V(3).Info("Workload is evicted due to a stopped queue", "reason", reason)
+	workload.SetEvictedCondition(wl, reason, msg)
+	err := workload.ApplyAdmissionStatus(ctx, r.client, wl, true)
+	return true, client.IgnoreNotFound(err)
+}
+
+func isDrained(policy *kueue.StopPolicy) bool {
+	return policy != nil && *policy == kueue.HoldAndDrain
+}
+
+// reconcileSyncAdmissionChecks makes the admission checks of the workload
+// match the ones required by its ClusterQueue.
+func (r *WorkloadReconciler) reconcileSyncAdmissionChecks(ctx context.Context, wl *kueue.Workload) (bool, error) {
+	cqName := string(wl.Status.Admission.ClusterQueue)
+	checks, found := r.cache.ClusterQueueAdmissionChecks(cqName)
+	if !found {
+		return false, nil
+	}
+	if workload.SyncAdmissionChecks(wl, checks) {
+		log := ctrl.LoggerFrom(ctx)
+		log.V(3).Info("The workload needs admission checks updates", "clusterQueue", klog.KRef("", cqName), "admissionChecks", sets.List(checks))
+		err := workload.ApplyAdmissionStatus(ctx, r.client, wl, true)
+		return true, client.IgnoreNotFound(err)
+	}
+	return false, nil
+}
+
 func (r *WorkloadReconciler) reconcileNotReadyTimeout(ctx context.Context, req ctrl.Request, wl *kueue.Workload) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
 	countingTowardsTimeout, recheckAfter := r.admittedNotReadyWorkload(wl, realClock)
@@ -166,12 +396,57 @@ func (r *WorkloadReconciler) reconcileNotReadyTimeout(ctx context.Context, req c
 	if recheckAfter > 0 {
 		log.V(4).Info("Workload not yet ready and did not exceed its timeout", "recheckAfter", recheckAfter)
 		return ctrl.Result{RequeueAfter: recheckAfter}, nil
-	} else {
-		log.V(2).Info("Start the eviction of the workload due to exceeding the PodsReady timeout")
-		workload.SetEvictedCondition(wl, kueue.WorkloadEvictedByPodsReadyTimeout, fmt.Sprintf("Exceeded the PodsReady timeout %s", req.NamespacedName.String()))
-		err := workload.ApplyAdmissionStatus(ctx, r.client, wl, false)
-		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
+	if apimeta.IsStatusConditionTrue(wl.Status.Conditions, kueue.WorkloadEvicted) {
+		// The eviction is already in progress.
+		return ctrl.Result{}, nil
+	}
+	requeueState, deactivate := r.nextRequeueState(wl, realClock)
+	if deactivate {
+		log.V(2).Info("Deactivating the workload because it exceeded the backoff limit for the PodsReady timeout")
+		wl.Spec.Active = pointer.Bool(false)
+		if err := r.client.Update(ctx, wl); err != nil {
+			return ctrl.Result{}, client.IgnoreNotFound(err)
+		}
+		r.recorder.Eventf(wl, corev1.EventTypeNormal, "WorkloadDeactivated", "Deactivated after exceeding the PodsReady timeout more times than the backoff limit of %d", *r.requeuingStrategy.BackoffLimitCount)
+		return ctrl.Result{}, nil
+	}
+	log.V(2).Info("Start the eviction of the workload due to exceeding the PodsReady timeout")
+	wl.Status.RequeueState = requeueState
+	workload.SetEvictedCondition(wl, kueue.WorkloadEvictedByPodsReadyTimeout, fmt.Sprintf("Exceeded the PodsReady timeout %s", req.NamespacedName.String()))
+	err := workload.ApplyAdmissionStatus(ctx, r.client, wl, false)
+	return ctrl.Result{}, client.IgnoreNotFound(err)
+}
+
+// nextRequeueState returns the requeue state of the workload after an
+// eviction for exceeding the PodsReady timeout, or whether the workload has
+// to be deactivated because it reached the backoff limit.
+// The backoff grows exponentially with the number of evictions, up to the
+// maximum backoff. Without a requeuing strategy the workload is requeued
+// immediately.
+func (r *WorkloadReconciler) nextRequeueState(wl *kueue.Workload, clock clock.Clock) (*kueue.RequeueState, bool) {
+	strategy := r.requeuingStrategy
+	if strategy == nil {
+		return wl.Status.RequeueState, false
+	}
+	var count int32
+	if wl.Status.RequeueState != nil && wl.Status.RequeueState.Count != nil {
+		count = *wl.Status.RequeueState.Count
+	}
+	if strategy.BackoffLimitCount != nil && count >= *strategy.BackoffLimitCount {
+		return wl.Status.RequeueState, true
+	}
+	count++
+	backoff := time.Duration(pointer.Int32Deref(strategy.BackoffBaseSeconds, config.DefaultRequeuingBackoffBase)) * time.Second
+	maxBackoff := time.Duration(pointer.Int32Deref(strategy.BackoffMaxSeconds, config.DefaultRequeuingBackoffMax)) * time.Second
+	for i := int32(1); i < count && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	requeueAt := metav1.NewTime(clock.Now().Add(backoff))
+	return &kueue.RequeueState{Count: pointer.Int32(count), RequeueAt: &requeueAt}, false
 }
 
 func (r *WorkloadReconciler) Create(e event.CreateEvent) bool {
@@ -192,7 +467,7 @@ func (r *WorkloadReconciler) Create(e event.CreateEvent) bool {
 	wlCopy := wl.DeepCopy()
 	r.adjustResources(log, wlCopy)
 
-	if !workload.IsAdmitted(wl) {
+	if !workload.HasQuotaReservation(wl) {
 		if !r.queues.AddOrUpdateWorkload(wlCopy) {
 			log.V(2).Info("Queue for workload didn't exist; ignored for now")
 		}
@@ -223,7 +498,7 @@ func (r *WorkloadReconciler) Delete(e event.DeleteEvent) bool {
 	// When assigning a clusterQueue to a workload, we assume it in the cache. If
 	// the state is unknown, the workload could have been assumed and we need
 	// to clear it from the cache.
-	if workload.IsAdmitted(wl) || e.DeleteStateUnknown {
+	if workload.HasQuotaReservation(wl) || e.DeleteStateUnknown {
 		// trigger the move of associated inadmissibleWorkloads if required.
 		r.queues.QueueAssociatedInadmissibleWorkloadsAfter(ctx, wl, func() {
 			// Delete the workload from cache while holding the queues lock
@@ -239,7 +514,7 @@ func (r *WorkloadReconciler) Delete(e event.DeleteEvent) bool {
 
 	// Even if the state is unknown, the last cached state tells us whether the
 	// workload was in the queues and should be cleared from them.
-	if workload.IsAdmitted(wl) {
+	if workload.HasQuotaReservation(wl) {
 		r.queues.DeleteWorkload(wl)
 	}
 	return true
@@ -266,10 +541,10 @@ func (r *WorkloadReconciler) Update(e event.UpdateEvent) bool {
 	if prevStatus != status {
 		log = log.WithValues("prevStatus", prevStatus)
 	}
-	if workload.IsAdmitted(wl) {
+	if workload.HasQuotaReservation(wl) {
 		log = log.WithValues("clusterQueue", wl.Status.Admission.ClusterQueue)
 	}
-	if workload.IsAdmitted(oldWl) && (!workload.IsAdmitted(wl) || wl.Status.Admission.ClusterQueue != oldWl.Status.Admission.ClusterQueue) {
+	if workload.HasQuotaReservation(oldWl) && (!workload.HasQuotaReservation(wl) || wl.Status.Admission.ClusterQueue != oldWl.Status.Admission.ClusterQueue) {
 		log = log.WithValues("prevClusterQueue", oldWl.Status.Admission.ClusterQueue)
 	}
 	log.V(2).Info("Workload update event")
@@ -288,7 +563,7 @@ func (r *WorkloadReconciler) Update(e event.UpdateEvent) bool {
 			// Delete the workload from cache while holding the queues lock
 			// to guarantee that requeueued workloads are taken into account before
 			// the next scheduling cycle.
-			if err := r.cache.DeleteWorkload(oldWl); err != nil && prevStatus == admitted {
+			if err := r.cache.DeleteWorkload(oldWl); err != nil && prevStatus == quotaReserved {
 				log.Error(err, "Failed to delete workload from cache")
 			}
 		})
@@ -298,12 +573,12 @@ func (r *WorkloadReconciler) Update(e event.UpdateEvent) bool {
 			log.V(2).Info("Queue for updated workload didn't exist; ignoring for now")
 		}
 
-	case prevStatus == pending && status == admitted:
+	case prevStatus == pending && status == quotaReserved:
 		r.queues.DeleteWorkload(oldWl)
 		if !r.cache.AddOrUpdateWorkload(wlCopy) {
 			log.V(2).Info("ClusterQueue for workload didn't exist; ignored for now")
 		}
-	case prevStatus == admitted && status == pending:
+	case prevStatus == quotaReserved && status == pending:
 		// trigger the move of associated inadmissibleWorkloads, if there are any.
 		r.queues.QueueAssociatedInadmissibleWorkloadsAfter(ctx, wl, func() {
 			// Delete the workload from cache while holding the queues lock
@@ -344,10 +619,15 @@ func (r *WorkloadReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	ruh := &resourceUpdatesHandler{
 		r: r,
 	}
+	dqh := &drainedQueueHandler{
+		client: r.client,
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&kueue.Workload{}).
 		Watches(&corev1.LimitRange{}, ruh).
 		Watches(&nodev1.RuntimeClass{}, ruh).
+		Watches(&kueue.ClusterQueue{}, dqh).
+		Watches(&kueue.LocalQueue{}, dqh).
 		WithEventFilter(r).
 		Complete(r)
 }
@@ -388,8 +668,8 @@ func workloadStatus(w *kueue.Workload) string {
 	if apimeta.IsStatusConditionTrue(w.Status.Conditions, kueue.WorkloadFinished) {
 		return finished
 	}
-	if workload.IsAdmitted(w) {
-		return admitted
+	if workload.HasQuotaReservation(w) {
+		return quotaReserved
 	}
 	return pending
 }
@@ -515,7 +795,7 @@ func (h *resourceUpdatesHandler) handle(ctx context.Context, obj client.Object,
 func (h *resourceUpdatesHandler) queueReconcileForPending(ctx context.Context, _ workqueue.RateLimitingInterface, opts ...client.ListOption) {
 	log := ctrl.LoggerFrom(ctx)
 	lst := kueue.WorkloadList{}
-	opts = append(opts, client.MatchingFields{indexer.WorkloadAdmittedKey: string(metav1.ConditionFalse)})
+	opts = append(opts, client.MatchingFields{indexer.WorkloadQuotaReservedKey: string(metav1.ConditionFalse)})
 	err := h.r.client.List(ctx, &lst, opts...)
 	if err != nil {
 		log.Error(err, "Could not list pending workloads")
@@ -531,3 +811,50 @@ func (h *resourceUpdatesHandler) queueReconcileForPending(ctx context.Context, _
 		}
 	}
 }
+
+// drainedQueueHandler queues the reconciliation of the workloads with quota
+// reservation in a ClusterQueue or a LocalQueue whose stop policy changes to
+// HoldAndDrain, so that they are evicted.
+type drainedQueueHandler struct {
+	client client.Client
+}
+
+func (h *drainedQueueHandler) Create(context.Context, event.CreateEvent, workqueue.RateLimitingInterface) {
+}
+
+func (h *drainedQueueHandler) Update(ctx context.Context, e event.UpdateEvent, q workqueue.RateLimitingInterface) {
+	var opts []client.ListOption
+	switch newObj := e.ObjectNew.(type) {
+	case *kueue.ClusterQueue:
+		oldObj := e.ObjectOld.(*kueue.ClusterQueue)
+		if isDrained(oldObj.Spec.StopPolicy) || !isDrained(newObj.Spec.StopPolicy) {
+			return
+		}
+		opts = append(opts, client.MatchingFields{indexer.WorkloadClusterQueueKey: newObj.Name})
+	case *kueue.LocalQueue:
+		oldObj := e.ObjectOld.(*kueue.LocalQueue)
+		if isDrained(oldObj.Spec.StopPolicy) || !isDrained(newObj.Spec.StopPolicy) {
+			return
+		}
+		opts = append(opts, client.InNamespace(newObj.Namespace), client.MatchingFields{indexer.WorkloadQueueKey: newObj.Name})
+	default:
+		return
+	}
+	log := ctrl.LoggerFrom(ctx).WithValues("queue", klog.KObj(e.ObjectNew))
+	var lst kueue.WorkloadList
+	if err := h.client.List(ctx, &lst, opts...); err != nil {
+		log.Error(err, "Could not list the workloads of the stopped queue")
+		return
+	}
+	for i := range lst.Items {
+		if workload.HasQuotaReservation(&lst.Items[i]) {
+			q.Add(reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&lst.Items[i])})
+		}
+	}
+}
+
+func (h *drainedQueueHandler) Delete(context.Context, event.DeleteEvent, workqueue.RateLimitingInterface) {
+}
+
+func (h *drainedQueueHandler) Generic(context.Context, event.GenericEvent, workqueue.RateLimitingInterface) {
+}
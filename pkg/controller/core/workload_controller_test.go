@@ -20,11 +20,30 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 	testingclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	config "sigs.k8s.io/kueue/apis/config/v1beta1"
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/cache"
+	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/controller/jobs/job"
+	"sigs.k8s.io/kueue/pkg/queue"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingjob "sigs.k8s.io/kueue/pkg/util/testingjobs/job"
+	"sigs.k8s.io/kueue/pkg/workload"
 )
 
 func TestAdmittedNotReadyWorkload(t *testing.T) {
@@ -176,3 +195,505 @@ func TestAdmittedNotReadyWorkload(t *testing.T) {
 		})
 	}
 }
+
+func TestRemainingExecutionTime(t *testing.T) {
+	now := time.Now()
+	fakeClock := testingclock.NewFakeClock(now)
+	admitted := metav1.Condition{
+		Type:               kueue.WorkloadAdmitted,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.NewTime(now.Add(-time.Minute)),
+	}
+
+	testCases := map[string]struct {
+		workload      kueue.Workload
+		wantRemaining time.Duration
+		wantLimited   bool
+	}{
+		"no maximum execution time": {
+			workload: kueue.Workload{
+				Status: kueue.WorkloadStatus{Conditions: []metav1.Condition{admitted}},
+			},
+		},
+		"not admitted": {
+			workload: kueue.Workload{
+				Spec: kueue.WorkloadSpec{MaximumExecutionTimeSeconds: pointer.Int32(300)},
+			},
+			wantRemaining: 5 * time.Minute,
+			wantLimited:   true,
+		},
+		"admitted": {
+			workload: kueue.Workload{
+				Spec:   kueue.WorkloadSpec{MaximumExecutionTimeSeconds: pointer.Int32(300)},
+				Status: kueue.WorkloadStatus{Conditions: []metav1.Condition{admitted}},
+			},
+			wantRemaining: 4 * time.Minute,
+			wantLimited:   true,
+		},
+		"admitted, with time accumulated before previous evictions": {
+			workload: kueue.Workload{
+				Spec: kueue.WorkloadSpec{MaximumExecutionTimeSeconds: pointer.Int32(300)},
+				Status: kueue.WorkloadStatus{
+					Conditions:                          []metav1.Condition{admitted},
+					AccumulatedPastExecutionTimeSeconds: pointer.Int32(120),
+				},
+			},
+			wantRemaining: 2 * time.Minute,
+			wantLimited:   true,
+		},
+		"maximum execution time exceeded": {
+			workload: kueue.Workload{
+				Spec: kueue.WorkloadSpec{MaximumExecutionTimeSeconds: pointer.Int32(300)},
+				Status: kueue.WorkloadStatus{
+					Conditions:                          []metav1.Condition{admitted},
+					AccumulatedPastExecutionTimeSeconds: pointer.Int32(600),
+				},
+			},
+			wantLimited: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			remaining, limited := remainingExecutionTime(&tc.workload, fakeClock)
+			if tc.wantRemaining != remaining {
+				t.Errorf("Unexpected remaining time, want=%v, got=%v", tc.wantRemaining, remaining)
+			}
+			if tc.wantLimited != limited {
+				t.Errorf("Unexpected limited, want=%v, got=%v", tc.wantLimited, limited)
+			}
+		})
+	}
+}
+
+func TestRemainingPreemptionGracePeriod(t *testing.T) {
+	now := time.Now()
+	fakeClock := testingclock.NewFakeClock(now)
+	deadline := func(d time.Duration) *metav1.Time {
+		ts := metav1.NewTime(now.Add(d))
+		return &ts
+	}
+
+	testCases := map[string]struct {
+		workload      kueue.Workload
+		wantRemaining time.Duration
+	}{
+		"no deadline": {},
+		"deadline in the future": {
+			workload: kueue.Workload{
+				Status: kueue.WorkloadStatus{PreemptionDeadline: deadline(time.Minute)},
+			},
+			wantRemaining: time.Minute,
+		},
+		"deadline passed": {
+			workload: kueue.Workload{
+				Status: kueue.WorkloadStatus{PreemptionDeadline: deadline(-time.Minute)},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if remaining := remainingPreemptionGracePeriod(&tc.workload, fakeClock); tc.wantRemaining != remaining {
+				t.Errorf("Unexpected remaining time, want=%v, got=%v", tc.wantRemaining, remaining)
+			}
+		})
+	}
+}
+
+func TestNextRequeueState(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	fakeClock := testingclock.NewFakeClock(now)
+	requeueAt := func(d time.Duration) *metav1.Time {
+		ts := metav1.NewTime(now.Add(d))
+		return &ts
+	}
+	strategy := &config.RequeuingStrategy{
+		BackoffLimitCount:  pointer.Int32(3),
+		BackoffBaseSeconds: pointer.Int32(60),
+		BackoffMaxSeconds:  pointer.Int32(150),
+	}
+
+	testCases := map[string]struct {
+		requeueState      *kueue.RequeueState
+		requeuingStrategy *config.RequeuingStrategy
+		wantRequeueState  *kueue.RequeueState
+		wantDeactivate    bool
+	}{
+		"no requeuing strategy": {},
+		"first eviction": {
+			requeuingStrategy: strategy,
+			wantRequeueState:  &kueue.RequeueState{Count: pointer.Int32(1), RequeueAt: requeueAt(time.Minute)},
+		},
+		"exponential backoff": {
+			requeueState:      &kueue.RequeueState{Count: pointer.Int32(1)},
+			requeuingStrategy: strategy,
+			wantRequeueState:  &kueue.RequeueState{Count: pointer.Int32(2), RequeueAt: requeueAt(2 * time.Minute)},
+		},
+		"backoff capped to the maximum": {
+			requeueState:      &kueue.RequeueState{Count: pointer.Int32(2)},
+			requeuingStrategy: strategy,
+			wantRequeueState:  &kueue.RequeueState{Count: pointer.Int32(3), RequeueAt: requeueAt(150 * time.Second)},
+		},
+		"backoff limit reached": {
+			requeueState:      &kueue.RequeueState{Count: pointer.Int32(3)},
+			requeuingStrategy: strategy,
+			wantRequeueState:  &kueue.RequeueState{Count: pointer.Int32(3)},
+			wantDeactivate:    true,
+		},
+		"unlimited retries": {
+			requeueState: &kueue.RequeueState{Count: pointer.Int32(30)},
+			requeuingStrategy: &config.RequeuingStrategy{
+				BackoffBaseSeconds: pointer.Int32(60),
+				BackoffMaxSeconds:  pointer.Int32(3600),
+			},
+			wantRequeueState: &kueue.RequeueState{Count: pointer.Int32(31), RequeueAt: requeueAt(time.Hour)},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			wRec := WorkloadReconciler{requeuingStrategy: tc.requeuingStrategy}
+			wl := kueue.Workload{Status: kueue.WorkloadStatus{RequeueState: tc.requeueState}}
+			requeueState, deactivate := wRec.nextRequeueState(&wl, fakeClock)
+			if diff := cmp.Diff(tc.wantRequeueState, requeueState); diff != "" {
+				t.Errorf("Unexpected requeue state (-want,+got):\n%s", diff)
+			}
+			if tc.wantDeactivate != deactivate {
+				t.Errorf("Unexpected deactivate, want=%v, got=%v", tc.wantDeactivate, deactivate)
+			}
+		})
+	}
+}
+
+func TestReconcileStoppedQueueEviction(t *testing.T) {
+	baseWorkload := utiltesting.MakeWorkload("wl", "ns").
+		Queue("lq").
+		ReserveQuota(utiltesting.MakeAdmission("cq").Obj())
+
+	testCases := map[string]struct {
+		cq              *kueue.ClusterQueue
+		lq              *kueue.LocalQueue
+		workload        *kueue.Workload
+		wantEvicted     bool
+		wantEvictReason string
+	}{
+		"queues not stopped": {
+			cq:       utiltesting.MakeClusterQueue("cq").Obj(),
+			lq:       utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj(),
+			workload: baseWorkload.Clone().Obj(),
+		},
+		"ClusterQueue on hold": {
+			cq:       utiltesting.MakeClusterQueue("cq").StopPolicy(kueue.Hold).Obj(),
+			lq:       utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj(),
+			workload: baseWorkload.Clone().Obj(),
+		},
+		"ClusterQueue on hold and drain": {
+			cq:              utiltesting.MakeClusterQueue("cq").StopPolicy(kueue.HoldAndDrain).Obj(),
+			lq:              utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj(),
+			workload:        baseWorkload.Clone().Obj(),
+			wantEvicted:     true,
+			wantEvictReason: kueue.WorkloadEvictedByClusterQueueStopped,
+		},
+		"LocalQueue on hold": {
+			cq:       utiltesting.MakeClusterQueue("cq").Obj(),
+			lq:       utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").StopPolicy(kueue.Hold).Obj(),
+			workload: baseWorkload.Clone().Obj(),
+		},
+		"LocalQueue on hold and drain": {
+			cq:              utiltesting.MakeClusterQueue("cq").Obj(),
+			lq:              utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").StopPolicy(kueue.HoldAndDrain).Obj(),
+			workload:        baseWorkload.Clone().Obj(),
+			wantEvicted:     true,
+			wantEvictReason: kueue.WorkloadEvictedByLocalQueueStopped,
+		},
+		"ClusterQueue on hold and drain, LocalQueue on hold": {
+			cq:              utiltesting.MakeClusterQueue("cq").StopPolicy(kueue.HoldAndDrain).Obj(),
+			lq:              utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").StopPolicy(kueue.Hold).Obj(),
+			workload:        baseWorkload.Clone().Obj(),
+			wantEvicted:     true,
+			wantEvictReason: kueue.WorkloadEvictedByClusterQueueStopped,
+		},
+		"already evicted": {
+			cq: utiltesting.MakeClusterQueue("cq").StopPolicy(kueue.HoldAndDrain).Obj(),
+			lq: utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj(),
+			workload: baseWorkload.Clone().
+				Condition(metav1.Condition{
+					Type:   kueue.WorkloadEvicted,
+					Status: metav1.ConditionTrue,
+					Reason: kueue.WorkloadEvictedByPreemption,
+				}).
+				Obj(),
+			wantEvicted:     true,
+			wantEvictReason: kueue.WorkloadEvictedByPreemption,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(tc.cq, tc.lq, tc.workload).
+				WithStatusSubresource(tc.workload).
+				Build()
+			r := &WorkloadReconciler{client: cl}
+
+			wl := tc.workload.DeepCopy()
+			if _, err := r.reconcileStoppedQueueEviction(ctx, wl); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var gotWl kueue.Workload
+			if err := cl.Get(ctx, client.ObjectKeyFromObject(tc.workload), &gotWl); err != nil {
+				t.Fatalf("Couldn't get the workload: %v", err)
+			}
+			evictedCond := apimeta.FindStatusCondition(gotWl.Status.Conditions, kueue.WorkloadEvicted)
+			gotEvicted := evictedCond != nil && evictedCond.Status == metav1.ConditionTrue
+			if gotEvicted != tc.wantEvicted {
+				t.Fatalf("Unexpected evicted, want=%v, got=%v", tc.wantEvicted, gotEvicted)
+			}
+			if tc.wantEvicted && evictedCond.Reason != tc.wantEvictReason {
+				t.Errorf("Unexpected eviction reason, want=%q, got=%q", tc.wantEvictReason, evictedCond.Reason)
+			}
+		})
+	}
+}
+
+func TestDrainedQueueHandlerUpdate(t *testing.T) {
+	workloads := []kueue.Workload{
+		*utiltesting.MakeWorkload("reserved", "ns").
+			Queue("lq").
+			ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+			Obj(),
+		*utiltesting.MakeWorkload("pending", "ns").
+			Queue("lq").
+			Obj(),
+		*utiltesting.MakeWorkload("other-queue", "ns").
+			Queue("other-lq").
+			ReserveQuota(utiltesting.MakeAdmission("other-cq").Obj()).
+			Obj(),
+	}
+
+	testCases := map[string]struct {
+		oldObj       client.Object
+		newObj       client.Object
+		wantRequests []reconcile.Request
+	}{
+		"ClusterQueue put on hold": {
+			oldObj: utiltesting.MakeClusterQueue("cq").Obj(),
+			newObj: utiltesting.MakeClusterQueue("cq").StopPolicy(kueue.Hold).Obj(),
+		},
+		"ClusterQueue put on hold and drain": {
+			oldObj: utiltesting.MakeClusterQueue("cq").Obj(),
+			newObj: utiltesting.MakeClusterQueue("cq").StopPolicy(kueue.HoldAndDrain).Obj(),
+			wantRequests: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "reserved"}},
+			},
+		},
+		"ClusterQueue already drained": {
+			oldObj: utiltesting.MakeClusterQueue("cq").StopPolicy(kueue.HoldAndDrain).Obj(),
+			newObj: utiltesting.MakeClusterQueue("cq").StopPolicy(kueue.HoldAndDrain).Obj(),
+		},
+		"LocalQueue put on hold": {
+			oldObj: utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj(),
+			newObj: utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").StopPolicy(kueue.Hold).Obj(),
+		},
+		"LocalQueue put on hold and drain": {
+			oldObj: utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj(),
+			newObj: utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").StopPolicy(kueue.HoldAndDrain).Obj(),
+			wantRequests: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "reserved"}},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().
+				WithLists(&kueue.WorkloadList{Items: workloads}).
+				Build()
+			h := &drainedQueueHandler{client: cl}
+			q := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+			defer q.ShutDown()
+
+			h.Update(ctx, event.UpdateEvent{ObjectOld: tc.oldObj, ObjectNew: tc.newObj}, q)
+
+			var gotRequests []reconcile.Request
+			for q.Len() > 0 {
+				item, _ := q.Get()
+				gotRequests = append(gotRequests, item.(reconcile.Request))
+				q.Done(item)
+			}
+			if diff := cmp.Diff(tc.wantRequests, gotRequests); diff != "" {
+				t.Errorf("Unexpected requests (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReconcileEvictionsDuringPreemptionGracePeriod(t *testing.T) {
+	pendingPreemption := func(deadline time.Time) *utiltesting.WorkloadWrapper {
+		w := utiltesting.MakeWorkload("wl", "ns").
+			Queue("lq").
+			ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+			Condition(metav1.Condition{
+				Type:    kueue.WorkloadPreemptionPending,
+				Status:  metav1.ConditionTrue,
+				Reason:  "Preempted",
+				Message: "Preempted to accommodate a higher priority Workload",
+			})
+		w.Status.PreemptionDeadline = &metav1.Time{Time: deadline}
+		return w
+	}
+	inGracePeriod := pendingPreemption(time.Now().Add(time.Hour))
+
+	testCases := map[string]struct {
+		cq              *kueue.ClusterQueue
+		workload        *kueue.Workload
+		wantEvictReason string
+		wantRequeue     bool
+	}{
+		"grace period not ended": {
+			cq:          utiltesting.MakeClusterQueue("cq").Obj(),
+			workload:    inGracePeriod.Clone().Obj(),
+			wantRequeue: true,
+		},
+		"grace period ended": {
+			cq:              utiltesting.MakeClusterQueue("cq").Obj(),
+			workload:        pendingPreemption(time.Now().Add(-time.Minute)).Obj(),
+			wantEvictReason: kueue.WorkloadEvictedByPreemption,
+		},
+		"deactivated": {
+			cq:              utiltesting.MakeClusterQueue("cq").Obj(),
+			workload:        inGracePeriod.Clone().Active(false).Obj(),
+			wantEvictReason: kueue.WorkloadEvictedByDeactivation,
+		},
+		"ClusterQueue on hold and drain": {
+			cq:              utiltesting.MakeClusterQueue("cq").StopPolicy(kueue.HoldAndDrain).Obj(),
+			workload:        inGracePeriod.Clone().Obj(),
+			wantEvictReason: kueue.WorkloadEvictedByClusterQueueStopped,
+		},
+		"admission check rejected": {
+			cq: utiltesting.MakeClusterQueue("cq").Obj(),
+			workload: inGracePeriod.Clone().
+				AdmissionCheck(kueue.AdmissionCheckState{Name: "check", State: kueue.CheckStateRejected}).
+				Obj(),
+			wantEvictReason: kueue.WorkloadEvictedByAdmissionCheck,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(tc.cq, utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj(), tc.workload).
+				WithStatusSubresource(tc.workload).
+				Build()
+			r := &WorkloadReconciler{client: cl}
+
+			result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(tc.workload)})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if gotRequeue := result.RequeueAfter > 0; gotRequeue != tc.wantRequeue {
+				t.Errorf("Unexpected requeue, want=%v, got=%v", tc.wantRequeue, gotRequeue)
+			}
+
+			var gotWl kueue.Workload
+			if err := cl.Get(ctx, client.ObjectKeyFromObject(tc.workload), &gotWl); err != nil {
+				t.Fatalf("Couldn't get the workload: %v", err)
+			}
+			var gotEvictReason string
+			if evictedCond := apimeta.FindStatusCondition(gotWl.Status.Conditions, kueue.WorkloadEvicted); evictedCond != nil && evictedCond.Status == metav1.ConditionTrue {
+				gotEvictReason = evictedCond.Reason
+			}
+			if gotEvictReason != tc.wantEvictReason {
+				t.Errorf("Unexpected eviction reason, want=%q, got=%q", tc.wantEvictReason, gotEvictReason)
+			}
+		})
+	}
+}
+
+func TestReconcileDeactivatesJobWorkloadAtBackoffLimit(t *testing.T) {
+	ctx, _ := utiltesting.ContextWithLog(t)
+	backoffLimit := int32(2)
+	podsReadyTimeout := time.Minute
+
+	clientBuilder := utiltesting.NewClientBuilder()
+	if err := job.SetupIndexes(ctx, utiltesting.AsIndexer(clientBuilder)); err != nil {
+		t.Fatalf("Could not setup indexes: %v", err)
+	}
+	batchJob := testingjob.MakeJob("job", "ns").
+		Queue("lq").
+		SetAnnotation(controllerconsts.WorkloadActiveAnnotation, "true").
+		Obj()
+	cl := clientBuilder.WithObjects(batchJob).WithStatusSubresource(&kueue.Workload{}).Build()
+	recorder := record.NewBroadcaster().NewRecorder(cl.Scheme(), corev1.EventSource{Component: "test"})
+	cqCache := cache.New(cl)
+	wlReconciler := NewWorkloadReconciler(cl, queue.NewManager(cl, cqCache), cqCache, recorder,
+		WithPodsReadyTimeout(&podsReadyTimeout),
+		WithRequeuingStrategy(&config.RequeuingStrategy{BackoffLimitCount: pointer.Int32(backoffLimit)}))
+	jobReconciler := job.NewReconciler(cl, recorder)
+
+	jobKey := client.ObjectKeyFromObject(batchJob)
+	wlKey := types.NamespacedName{Namespace: "ns", Name: jobframework.GetWorkloadNameForOwnerWithGVK("job", batchv1.SchemeGroupVersion.WithKind("Job"))}
+	reconcileJob := func() {
+		t.Helper()
+		if _, err := jobReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: jobKey}); err != nil {
+			t.Fatalf("Reconciling the job: %v", err)
+		}
+	}
+	reconcileWorkload := func() {
+		t.Helper()
+		if _, err := wlReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: wlKey}); err != nil {
+			t.Fatalf("Reconciling the workload: %v", err)
+		}
+	}
+	getWorkload := func() *kueue.Workload {
+		t.Helper()
+		var wl kueue.Workload
+		if err := cl.Get(ctx, wlKey, &wl); err != nil {
+			t.Fatalf("Getting the workload: %v", err)
+		}
+		return &wl
+	}
+
+	// Create the workload.
+	reconcileJob()
+	for i := int32(0); i <= backoffLimit; i++ {
+		if !workload.IsActive(getWorkload()) {
+			t.Fatalf("Workload deactivated after %d timeouts, want %d", i, backoffLimit)
+		}
+		// Admit the workload, as if it was admitted before the PodsReady timeout.
+		wl := getWorkload()
+		apimeta.RemoveStatusCondition(&wl.Status.Conditions, kueue.WorkloadEvicted)
+		workload.SetQuotaReservation(wl, utiltesting.MakeAdmission("cq").Obj())
+		workload.SyncAdmittedCondition(wl)
+		apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadAdmitted).LastTransitionTime = metav1.NewTime(time.Now().Add(-2 * podsReadyTimeout))
+		if err := cl.Status().Update(ctx, wl); err != nil {
+			t.Fatalf("Admitting the workload: %v", err)
+		}
+		// Start the job, time the workload out and stop the job.
+		reconcileJob()
+		reconcileWorkload()
+		reconcileJob()
+	}
+
+	// The deactivated workload is evicted and the job stays stopped.
+	reconcileWorkload()
+	reconcileJob()
+	reconcileJob()
+
+	wl := getWorkload()
+	if workload.IsActive(wl) {
+		t.Error("Workload was reactivated after reaching the backoff limit")
+	}
+	var gotJob batchv1.Job
+	if err := cl.Get(ctx, jobKey, &gotJob); err != nil {
+		t.Fatalf("Getting the job: %v", err)
+	}
+	if !pointer.BoolDeref(gotJob.Spec.Suspend, false) {
+		t.Error("The job of the deactivated workload is not suspended")
+	}
+}
@@ -24,6 +24,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -60,6 +61,9 @@ type IntegrationCallbacks struct {
 	// managed by this integration
 	// (this callback is optional)
 	IsManagingObjectsOwner func(ref *metav1.OwnerReference) bool
+	// Returns an empty GenericJob of the type managed by the integration
+	// (this callback is optional)
+	NewJob func() GenericJob
 }
 
 type integrationManager struct {
@@ -116,6 +120,19 @@ func (m *integrationManager) getList() []string {
 	return ret
 }
 
+func (m *integrationManager) newJobForGVK(gvk schema.GroupVersionKind) (GenericJob, bool) {
+	for _, name := range m.names {
+		cbs := m.integrations[name]
+		if cbs.NewJob == nil {
+			continue
+		}
+		if job := cbs.NewJob(); job.GetGVK() == gvk {
+			return job, true
+		}
+	}
+	return nil, false
+}
+
 func (m *integrationManager) getCallbacksForOwner(ownerRef *metav1.OwnerReference) *IntegrationCallbacks {
 	for _, name := range m.names {
 		cbs := m.integrations[name]
@@ -156,6 +173,12 @@ func IsOwnerManagedByKueue(owner *metav1.OwnerReference) bool {
 	return manager.getCallbacksForOwner(owner) != nil
 }
 
+// NewJobForGVK returns an empty GenericJob of the integration managing the
+// kind, and false if no registered integration manages it.
+func NewJobForGVK(gvk schema.GroupVersionKind) (GenericJob, bool) {
+	return manager.newJobForGVK(gvk)
+}
+
 // GetEmptyOwnerObject returns an empty object of the owner's type,
 // returns nil if the owner is not manageable by kueue.
 func GetEmptyOwnerObject(owner *metav1.OwnerReference) client.Object {
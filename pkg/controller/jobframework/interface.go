@@ -15,9 +15,13 @@ package jobframework
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
@@ -71,6 +75,32 @@ type JobWithPriorityClass interface {
 	PriorityClass() string
 }
 
+type JobWithPreemptionNotice interface {
+	// NotifyPreemption signals the running pods of the job that its workload
+	// was preempted and is evicted at the deadline, so that they can
+	// terminate gracefully.
+	// The function should be idempotent: not do any API calls if the pods
+	// were already notified.
+	NotifyPreemption(ctx context.Context, c client.Client, deadline time.Time) error
+}
+
+// ComposableJob is implemented by the jobs that are composed of multiple
+// objects, like a group of pods, and admitted through a single workload.
+type ComposableJob interface {
+	// Load fetches the members of the job for the reconcile request.
+	// Returns false if the job has no members.
+	Load(ctx context.Context, c client.Client, key types.NamespacedName) (bool, error)
+	// Run starts the suspended members of the job, injecting the podSetsInfo
+	// of the admission.
+	Run(ctx context.Context, c client.Client, podSetsInfo []PodSetInfo) error
+	// ConstructComposableWorkload returns the workload of the job, owned by
+	// all its members, or nil if some of the members weren't created yet.
+	ConstructComposableWorkload(ctx context.Context, c client.Client) (*kueue.Workload, error)
+	// FindMatchingWorkloads returns the workload matching the job, if any,
+	// and the workloads that need to be deleted.
+	FindMatchingWorkloads(ctx context.Context, c client.Client) (*kueue.Workload, []*kueue.Workload, error)
+}
+
 func ParentWorkloadName(job GenericJob) string {
 	return job.Object().GetAnnotations()[constants.ParentWorkloadAnnotation]
 }
@@ -86,3 +116,81 @@ func QueueNameForObject(object client.Object) string {
 	// fallback to the annotation (deprecated)
 	return object.GetAnnotations()[constants.QueueAnnotation]
 }
+
+// WorkloadPriorityClassName returns the name of the WorkloadPriorityClass
+// referenced by the job, empty if none.
+func WorkloadPriorityClassName(job GenericJob) string {
+	return job.Object().GetLabels()[constants.WorkloadPriorityClassLabel]
+}
+
+// MaximumExecutionTimeSeconds returns the maximum execution time of the
+// workload of the job, based on the job label. Missing or invalid values mean
+// no maximum.
+func MaximumExecutionTimeSeconds(job GenericJob) *int32 {
+	v, err := strconv.ParseInt(job.Object().GetLabels()[constants.MaxExecTimeSecondsLabel], 10, 32)
+	if err != nil || v <= 0 {
+		return nil
+	}
+	return pointer.Int32(int32(v))
+}
+
+// PreemptionGracePeriodSeconds returns the preemption grace period of the
+// workload of the job, based on the job label. Missing or invalid values mean
+// no grace period set for the workload.
+func PreemptionGracePeriodSeconds(job GenericJob) *int32 {
+	v, err := strconv.ParseInt(job.Object().GetLabels()[constants.PreemptionGracePeriodSecondsLabel], 10, 32)
+	if err != nil || v < 0 {
+		return nil
+	}
+	return pointer.Int32(int32(v))
+}
+
+// WorkloadActive returns whether the workload of the job should be active,
+// based on the job annotation. Missing or invalid values mean active.
+func WorkloadActive(job GenericJob) bool {
+	active, err := strconv.ParseBool(job.Object().GetAnnotations()[constants.WorkloadActiveAnnotation])
+	return err != nil || active
+}
+
+// syncWorkloadActive copies the activation requested by the job annotation
+// to the workload, if the annotation changed since it was last copied, and
+// returns whether the workload changed.
+// The workload records the last copied value in the same annotation, so that
+// changes done to .spec.active directly in the workload, or by Kueue, are
+// kept until the job annotation changes.
+func syncWorkloadActive(job GenericJob, wl *kueue.Workload) bool {
+	jobValue, jobSet := job.Object().GetAnnotations()[constants.WorkloadActiveAnnotation]
+	wlValue, wlSet := wl.Annotations[constants.WorkloadActiveAnnotation]
+	if jobSet == wlSet && jobValue == wlValue {
+		return false
+	}
+	if jobSet {
+		if wl.Annotations == nil {
+			wl.Annotations = make(map[string]string, 1)
+		}
+		wl.Annotations[constants.WorkloadActiveAnnotation] = jobValue
+	} else {
+		delete(wl.Annotations, constants.WorkloadActiveAnnotation)
+	}
+	wl.Spec.Active = pointer.Bool(WorkloadActive(job))
+	return true
+}
+
+// syncPreemptionCost copies the preemption cost annotation of the job to the
+// workload, and returns whether the workload changed.
+func syncPreemptionCost(job GenericJob, wl *kueue.Workload) bool {
+	jobValue, jobSet := job.Object().GetAnnotations()[constants.PreemptionCostAnnotation]
+	wlValue, wlSet := wl.Annotations[constants.PreemptionCostAnnotation]
+	if jobSet == wlSet && jobValue == wlValue {
+		return false
+	}
+	if jobSet {
+		if wl.Annotations == nil {
+			wl.Annotations = make(map[string]string, 1)
+		}
+		wl.Annotations[constants.PreemptionCostAnnotation] = jobValue
+	} else {
+		delete(wl.Annotations, constants.PreemptionCostAnnotation)
+	}
+	return true
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobframework
+
+import (
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/util/maps"
+)
+
+var (
+	ErrInvalidPodSetUpdate = errors.New("invalid admission check PodSetUpdate")
+)
+
+type PodSetInfo struct {
+	Name         string              `json:"name"`
+	NodeSelector map[string]string   `json:"nodeSelector"`
+	Count        int32               `json:"count"`
+	Labels       map[string]string   `json:"labels,omitempty"`
+	Annotations  map[string]string   `json:"annotations,omitempty"`
+	Tolerations  []corev1.Toleration `json:"tolerations,omitempty"`
+	// NodeAffinityTerms are the required node selector terms. When merged,
+	// they are combined with the terms of the pod template, so that the
+	// requirements of both need to be satisfied.
+	NodeAffinityTerms []corev1.NodeSelectorTerm `json:"nodeAffinityTerms,omitempty"`
+}
+
+// Merge adds the labels, annotations and node selector of the provided
+// PodSetUpdate to the info. The update can only add new keys, or keys
+// having the same value.
+func (i *PodSetInfo) Merge(update *kueue.PodSetUpdate) error {
+	if err := checkNoConflict(i.Labels, update.Labels); err != nil {
+		return fmt.Errorf("%w: labels: %s", ErrInvalidPodSetUpdate, err)
+	}
+	if err := checkNoConflict(i.Annotations, update.Annotations); err != nil {
+		return fmt.Errorf("%w: annotations: %s", ErrInvalidPodSetUpdate, err)
+	}
+	if err := checkNoConflict(i.NodeSelector, update.NodeSelector); err != nil {
+		return fmt.Errorf("%w: nodeSelector: %s", ErrInvalidPodSetUpdate, err)
+	}
+	i.Labels = maps.MergeKeepFirst(i.Labels, update.Labels)
+	i.Annotations = maps.MergeKeepFirst(i.Annotations, update.Annotations)
+	i.NodeSelector = maps.MergeKeepFirst(i.NodeSelector, update.NodeSelector)
+	return nil
+}
+
+func checkNoConflict(a, b map[string]string) error {
+	for k, v := range b {
+		if av, found := a[k]; found && av != v {
+			return fmt.Errorf("conflict for key=%s, value1=%s, value2=%s", k, av, v)
+		}
+	}
+	return nil
+}
+
+// MergePodTemplate injects the labels, annotations, node selector,
+// tolerations and node affinity terms of the info into the pod template.
+// The values in the info take precedence. Tolerations already present in the
+// template are not duplicated.
+func MergePodTemplate(template *corev1.PodTemplateSpec, info PodSetInfo) {
+	template.Labels = maps.MergeKeepFirst(info.Labels, template.Labels)
+	template.Annotations = maps.MergeKeepFirst(info.Annotations, template.Annotations)
+	template.Spec.NodeSelector = maps.MergeKeepFirst(info.NodeSelector, template.Spec.NodeSelector)
+	template.Spec.Tolerations = appendMissingTolerations(template.Spec.Tolerations, info.Tolerations)
+	if len(info.NodeAffinityTerms) > 0 {
+		setRequiredNodeAffinityTerms(&template.Spec, combineNodeSelectorTerms(requiredNodeAffinityTerms(&template.Spec), info.NodeAffinityTerms))
+	}
+}
+
+// combineNodeSelectorTerms returns the terms that are satisfied when a term
+// of each list is satisfied.
+func combineNodeSelectorTerms(a, b []corev1.NodeSelectorTerm) []corev1.NodeSelectorTerm {
+	if len(a) == 0 {
+		return cloneNodeSelectorTerms(b)
+	}
+	terms := make([]corev1.NodeSelectorTerm, 0, len(a)*len(b))
+	for i := range a {
+		for j := range b {
+			term := *a[i].DeepCopy()
+			term.MatchExpressions = append(term.MatchExpressions, b[j].DeepCopy().MatchExpressions...)
+			term.MatchFields = append(term.MatchFields, b[j].DeepCopy().MatchFields...)
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+func cloneNodeSelectorTerms(terms []corev1.NodeSelectorTerm) []corev1.NodeSelectorTerm {
+	if terms == nil {
+		return nil
+	}
+	clone := make([]corev1.NodeSelectorTerm, len(terms))
+	for i := range terms {
+		terms[i].DeepCopyInto(&clone[i])
+	}
+	return clone
+}
+
+func requiredNodeAffinityTerms(spec *corev1.PodSpec) []corev1.NodeSelectorTerm {
+	if spec.Affinity == nil || spec.Affinity.NodeAffinity == nil || spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return nil
+	}
+	return spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+}
+
+// setRequiredNodeAffinityTerms sets the required node selector terms of the
+// pod spec, removing the affinity structs that become empty when there are
+// no terms.
+func setRequiredNodeAffinityTerms(spec *corev1.PodSpec, terms []corev1.NodeSelectorTerm) {
+	if len(terms) == 0 {
+		if spec.Affinity == nil || spec.Affinity.NodeAffinity == nil {
+			return
+		}
+		spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = nil
+		if len(spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution) == 0 {
+			spec.Affinity.NodeAffinity = nil
+		}
+		if *spec.Affinity == (corev1.Affinity{}) {
+			spec.Affinity = nil
+		}
+		return
+	}
+	if spec.Affinity == nil {
+		spec.Affinity = &corev1.Affinity{}
+	}
+	if spec.Affinity.NodeAffinity == nil {
+		spec.Affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{
+		NodeSelectorTerms: terms,
+	}
+}
+
+func appendMissingTolerations(dst, src []corev1.Toleration) []corev1.Toleration {
+	for _, t := range src {
+		if !containsToleration(dst, t) {
+			dst = append(dst, t)
+		}
+	}
+	return dst
+}
+
+func containsToleration(tolerations []corev1.Toleration, t corev1.Toleration) bool {
+	for i := range tolerations {
+		if equality.Semantic.DeepEqual(tolerations[i], t) {
+			return true
+		}
+	}
+	return false
+}
+
+// RestorePodTemplate sets the labels, annotations, node selector, tolerations
+// and required node affinity terms of the pod template back to the ones in
+// the info.
+// Returns whether the template was changed.
+func RestorePodTemplate(template *corev1.PodTemplateSpec, info PodSetInfo) bool {
+	changed := false
+	if !equality.Semantic.DeepEqual(template.Labels, info.Labels) {
+		template.Labels = maps.Clone(info.Labels)
+		changed = true
+	}
+	if !equality.Semantic.DeepEqual(template.Annotations, info.Annotations) {
+		template.Annotations = maps.Clone(info.Annotations)
+		changed = true
+	}
+	if !equality.Semantic.DeepEqual(template.Spec.NodeSelector, info.NodeSelector) {
+		template.Spec.NodeSelector = maps.Clone(info.NodeSelector)
+		changed = true
+	}
+	if !equality.Semantic.DeepEqual(template.Spec.Tolerations, info.Tolerations) {
+		template.Spec.Tolerations = append([]corev1.Toleration(nil), info.Tolerations...)
+		changed = true
+	}
+	if !equality.Semantic.DeepEqual(requiredNodeAffinityTerms(&template.Spec), info.NodeAffinityTerms) {
+		setRequiredNodeAffinityTerms(&template.Spec, cloneNodeSelectorTerms(info.NodeAffinityTerms))
+		changed = true
+	}
+	return changed
+}
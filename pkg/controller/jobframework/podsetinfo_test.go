@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobframework
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+func TestPodSetInfoMerge(t *testing.T) {
+	cases := map[string]struct {
+		info     PodSetInfo
+		update   kueue.PodSetUpdate
+		wantInfo PodSetInfo
+		wantErr  error
+	}{
+		"add to empty": {
+			info: PodSetInfo{Name: "main"},
+			update: kueue.PodSetUpdate{
+				Name:         "main",
+				Labels:       map[string]string{"l1": "v1"},
+				Annotations:  map[string]string{"a1": "v1"},
+				NodeSelector: map[string]string{"n1": "v1"},
+			},
+			wantInfo: PodSetInfo{
+				Name:         "main",
+				Labels:       map[string]string{"l1": "v1"},
+				Annotations:  map[string]string{"a1": "v1"},
+				NodeSelector: map[string]string{"n1": "v1"},
+			},
+		},
+		"same values": {
+			info: PodSetInfo{
+				Name:         "main",
+				NodeSelector: map[string]string{"n1": "v1"},
+			},
+			update: kueue.PodSetUpdate{
+				Name:         "main",
+				NodeSelector: map[string]string{"n1": "v1", "n2": "v2"},
+			},
+			wantInfo: PodSetInfo{
+				Name:         "main",
+				NodeSelector: map[string]string{"n1": "v1", "n2": "v2"},
+			},
+		},
+		"conflicting node selector": {
+			info: PodSetInfo{
+				Name:         "main",
+				NodeSelector: map[string]string{"n1": "v1"},
+			},
+			update: kueue.PodSetUpdate{
+				Name:         "main",
+				NodeSelector: map[string]string{"n1": "v2"},
+			},
+			wantInfo: PodSetInfo{
+				Name:         "main",
+				NodeSelector: map[string]string{"n1": "v1"},
+			},
+			wantErr: ErrInvalidPodSetUpdate,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.info.Merge(&tc.update)
+			if diff := cmp.Diff(tc.wantErr, gotErr, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantInfo, tc.info); diff != "" {
+				t.Errorf("Unexpected info (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMergeAndRestoreNodeAffinity(t *testing.T) {
+	zoneTerm := corev1.NodeSelectorTerm{
+		MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"a", "b"}}},
+	}
+	rackTerm := func(rack string) corev1.NodeSelectorTerm {
+		return corev1.NodeSelectorTerm{
+			MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "rack", Operator: corev1.NodeSelectorOpIn, Values: []string{rack}}},
+		}
+	}
+	cases := map[string]struct {
+		original  corev1.PodSpec
+		terms     []corev1.NodeSelectorTerm
+		wantTerms []corev1.NodeSelectorTerm
+	}{
+		"no affinity in the template": {
+			terms:     []corev1.NodeSelectorTerm{rackTerm("r1"), rackTerm("r2")},
+			wantTerms: []corev1.NodeSelectorTerm{rackTerm("r1"), rackTerm("r2")},
+		},
+		"combined with the terms of the template": {
+			original: corev1.PodSpec{
+				Affinity: &corev1.Affinity{
+					NodeAffinity: &corev1.NodeAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+							NodeSelectorTerms: []corev1.NodeSelectorTerm{zoneTerm},
+						},
+					},
+				},
+			},
+			terms: []corev1.NodeSelectorTerm{rackTerm("r1"), rackTerm("r2")},
+			wantTerms: []corev1.NodeSelectorTerm{
+				{MatchExpressions: append(append([]corev1.NodeSelectorRequirement(nil), zoneTerm.MatchExpressions...), rackTerm("r1").MatchExpressions...)},
+				{MatchExpressions: append(append([]corev1.NodeSelectorRequirement(nil), zoneTerm.MatchExpressions...), rackTerm("r2").MatchExpressions...)},
+			},
+		},
+		"preferred terms are kept": {
+			original: corev1.PodSpec{
+				Affinity: &corev1.Affinity{
+					NodeAffinity: &corev1.NodeAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []corev1.PreferredSchedulingTerm{{Weight: 1, Preference: zoneTerm}},
+					},
+				},
+			},
+			terms:     []corev1.NodeSelectorTerm{rackTerm("r1")},
+			wantTerms: []corev1.NodeSelectorTerm{rackTerm("r1")},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			template := corev1.PodTemplateSpec{Spec: *tc.original.DeepCopy()}
+			MergePodTemplate(&template, PodSetInfo{NodeAffinityTerms: tc.terms})
+			if diff := cmp.Diff(tc.wantTerms, requiredNodeAffinityTerms(&template.Spec)); diff != "" {
+				t.Errorf("Unexpected terms after merge (-want,+got):\n%s", diff)
+			}
+			restoreInfo := PodSetInfo{NodeAffinityTerms: requiredNodeAffinityTerms(&tc.original)}
+			if !RestorePodTemplate(&template, restoreInfo) {
+				t.Errorf("Expected the template to change when restored")
+			}
+			if diff := cmp.Diff(tc.original, template.Spec); diff != "" {
+				t.Errorf("Unexpected pod spec after restore (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
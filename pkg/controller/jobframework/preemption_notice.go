@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobframework
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+)
+
+// NotifyPodsPreemption sets the preemption deadline annotation in the running
+// pods listed with opts. The pods can read it through a downwardAPI volume.
+func NotifyPodsPreemption(ctx context.Context, c client.Client, deadline time.Time, opts ...client.ListOption) error {
+	var pods corev1.PodList
+	if err := c.List(ctx, &pods, opts...); err != nil {
+		return fmt.Errorf("listing pods: %w", err)
+	}
+	for i := range pods.Items {
+		if err := NotifyPodPreemption(ctx, c, &pods.Items[i], deadline); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NotifyPodPreemption sets the preemption deadline annotation in the pod,
+// unless it already finished or has the annotation.
+func NotifyPodPreemption(ctx context.Context, c client.Client, pod *corev1.Pod, deadline time.Time) error {
+	value := deadline.UTC().Format(time.RFC3339)
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed ||
+		pod.Annotations[constants.PreemptionDeadlineAnnotation] == value {
+		return nil
+	}
+	patch := client.MergeFrom(pod.DeepCopy())
+	metav1.SetMetaDataAnnotation(&pod.ObjectMeta, constants.PreemptionDeadlineAnnotation, value)
+	if err := c.Patch(ctx, pod, patch); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("annotating pod %s: %w", pod.Name, err)
+	}
+	return nil
+}
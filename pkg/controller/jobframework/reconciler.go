@@ -44,7 +44,9 @@ import (
 )
 
 const (
-	FailedToStartFinisedReason = "FailedToStart"
+	FailedToStartFinisedReason                 = "FailedToStart"
+	AdmissionChecksRejectedFinishedReason      = "AdmissionChecksRejected"
+	MaximumExecutionTimeExceededFinishedReason = "MaximumExecutionTimeExceeded"
 )
 
 var (
@@ -68,6 +70,9 @@ type Options struct {
 	ManageJobsWithoutQueueName bool
 	WaitForPodsReady           bool
 	KubeServerVersion          *kubeversion.ServerVersionFetcher
+	// IntegrationOptions holds the options of the integrations, keyed by
+	// the framework name.
+	IntegrationOptions map[string]any
 }
 
 // Option configures the reconciler.
@@ -96,6 +101,17 @@ func WithKubeServerVersion(v *kubeversion.ServerVersionFetcher) Option {
 	}
 }
 
+// WithIntegrationOptions adds the options of the integration with the given
+// framework name.
+func WithIntegrationOptions(integrationName string, opts any) Option {
+	return func(o *Options) {
+		if len(o.IntegrationOptions) == 0 {
+			o.IntegrationOptions = make(map[string]any)
+		}
+		o.IntegrationOptions[integrationName] = opts
+	}
+}
+
 var DefaultOptions = Options{}
 
 func NewReconciler(
@@ -117,7 +133,13 @@ func NewReconciler(
 
 func (r *JobReconciler) ReconcileGenericJob(ctx context.Context, req ctrl.Request, job GenericJob) (ctrl.Result, error) {
 	object := job.Object()
-	if err := r.client.Get(ctx, req.NamespacedName, object); err != nil {
+	if cj, implements := job.(ComposableJob); implements {
+		found, err := cj.Load(ctx, r.client, req.NamespacedName)
+		if err != nil || !found {
+			return ctrl.Result{}, err
+		}
+		object = job.Object()
+	} else if err := r.client.Get(ctx, req.NamespacedName, object); err != nil {
 		// we'll ignore not-found errors, since there is nothing to do.
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
@@ -200,7 +222,20 @@ func (r *JobReconciler) ReconcileGenericJob(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, err
 	}
 
-	// 4. update reclaimable counts if implemented by the job
+	// 4. update the workload activation and preemption cost if the job
+	// annotations changed.
+	activeChanged := syncWorkloadActive(job, wl)
+	costChanged := syncPreemptionCost(job, wl)
+	if activeChanged || costChanged {
+		log.V(2).Info("Job changed the workload annotations, updating workload", "active", workload.IsActive(wl), "preemptionCost", wl.Annotations[controllerconsts.PreemptionCostAnnotation])
+		err := r.client.Update(ctx, wl)
+		if err != nil {
+			log.Error(err, "Updating workload annotations")
+		}
+		return ctrl.Result{}, err
+	}
+
+	// 5. update reclaimable counts if implemented by the job
 	if jobRecl, implementsReclaimable := job.(JobWithReclaimablePods); implementsReclaimable {
 		if rp := jobRecl.ReclaimablePods(); !workload.ReclaimablePodsAreEqual(rp, wl.Status.ReclaimablePods) {
 			err = workload.UpdateReclaimablePods(ctx, r.client, wl, rp)
@@ -212,7 +247,7 @@ func (r *JobReconciler) ReconcileGenericJob(ctx context.Context, req ctrl.Reques
 		}
 	}
 
-	// 5. handle WaitForPodsReady only for a standalone job.
+	// 6. handle WaitForPodsReady only for a standalone job.
 	// handle a job when waitForPodsReady is enabled, and it is the main job
 	if r.waitForPodsReady {
 		log.V(5).Info("Handling a job when waitForPodsReady is enabled")
@@ -228,15 +263,52 @@ func (r *JobReconciler) ReconcileGenericJob(ctx context.Context, req ctrl.Reques
 		}
 	}
 
-	// 6. handle eviction
+	// 7. handle eviction
+	if workload.IsPreemptionPending(wl) {
+		if !job.IsActive() {
+			// The pods exited within the grace period, the quota can be freed.
+			log.V(2).Info("The job is no longer active, evicting the workload pending preemption")
+			cond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadPreemptionPending)
+			workload.SetEvictedCondition(wl, kueue.WorkloadEvictedByPreemption, cond.Message)
+			err := workload.ApplyAdmissionStatus(ctx, r.client, wl, true)
+			if err != nil {
+				return ctrl.Result{}, fmt.Errorf("evicting the workload: %w", err)
+			}
+			return ctrl.Result{}, nil
+		}
+		if jobNotice, implementsNotice := job.(JobWithPreemptionNotice); implementsNotice && wl.Status.PreemptionDeadline != nil {
+			if err := jobNotice.NotifyPreemption(ctx, r.client, wl.Status.PreemptionDeadline.Time); err != nil {
+				log.Error(err, "Notifying the preemption")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
 	if evCond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadEvicted); evCond != nil && evCond.Status == metav1.ConditionTrue {
 		if err := r.stopJob(ctx, job, object, wl, evCond.Message); err != nil {
 			return ctrl.Result{}, err
 		}
-		if workload.IsAdmitted(wl) {
+		if workload.HasQuotaReservation(wl) {
 			if !job.IsActive() {
 				log.V(6).Info("The job is no longer active, clear the workloads admission")
-				workload.UnsetAdmissionWithCondition(wl, "Pending", evCond.Message)
+				if rejected := workload.RejectedChecks(wl); len(rejected) > 0 {
+					// At least one admission check rejected the workload, there is no point in requeueing it.
+					msg := fmt.Sprintf("Admission checks %v are rejected", checkNames(rejected))
+					err := workload.UpdateStatus(ctx, r.client, wl, kueue.WorkloadFinished, metav1.ConditionTrue, AdmissionChecksRejectedFinishedReason, msg, constants.JobControllerName)
+					if err != nil {
+						return ctrl.Result{}, fmt.Errorf("finishing the workload: %w", err)
+					}
+					return ctrl.Result{}, nil
+				}
+				if evCond.Reason == kueue.WorkloadEvictedByMaximumExecutionTime {
+					// The workload ran out of its execution time, there is no point in requeueing it.
+					err := workload.UpdateStatus(ctx, r.client, wl, kueue.WorkloadFinished, metav1.ConditionTrue, MaximumExecutionTimeExceededFinishedReason, evCond.Message, constants.JobControllerName)
+					if err != nil {
+						return ctrl.Result{}, fmt.Errorf("finishing the workload: %w", err)
+					}
+					return ctrl.Result{}, nil
+				}
+				workload.UnsetQuotaReservationWithCondition(wl, "Pending", evCond.Message)
 				err := workload.ApplyAdmissionStatus(ctx, r.client, wl, true)
 				if err != nil {
 					return ctrl.Result{}, fmt.Errorf("clearing admission: %w", err)
@@ -246,7 +318,7 @@ func (r *JobReconciler) ReconcileGenericJob(ctx context.Context, req ctrl.Reques
 		}
 	}
 
-	// 7. handle job is suspended.
+	// 8. handle job is suspended.
 	if job.IsSuspended() {
 		// start the job if the workload has been admitted, and the job is still suspended
 		if workload.IsAdmitted(wl) {
@@ -281,7 +353,7 @@ func (r *JobReconciler) ReconcileGenericJob(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, nil
 	}
 
-	// 8. handle job is unsuspended.
+	// 9. handle job is unsuspended.
 	if !workload.IsAdmitted(wl) {
 		// the job must be suspended if the workload is not yet admitted.
 		log.V(2).Info("Running job is not admitted by a cluster queue, suspending")
@@ -298,7 +370,11 @@ func (r *JobReconciler) ReconcileGenericJob(ctx context.Context, req ctrl.Reques
 }
 
 func isPermanent(e error) bool {
-	return errors.Is(e, ErrInvalidPodsetInfo)
+	return errors.Is(e, ErrInvalidPodsetInfo) || errors.Is(e, ErrInvalidPodSetUpdate)
+}
+
+func checkNames(checks []kueue.AdmissionCheckState) []string {
+	return slices.Map(checks, func(c *kueue.AdmissionCheckState) string { return c.Name })
 }
 
 // IsParentJobManaged checks whether the parent job is managed by kueue.
@@ -339,19 +415,28 @@ func (r *JobReconciler) ensureOneWorkload(ctx context.Context, job GenericJob, o
 	// Find a matching workload first if there is one.
 	var toDelete []*kueue.Workload
 	var match *kueue.Workload
-	var workloads kueue.WorkloadList
-	if err := r.client.List(ctx, &workloads, client.InNamespace(object.GetNamespace()),
-		client.MatchingFields{getOwnerKey(job.GetGVK()): object.GetName()}); err != nil {
-		log.Error(err, "Unable to list child workloads")
-		return nil, err
-	}
+	if cj, implements := job.(ComposableJob); implements {
+		var err error
+		match, toDelete, err = cj.FindMatchingWorkloads(ctx, r.client)
+		if err != nil {
+			log.Error(err, "Unable to find the matching workloads")
+			return nil, err
+		}
+	} else {
+		var workloads kueue.WorkloadList
+		if err := r.client.List(ctx, &workloads, client.InNamespace(object.GetNamespace()),
+			client.MatchingFields{getOwnerKey(job.GetGVK()): object.GetName()}); err != nil {
+			log.Error(err, "Unable to list child workloads")
+			return nil, err
+		}
 
-	for i := range workloads.Items {
-		w := &workloads.Items[i]
-		if match == nil && r.equivalentToWorkload(job, object, w) {
-			match = w
-		} else {
-			toDelete = append(toDelete, w)
+		for i := range workloads.Items {
+			w := &workloads.Items[i]
+			if match == nil && r.equivalentToWorkload(job, object, w) {
+				match = w
+			} else {
+				toDelete = append(toDelete, w)
+			}
 		}
 	}
 
@@ -359,11 +444,11 @@ func (r *JobReconciler) ensureOneWorkload(ctx context.Context, job GenericJob, o
 	if match == nil && !job.IsSuspended() {
 		log.V(2).Info("job with no matching workload, suspending")
 		var w *kueue.Workload
-		if len(workloads.Items) == 1 {
+		if len(toDelete) == 1 {
 			// The job may have been modified and hence the existing workload
 			// doesn't match the job anymore. All bets are off if there are more
 			// than one workload...
-			w = &workloads.Items[0]
+			w = toDelete[0]
 		}
 		if err := r.stopJob(ctx, job, object, w, "No matching Workload"); err != nil {
 			return nil, fmt.Errorf("stopping job with no matching workload: %w", err)
@@ -440,12 +525,18 @@ func (r *JobReconciler) startJob(ctx context.Context, job GenericJob, object cli
 	if err != nil {
 		return err
 	}
-	if runErr := job.RunWithPodSetsInfo(info); runErr != nil {
-		return runErr
-	}
+	if cj, implements := job.(ComposableJob); implements {
+		if err := cj.Run(ctx, r.client, info); err != nil {
+			return err
+		}
+	} else {
+		if runErr := job.RunWithPodSetsInfo(info); runErr != nil {
+			return runErr
+		}
 
-	if err := r.client.Update(ctx, object); err != nil {
-		return err
+		if err := r.client.Update(ctx, object); err != nil {
+			return err
+		}
 	}
 
 	r.record.Eventf(object, corev1.EventTypeNormal, "Started",
@@ -484,9 +575,27 @@ func (r *JobReconciler) stopJob(ctx context.Context, job GenericJob, object clie
 }
 
 // constructWorkload will derive a workload from the corresponding job.
+// It returns nil when a composable job isn't complete yet.
 func (r *JobReconciler) constructWorkload(ctx context.Context, job GenericJob, object client.Object) (*kueue.Workload, error) {
+	if cj, implements := job.(ComposableJob); implements {
+		return cj.ConstructComposableWorkload(ctx, r.client)
+	}
+	wl, err := NewWorkload(ctx, r.client, job)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctrl.SetControllerReference(object, wl, r.client.Scheme()); err != nil {
+		return nil, err
+	}
+	return wl, nil
+}
+
+// NewWorkload returns the workload for the job, without an owner reference.
+// The client is used to look up the priority classes.
+func NewWorkload(ctx context.Context, c client.Client, job GenericJob) (*kueue.Workload, error) {
 	log := ctrl.LoggerFrom(ctx)
 
+	object := job.Object()
 	podSets := job.PodSets()
 
 	wl := &kueue.Workload{
@@ -500,6 +609,10 @@ func (r *JobReconciler) constructWorkload(ctx context.Context, job GenericJob, o
 			QueueName: QueueName(job),
 		},
 	}
+	syncWorkloadActive(job, wl)
+	wl.Spec.MaximumExecutionTimeSeconds = MaximumExecutionTimeSeconds(job)
+	wl.Spec.PreemptionGracePeriodSeconds = PreemptionGracePeriodSeconds(job)
+	syncPreemptionCost(job, wl)
 
 	jobUid := string(job.Object().GetUID())
 	if errs := validation.IsValidLabelValue(jobUid); len(errs) == 0 {
@@ -512,27 +625,30 @@ func (r *JobReconciler) constructWorkload(ctx context.Context, job GenericJob, o
 		)
 	}
 
-	priorityClassName, p, err := r.extractPriority(ctx, podSets, job)
+	priorityClassName, source, p, err := extractPriority(ctx, c, podSets, job)
 	if err != nil {
 		return nil, err
 	}
 
 	wl.Spec.PriorityClassName = priorityClassName
 	wl.Spec.Priority = &p
-
-	if err := ctrl.SetControllerReference(object, wl, r.client.Scheme()); err != nil {
-		return nil, err
-	}
+	wl.Spec.PriorityClassSource = source
 	return wl, nil
 }
 
-func (r *JobReconciler) extractPriority(ctx context.Context, podSets []kueue.PodSet, job GenericJob) (string, int32, error) {
+// extractPriority returns the name, source and value of the priority of the
+// job. A WorkloadPriorityClass referenced in the job labels takes precedence
+// over the pod PriorityClass.
+func extractPriority(ctx context.Context, c client.Client, podSets []kueue.PodSet, job GenericJob) (string, string, int32, error) {
+	if workloadPriorityClass := WorkloadPriorityClassName(job); len(workloadPriorityClass) > 0 {
+		return utilpriority.GetPriorityFromWorkloadPriorityClass(ctx, c, workloadPriorityClass)
+	}
 	if jobWithPriorityClass, isImplemented := job.(JobWithPriorityClass); isImplemented {
 		return utilpriority.GetPriorityFromPriorityClass(
-			ctx, r.client, jobWithPriorityClass.PriorityClass())
+			ctx, c, jobWithPriorityClass.PriorityClass())
 	}
 	return utilpriority.GetPriorityFromPriorityClass(
-		ctx, r.client, extractPriorityFromPodSets(podSets))
+		ctx, c, extractPriorityFromPodSets(podSets))
 }
 
 func extractPriorityFromPodSets(podSets []kueue.PodSet) string {
@@ -544,13 +660,9 @@ func extractPriorityFromPodSets(podSets []kueue.PodSet) string {
 	return ""
 }
 
-type PodSetInfo struct {
-	Name         string            `json:"name"`
-	NodeSelector map[string]string `json:"nodeSelector"`
-	Count        int32             `json:"count"`
-}
-
 // getPodSetsInfoFromAdmission will extract podSetsInfo and podSets count from admitted workloads.
+// The node labels and tolerations of the assigned flavors are extended with the
+// podSetUpdates provided by the admission checks.
 func (r *JobReconciler) getPodSetsInfoFromAdmission(ctx context.Context, w *kueue.Workload) ([]PodSetInfo, error) {
 	if len(w.Status.Admission.PodSetAssignments) == 0 {
 		return nil, nil
@@ -570,7 +682,8 @@ func (r *JobReconciler) getPodSetsInfoFromAdmission(ctx context.Context, w *kueu
 			if processedFlvs.Has(flvName) {
 				continue
 			}
-			// Lookup the ResourceFlavors to fetch the node affinity labels to apply on the job.
+			// Lookup the ResourceFlavors to fetch the node affinity labels and
+			// tolerations to apply on the job.
 			flv := kueue.ResourceFlavor{}
 			if err := r.client.Get(ctx, types.NamespacedName{Name: string(flvName)}, &flv); err != nil {
 				return nil, err
@@ -578,14 +691,53 @@ func (r *JobReconciler) getPodSetsInfoFromAdmission(ctx context.Context, w *kueu
 			for k, v := range flv.Spec.NodeLabels {
 				nodeSelector.NodeSelector[k] = v
 			}
+			nodeSelector.Tolerations = appendMissingTolerations(nodeSelector.Tolerations, flv.Spec.Tolerations)
 			processedFlvs.Insert(flvName)
 		}
+		if ta := podSetFlavor.TopologyAssignment; ta != nil {
+			// The pods are restricted to the assigned topology domains, and
+			// labeled so that their usage is not counted twice by the cache.
+			nodeSelector.NodeAffinityTerms = topologyNodeSelectorTerms(ta)
+			nodeSelector.Labels = map[string]string{kueue.TopologySchedulingLabel: "true"}
+		}
+
+		for _, check := range w.Status.AdmissionChecks {
+			for j := range check.PodSetUpdates {
+				if check.PodSetUpdates[j].Name != podSetFlavor.Name {
+					continue
+				}
+				if err := nodeSelector.Merge(&check.PodSetUpdates[j]); err != nil {
+					return nil, fmt.Errorf("admission check %q: %w", check.Name, err)
+				}
+			}
+		}
 
 		nodeSelectors[i] = nodeSelector
 	}
 	return nodeSelectors, nil
 }
 
+// topologyNodeSelectorTerms returns a node selector term for each domain of
+// the topology assignment.
+func topologyNodeSelectorTerms(ta *kueue.TopologyAssignment) []corev1.NodeSelectorTerm {
+	terms := make([]corev1.NodeSelectorTerm, 0, len(ta.Domains))
+	for _, d := range ta.Domains {
+		term := corev1.NodeSelectorTerm{}
+		for i, level := range ta.Levels {
+			if i >= len(d.Values) {
+				break
+			}
+			term.MatchExpressions = append(term.MatchExpressions, corev1.NodeSelectorRequirement{
+				Key:      level,
+				Operator: corev1.NodeSelectorOpIn,
+				Values:   []string{d.Values[i]},
+			})
+		}
+		terms = append(terms, term)
+	}
+	return terms
+}
+
 func (r *JobReconciler) handleJobWithNoWorkload(ctx context.Context, job GenericJob, object client.Object) error {
 	log := ctrl.LoggerFrom(ctx)
 
@@ -600,6 +752,10 @@ func (r *JobReconciler) handleJobWithNoWorkload(ctx context.Context, job Generic
 	if err != nil {
 		return err
 	}
+	if wl == nil {
+		log.V(3).Info("Not all the members of the job were created yet, waiting")
+		return nil
+	}
 	if err = r.client.Create(ctx, wl); err != nil {
 		return err
 	}
@@ -637,9 +793,13 @@ func getPodSetsInfoFromWorkload(wl *kueue.Workload) []PodSetInfo {
 
 	return slices.Map(wl.Spec.PodSets, func(ps *kueue.PodSet) PodSetInfo {
 		return PodSetInfo{
-			Name:         ps.Name,
-			NodeSelector: maps.Clone(ps.Template.Spec.NodeSelector),
-			Count:        ps.Count,
+			Name:              ps.Name,
+			NodeSelector:      maps.Clone(ps.Template.Spec.NodeSelector),
+			Count:             ps.Count,
+			Labels:            maps.Clone(ps.Template.Labels),
+			Annotations:       maps.Clone(ps.Template.Annotations),
+			Tolerations:       append([]corev1.Toleration(nil), ps.Template.Spec.Tolerations...),
+			NodeAffinityTerms: cloneNodeSelectorTerms(requiredNodeAffinityTerms(&ps.Template.Spec)),
 		}
 	})
 }
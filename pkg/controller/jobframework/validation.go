@@ -14,6 +14,7 @@ limitations under the License.
 package jobframework
 
 import (
+	"strconv"
 	"strings"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -28,6 +29,11 @@ var (
 	labelsPath            = field.NewPath("metadata", "labels")
 	parentWorkloadKeyPath = annotationsPath.Key(constants.ParentWorkloadAnnotation)
 	queueNameLabelPath    = labelsPath.Key(constants.QueueLabel)
+	workloadActivePath    = annotationsPath.Key(constants.WorkloadActiveAnnotation)
+	maxExecTimeLabelPath  = labelsPath.Key(constants.MaxExecTimeSecondsLabel)
+	gracePeriodLabelPath  = labelsPath.Key(constants.PreemptionGracePeriodSecondsLabel)
+
+	workloadPriorityClassNamePath = labelsPath.Key(constants.WorkloadPriorityClassLabel)
 )
 
 func ValidateCreateForQueueName(job GenericJob) field.ErrorList {
@@ -37,6 +43,40 @@ func ValidateCreateForQueueName(job GenericJob) field.ErrorList {
 	return allErrs
 }
 
+func ValidateCreateForWorkloadPriorityClassName(job GenericJob) field.ErrorList {
+	return validateLabelAsCRDName(job, constants.WorkloadPriorityClassLabel)
+}
+
+func ValidateWorkloadActive(job GenericJob) field.ErrorList {
+	var allErrs field.ErrorList
+	if value, exists := job.Object().GetAnnotations()[constants.WorkloadActiveAnnotation]; exists {
+		if _, err := strconv.ParseBool(value); err != nil {
+			allErrs = append(allErrs, field.Invalid(workloadActivePath, value, "must be true or false"))
+		}
+	}
+	return allErrs
+}
+
+func ValidateMaxExecTime(job GenericJob) field.ErrorList {
+	var allErrs field.ErrorList
+	if value, exists := job.Object().GetLabels()[constants.MaxExecTimeSecondsLabel]; exists {
+		if v, err := strconv.ParseInt(value, 10, 32); err != nil || v <= 0 {
+			allErrs = append(allErrs, field.Invalid(maxExecTimeLabelPath, value, "must be a positive integer"))
+		}
+	}
+	return allErrs
+}
+
+func ValidatePreemptionGracePeriod(job GenericJob) field.ErrorList {
+	var allErrs field.ErrorList
+	if value, exists := job.Object().GetLabels()[constants.PreemptionGracePeriodSecondsLabel]; exists {
+		if v, err := strconv.ParseInt(value, 10, 32); err != nil || v < 0 {
+			allErrs = append(allErrs, field.Invalid(gracePeriodLabelPath, value, "must be a non-negative integer"))
+		}
+	}
+	return allErrs
+}
+
 func ValidateAnnotationAsCRDName(job GenericJob, crdNameAnnotation string) field.ErrorList {
 	var allErrs field.ErrorList
 	if value, exists := job.Object().GetAnnotations()[crdNameAnnotation]; exists {
@@ -83,3 +123,12 @@ func ValidateUpdateForParentWorkload(oldJob, newJob GenericJob) field.ErrorList
 	}
 	return allErrs
 }
+
+func ValidateUpdateForWorkloadPriorityClassName(oldJob, newJob GenericJob) field.ErrorList {
+	var allErrs field.ErrorList
+	if errList := apivalidation.ValidateImmutableField(WorkloadPriorityClassName(newJob),
+		WorkloadPriorityClassName(oldJob), workloadPriorityClassNamePath); len(errList) > 0 {
+		allErrs = append(allErrs, field.Forbidden(workloadPriorityClassNamePath, "this label is immutable"))
+	}
+	return allErrs
+}
@@ -20,10 +20,10 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -40,7 +40,6 @@ import (
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
-	"sigs.k8s.io/kueue/pkg/util/maps"
 )
 
 var (
@@ -61,11 +60,14 @@ func init() {
 		NewReconciler: NewReconciler,
 		SetupWebhook:  SetupWebhook,
 		JobType:       &batchv1.Job{},
+		NewJob:        NewJob,
 	}))
 }
 
 // +kubebuilder:rbac:groups=scheduling.k8s.io,resources=priorityclasses,verbs=list;get;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloadpriorityclasses,verbs=list;get;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;watch;update;patch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;patch
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=batch,resources=jobs/status,verbs=get;update
 // +kubebuilder:rbac:groups=batch,resources=jobs/finalizers,verbs=get;update;patch
@@ -74,10 +76,12 @@ func init() {
 // +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/finalizers,verbs=update
 // +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch
 
+func NewJob() jobframework.GenericJob {
+	return &Job{}
+}
+
 var NewReconciler = jobframework.NewGenericReconciler(
-	func() jobframework.GenericJob {
-		return &Job{}
-	}, func(c client.Client) handler.EventHandler {
+	NewJob, func(c client.Client) handler.EventHandler {
 		return &parentWorkloadHandler{client: c}
 	})
 
@@ -130,6 +134,7 @@ type Job batchv1.Job
 var _ jobframework.GenericJob = (*Job)(nil)
 var _ jobframework.JobWithReclaimablePods = (*Job)(nil)
 var _ jobframework.JobWithCustomStop = (*Job)(nil)
+var _ jobframework.JobWithPreemptionNotice = (*Job)(nil)
 
 func (j *Job) Object() client.Object {
 	return (*batchv1.Job)(j)
@@ -178,6 +183,19 @@ func (j *Job) Stop(ctx context.Context, c client.Client, podSetsInfo []jobframew
 	return stoppedNow, nil
 }
 
+// NotifyPreemption sets the preemption deadline annotation in the running
+// pods of the job. The pods can read it through a downwardAPI volume.
+func (j *Job) NotifyPreemption(ctx context.Context, c client.Client, deadline time.Time) error {
+	if j.Spec.Selector == nil {
+		return nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(j.Spec.Selector)
+	if err != nil {
+		return fmt.Errorf("parsing the pod selector: %w", err)
+	}
+	return jobframework.NotifyPodsPreemption(ctx, c, deadline, client.InNamespace(j.Namespace), client.MatchingLabelsSelector{Selector: selector})
+}
+
 func (j *Job) GetGVK() schema.GroupVersionKind {
 	return gvk
 }
@@ -217,7 +235,7 @@ func (j *Job) RunWithPodSetsInfo(podSetsInfo []jobframework.PodSetInfo) error {
 	}
 
 	info := podSetsInfo[0]
-	j.Spec.Template.Spec.NodeSelector = maps.MergeKeepFirst(info.NodeSelector, j.Spec.Template.Spec.NodeSelector)
+	jobframework.MergePodTemplate(&j.Spec.Template, info)
 
 	if j.minPodsCount() != nil {
 		j.Spec.Parallelism = pointer.Int32(info.Count)
@@ -243,11 +261,7 @@ func (j *Job) RestorePodSetsInfo(podSetsInfo []jobframework.PodSetInfo) bool {
 		}
 	}
 
-	if equality.Semantic.DeepEqual(j.Spec.Template.Spec.NodeSelector, podSetsInfo[0].NodeSelector) {
-		return changed
-	}
-	j.Spec.Template.Spec.NodeSelector = maps.Clone(podSetsInfo[0].NodeSelector)
-	return true
+	return jobframework.RestorePodTemplate(&j.Spec.Template, podSetsInfo[0]) || changed
 }
 
 func (j *Job) Finished() (metav1.Condition, bool) {
@@ -32,6 +32,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/constants"
 	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	"sigs.k8s.io/kueue/pkg/features"
@@ -222,6 +223,91 @@ func TestPodSetsInfo(t *testing.T) {
 				},
 			},
 		},
+		"labels and annotations": {
+			job: (*Job)(utiltestingjob.MakeJob("job", "ns").
+				Parallelism(1).
+				PodAnnotation("orig-annotation", "orig-val").
+				Obj()),
+			runInfo: []jobframework.PodSetInfo{
+				{
+					NodeSelector: map[string]string{
+						"new-key": "new-val",
+					},
+					Labels: map[string]string{
+						"new-label": "new-val",
+					},
+					Annotations: map[string]string{
+						"new-annotation": "new-val",
+					},
+				},
+			},
+			wantUnsuspended: utiltestingjob.MakeJob("job", "ns").
+				Parallelism(1).
+				NodeSelector("new-key", "new-val").
+				PodLabel("new-label", "new-val").
+				PodAnnotation("orig-annotation", "orig-val").
+				PodAnnotation("new-annotation", "new-val").
+				Suspend(false).
+				Obj(),
+			restoreInfo: []jobframework.PodSetInfo{
+				{
+					NodeSelector: map[string]string{},
+					Annotations: map[string]string{
+						"orig-annotation": "orig-val",
+					},
+				},
+			},
+		},
+		"tolerations": {
+			job: (*Job)(utiltestingjob.MakeJob("job", "ns").
+				Parallelism(1).
+				Toleration(corev1.Toleration{
+					Key:      "orig-key",
+					Operator: corev1.TolerationOpExists,
+				}).
+				Obj()),
+			runInfo: []jobframework.PodSetInfo{
+				{
+					Tolerations: []corev1.Toleration{
+						{
+							Key:      "orig-key",
+							Operator: corev1.TolerationOpExists,
+						},
+						{
+							Key:      "new-key",
+							Operator: corev1.TolerationOpEqual,
+							Value:    "new-val",
+							Effect:   corev1.TaintEffectNoSchedule,
+						},
+					},
+				},
+			},
+			wantUnsuspended: utiltestingjob.MakeJob("job", "ns").
+				Parallelism(1).
+				Toleration(corev1.Toleration{
+					Key:      "orig-key",
+					Operator: corev1.TolerationOpExists,
+				}).
+				Toleration(corev1.Toleration{
+					Key:      "new-key",
+					Operator: corev1.TolerationOpEqual,
+					Value:    "new-val",
+					Effect:   corev1.TaintEffectNoSchedule,
+				}).
+				Suspend(false).
+				Obj(),
+			restoreInfo: []jobframework.PodSetInfo{
+				{
+					NodeSelector: map[string]string{},
+					Tolerations: []corev1.Toleration{
+						{
+							Key:      "orig-key",
+							Operator: corev1.TolerationOpExists,
+						},
+					},
+				},
+			},
+		},
 		"parallelism": {
 			job: (*Job)(utiltestingjob.MakeJob("job", "ns").
 				Parallelism(5).
@@ -322,6 +408,43 @@ func TestPodSets(t *testing.T) {
 	}
 }
 
+func TestNotifyPreemption(t *testing.T) {
+	deadline := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	job := utiltestingjob.MakeJob("job", "ns").Obj()
+	job.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"job-name": "job"}}
+	pod := func(name string, labels map[string]string, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", Labels: labels},
+			Status:     corev1.PodStatus{Phase: phase},
+		}
+	}
+	ctx, _ := utiltesting.ContextWithLog(t)
+	cl := utiltesting.NewClientBuilder().WithObjects(
+		pod("running", map[string]string{"job-name": "job"}, corev1.PodRunning),
+		pod("failed", map[string]string{"job-name": "job"}, corev1.PodFailed),
+		pod("other", map[string]string{"job-name": "other"}, corev1.PodRunning),
+	).Build()
+
+	if err := (*Job)(job).NotifyPreemption(ctx, cl, deadline); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	wantAnnotations := map[string]string{
+		"running": "2023-10-01T12:00:00Z",
+		"failed":  "",
+		"other":   "",
+	}
+	for name, want := range wantAnnotations {
+		var got corev1.Pod
+		if err := cl.Get(ctx, client.ObjectKey{Namespace: "ns", Name: name}, &got); err != nil {
+			t.Fatalf("Getting pod %s: %v", name, err)
+		}
+		if got := got.Annotations[controllerconsts.PreemptionDeadlineAnnotation]; got != want {
+			t.Errorf("Unexpected annotation in pod %s, want=%q, got=%q", name, want, got)
+		}
+	}
+}
+
 var (
 	jobCmpOpts = []cmp.Option{
 		cmpopts.EquateEmpty(),
@@ -353,6 +476,7 @@ func TestReconciler(t *testing.T) {
 		focus             bool
 		reconcilerOptions []jobframework.Option
 		job               batchv1.Job
+		priorityClasses   []client.Object
 		wantJob           batchv1.Job
 		workloads         []kueue.Workload
 		wantWorkloads     []kueue.Workload
@@ -470,6 +594,67 @@ func TestReconciler(t *testing.T) {
 					Obj(),
 			},
 		},
+		"the workload is created with the priority of the WorkloadPriorityClass": {
+			job: *baseJobWrapper.
+				Clone().
+				Queue("test-queue").
+				UID("test-uid").
+				PriorityClass("pod-priority").
+				WorkloadPriorityClass("workload-priority").
+				Obj(),
+			priorityClasses: []client.Object{
+				utiltesting.MakePriorityClass("pod-priority").PriorityValue(100).Obj(),
+				utiltesting.MakeWorkloadPriorityClass("workload-priority").PriorityValue(200).Obj(),
+			},
+			wantJob: *baseJobWrapper.
+				Clone().
+				Queue("test-queue").
+				UID("test-uid").
+				PriorityClass("pod-priority").
+				WorkloadPriorityClass("workload-priority").
+				Obj(),
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("job", "ns").
+					PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").PriorityClass("pod-priority").Obj()).
+					Queue("test-queue").
+					PriorityClass("workload-priority").
+					Priority(200).
+					PriorityClassSource(constants.WorkloadPriorityClassSource).
+					Labels(map[string]string{
+						controllerconsts.JobUIDLabel: "test-uid",
+					}).
+					Obj(),
+			},
+		},
+		"the workload is created with the priority of the pod PriorityClass": {
+			job: *baseJobWrapper.
+				Clone().
+				Queue("test-queue").
+				UID("test-uid").
+				PriorityClass("pod-priority").
+				Obj(),
+			priorityClasses: []client.Object{
+				utiltesting.MakePriorityClass("pod-priority").PriorityValue(100).Obj(),
+			},
+			wantJob: *baseJobWrapper.
+				Clone().
+				Queue("test-queue").
+				UID("test-uid").
+				PriorityClass("pod-priority").
+				Obj(),
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("job", "ns").
+					PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").PriorityClass("pod-priority").Obj()).
+					Queue("test-queue").
+					PriorityClass("pod-priority").
+					Priority(100).
+					PriorityClassSource(constants.PodPriorityClassSource).
+					Labels(map[string]string{
+						controllerconsts.JobUIDLabel: "test-uid",
+					}).
+					Obj(),
+			},
+		},
 		"the workload without uid label is created when job's uid is longer than 63 characters": {
 			job: *baseJobWrapper.
 				Clone().
@@ -638,6 +823,218 @@ func TestReconciler(t *testing.T) {
 			},
 			wantErr: jobframework.ErrExtraWorkloads,
 		},
+		"workload is deactivated when the job annotation is false": {
+			job: *baseJobWrapper.Clone().
+				Queue("foo").
+				Suspend(false).
+				SetAnnotation(controllerconsts.WorkloadActiveAnnotation, "false").
+				Obj(),
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("a", "ns").
+					PodSets(*utiltesting.MakePodSet("main", 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Admit(utiltesting.MakeAdmission("cq").AssignmentPodCount(10).Obj()).
+					Obj(),
+			},
+			wantJob: *baseJobWrapper.Clone().
+				Queue("foo").
+				Suspend(false).
+				SetAnnotation(controllerconsts.WorkloadActiveAnnotation, "false").
+				Obj(),
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("a", "ns").
+					PodSets(*utiltesting.MakePodSet("main", 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Admit(utiltesting.MakeAdmission("cq").AssignmentPodCount(10).Obj()).
+					Annotation(controllerconsts.WorkloadActiveAnnotation, "false").
+					Active(false).
+					Obj(),
+			},
+		},
+		"workload is reactivated when the job annotation is removed": {
+			job: *baseJobWrapper.Clone().
+				Queue("foo").
+				Obj(),
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("a", "ns").
+					PodSets(*utiltesting.MakePodSet("main", 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Annotation(controllerconsts.WorkloadActiveAnnotation, "false").
+					Active(false).
+					Obj(),
+			},
+			wantJob: *baseJobWrapper.Clone().
+				Queue("foo").
+				Obj(),
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("a", "ns").
+					PodSets(*utiltesting.MakePodSet("main", 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Active(true).
+					Obj(),
+			},
+		},
+		"workload is created inactive when the job annotation is false": {
+			job: *baseJobWrapper.Clone().
+				Queue("foo").
+				UID("test-uid").
+				SetAnnotation(controllerconsts.WorkloadActiveAnnotation, "false").
+				Obj(),
+			wantJob: *baseJobWrapper.Clone().
+				Queue("foo").
+				UID("test-uid").
+				SetAnnotation(controllerconsts.WorkloadActiveAnnotation, "false").
+				Obj(),
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("job", "ns").
+					PodSets(*utiltesting.MakePodSet("main", 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Priority(0).
+					Labels(map[string]string{
+						controllerconsts.JobUIDLabel: "test-uid",
+					}).
+					Annotation(controllerconsts.WorkloadActiveAnnotation, "false").
+					Active(false).
+					Obj(),
+			},
+		},
+		"workload deactivated directly stays inactive": {
+			job: *baseJobWrapper.Clone().
+				Queue("foo").
+				Obj(),
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("a", "ns").
+					PodSets(*utiltesting.MakePodSet("main", 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Active(false).
+					Obj(),
+			},
+			wantJob: *baseJobWrapper.Clone().
+				Queue("foo").
+				Obj(),
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("a", "ns").
+					PodSets(*utiltesting.MakePodSet("main", 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Active(false).
+					Obj(),
+			},
+		},
+		"preemption cost changed in the job is copied to the workload": {
+			job: *baseJobWrapper.Clone().
+				Queue("foo").
+				SetAnnotation(controllerconsts.PreemptionCostAnnotation, "20").
+				Obj(),
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("a", "ns").
+					PodSets(*utiltesting.MakePodSet("main", 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Annotation(controllerconsts.PreemptionCostAnnotation, "10").
+					Obj(),
+			},
+			wantJob: *baseJobWrapper.Clone().
+				Queue("foo").
+				SetAnnotation(controllerconsts.PreemptionCostAnnotation, "20").
+				Obj(),
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("a", "ns").
+					PodSets(*utiltesting.MakePodSet("main", 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Annotation(controllerconsts.PreemptionCostAnnotation, "20").
+					Obj(),
+			},
+		},
+		"preemption cost removed from the job is removed from the workload": {
+			job: *baseJobWrapper.Clone().
+				Queue("foo").
+				Obj(),
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("a", "ns").
+					PodSets(*utiltesting.MakePodSet("main", 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Annotation(controllerconsts.PreemptionCostAnnotation, "10").
+					Obj(),
+			},
+			wantJob: *baseJobWrapper.Clone().
+				Queue("foo").
+				Obj(),
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("a", "ns").
+					PodSets(*utiltesting.MakePodSet("main", 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Obj(),
+			},
+		},
+		"workload deactivated directly stays inactive while the job annotation doesn't change": {
+			job: *baseJobWrapper.Clone().
+				Queue("foo").
+				SetAnnotation(controllerconsts.WorkloadActiveAnnotation, "true").
+				Obj(),
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("a", "ns").
+					PodSets(*utiltesting.MakePodSet("main", 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Annotation(controllerconsts.WorkloadActiveAnnotation, "true").
+					Active(false).
+					Obj(),
+			},
+			wantJob: *baseJobWrapper.Clone().
+				Queue("foo").
+				SetAnnotation(controllerconsts.WorkloadActiveAnnotation, "true").
+				Obj(),
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("a", "ns").
+					PodSets(*utiltesting.MakePodSet("main", 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Annotation(controllerconsts.WorkloadActiveAnnotation, "true").
+					Active(false).
+					Obj(),
+			},
+		},
+		"workload is created with the maximum execution time of the job label": {
+			job: *baseJobWrapper.Clone().
+				Queue("foo").
+				UID("test-uid").
+				Label(controllerconsts.MaxExecTimeSecondsLabel, "600").
+				Obj(),
+			wantJob: *baseJobWrapper.Clone().
+				Queue("foo").
+				UID("test-uid").
+				Label(controllerconsts.MaxExecTimeSecondsLabel, "600").
+				Obj(),
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("job", "ns").
+					PodSets(*utiltesting.MakePodSet("main", 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Priority(0).
+					Labels(map[string]string{
+						controllerconsts.JobUIDLabel: "test-uid",
+					}).
+					MaximumExecutionTimeSeconds(600).
+					Obj(),
+			},
+		},
+		"workload is created with the preemption cost of the job annotation": {
+			job: *baseJobWrapper.Clone().
+				Queue("foo").
+				UID("test-uid").
+				SetAnnotation(controllerconsts.PreemptionCostAnnotation, "100").
+				Obj(),
+			wantJob: *baseJobWrapper.Clone().
+				Queue("foo").
+				UID("test-uid").
+				SetAnnotation(controllerconsts.PreemptionCostAnnotation, "100").
+				Obj(),
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("job", "ns").
+					PodSets(*utiltesting.MakePodSet("main", 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Priority(0).
+					Labels(map[string]string{
+						controllerconsts.JobUIDLabel: "test-uid",
+					}).
+					Annotation(controllerconsts.PreemptionCostAnnotation, "100").
+					Obj(),
+			},
+		},
 		"when workload is evicted, suspend, reset startTime and restore node affinity": {
 			job: *baseJobWrapper.Clone().
 				Queue("foo").
@@ -748,7 +1145,8 @@ func TestReconciler(t *testing.T) {
 				t.Fatalf("Could not setup indexes: %v", err)
 			}
 			kcBuilder := clientBuilder.
-				WithObjects(&tc.job)
+				WithObjects(&tc.job).
+				WithObjects(tc.priorityClasses...)
 			for i := range tc.workloads {
 				kcBuilder = kcBuilder.WithStatusSubresource(&tc.workloads[i])
 			}
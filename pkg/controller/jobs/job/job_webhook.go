@@ -106,6 +106,10 @@ func (w *JobWebhook) validateCreate(job *Job) field.ErrorList {
 	var allErrs field.ErrorList
 	allErrs = append(allErrs, jobframework.ValidateAnnotationAsCRDName(job, constants.ParentWorkloadAnnotation)...)
 	allErrs = append(allErrs, jobframework.ValidateCreateForQueueName(job)...)
+	allErrs = append(allErrs, jobframework.ValidateCreateForWorkloadPriorityClassName(job)...)
+	allErrs = append(allErrs, jobframework.ValidateWorkloadActive(job)...)
+	allErrs = append(allErrs, jobframework.ValidateMaxExecTime(job)...)
+	allErrs = append(allErrs, jobframework.ValidatePreemptionGracePeriod(job)...)
 	allErrs = append(allErrs, w.validatePartialAdmissionCreate(job)...)
 	allErrs = append(allErrs, jobframework.ValidateCreateForParentWorkload(job)...)
 	return allErrs
@@ -159,6 +163,7 @@ func (w *JobWebhook) validateUpdate(oldJob, newJob *Job) field.ErrorList {
 	allErrs := w.validateCreate(newJob)
 	allErrs = append(allErrs, jobframework.ValidateUpdateForParentWorkload(oldJob, newJob)...)
 	allErrs = append(allErrs, jobframework.ValidateUpdateForQueueName(oldJob, newJob)...)
+	allErrs = append(allErrs, jobframework.ValidateUpdateForWorkloadPriorityClassName(oldJob, newJob)...)
 	allErrs = append(allErrs, validatePartialAdmissionUpdate(oldJob, newJob)...)
 	return allErrs
 }
@@ -46,11 +46,15 @@ const (
 )
 
 var (
-	annotationsPath          = field.NewPath("metadata", "annotations")
-	labelsPath               = field.NewPath("metadata", "labels")
-	parentWorkloadKeyPath    = annotationsPath.Key(constants.ParentWorkloadAnnotation)
-	queueNameLabelPath       = labelsPath.Key(constants.QueueLabel)
-	queueNameAnnotationsPath = annotationsPath.Key(constants.QueueAnnotation)
+	annotationsPath               = field.NewPath("metadata", "annotations")
+	labelsPath                    = field.NewPath("metadata", "labels")
+	parentWorkloadKeyPath         = annotationsPath.Key(constants.ParentWorkloadAnnotation)
+	queueNameLabelPath            = labelsPath.Key(constants.QueueLabel)
+	queueNameAnnotationsPath      = annotationsPath.Key(constants.QueueAnnotation)
+	workloadPriorityClassNamePath = labelsPath.Key(constants.WorkloadPriorityClassLabel)
+	workloadActivePath            = annotationsPath.Key(constants.WorkloadActiveAnnotation)
+	maxExecTimeLabelPath          = labelsPath.Key(constants.MaxExecTimeSecondsLabel)
+	gracePeriodLabelPath          = labelsPath.Key(constants.PreemptionGracePeriodSecondsLabel)
 )
 
 func TestValidateCreate(t *testing.T) {
@@ -103,6 +107,41 @@ func TestValidateCreate(t *testing.T) {
 			job:     testingutil.MakeJob("job", "default").QueueNameAnnotation("queue name").Obj(),
 			wantErr: field.ErrorList{field.Invalid(queueNameAnnotationsPath, "queue name", invalidRFC1123Message)},
 		},
+		{
+			name:    "invalid workload priority class label",
+			job:     testingutil.MakeJob("job", "default").WorkloadPriorityClass("priority class").Obj(),
+			wantErr: field.ErrorList{field.Invalid(workloadPriorityClassNamePath, "priority class", invalidRFC1123Message)},
+		},
+		{
+			name:    "valid workload-active annotation",
+			job:     testingutil.MakeJob("job", "default").SetAnnotation(constants.WorkloadActiveAnnotation, "false").Obj(),
+			wantErr: nil,
+		},
+		{
+			name:    "invalid workload-active annotation",
+			job:     testingutil.MakeJob("job", "default").SetAnnotation(constants.WorkloadActiveAnnotation, "no").Obj(),
+			wantErr: field.ErrorList{field.Invalid(workloadActivePath, "no", "must be true or false")},
+		},
+		{
+			name:    "valid max-exec-time-seconds label",
+			job:     testingutil.MakeJob("job", "default").Label(constants.MaxExecTimeSecondsLabel, "3600").Obj(),
+			wantErr: nil,
+		},
+		{
+			name:    "invalid max-exec-time-seconds label",
+			job:     testingutil.MakeJob("job", "default").Label(constants.MaxExecTimeSecondsLabel, "0").Obj(),
+			wantErr: field.ErrorList{field.Invalid(maxExecTimeLabelPath, "0", "must be a positive integer")},
+		},
+		{
+			name:    "valid preemption-grace-period-seconds label",
+			job:     testingutil.MakeJob("job", "default").Label(constants.PreemptionGracePeriodSecondsLabel, "0").Obj(),
+			wantErr: nil,
+		},
+		{
+			name:    "invalid preemption-grace-period-seconds label",
+			job:     testingutil.MakeJob("job", "default").Label(constants.PreemptionGracePeriodSecondsLabel, "-1").Obj(),
+			wantErr: field.ErrorList{field.Invalid(gracePeriodLabelPath, "-1", "must be a non-negative integer")},
+		},
 		{
 			name: "invalid queue-name and parent-workload annotation",
 			job: testingutil.MakeJob("job", "default").
@@ -390,6 +429,60 @@ func TestValidateUpdate(t *testing.T) {
 				Obj(),
 			wantErr: nil,
 		},
+		{
+			name:    "add the workload priority class label",
+			oldJob:  testingutil.MakeJob("job", "default").Obj(),
+			newJob:  testingutil.MakeJob("job", "default").WorkloadPriorityClass("test-wpc").Obj(),
+			wantErr: field.ErrorList{field.Forbidden(workloadPriorityClassNamePath, "this label is immutable")},
+		},
+		{
+			name:    "update the workload priority class label",
+			oldJob:  testingutil.MakeJob("job", "default").WorkloadPriorityClass("test-wpc").Obj(),
+			newJob:  testingutil.MakeJob("job", "default").WorkloadPriorityClass("new-test-wpc").Obj(),
+			wantErr: field.ErrorList{field.Forbidden(workloadPriorityClassNamePath, "this label is immutable")},
+		},
+		{
+			name:    "delete the workload priority class label",
+			oldJob:  testingutil.MakeJob("job", "default").WorkloadPriorityClass("test-wpc").Obj(),
+			newJob:  testingutil.MakeJob("job", "default").Obj(),
+			wantErr: field.ErrorList{field.Forbidden(workloadPriorityClassNamePath, "this label is immutable")},
+		},
+		{
+			name:    "deactivate the job",
+			oldJob:  testingutil.MakeJob("job", "default").Queue("queue").Obj(),
+			newJob:  testingutil.MakeJob("job", "default").Queue("queue").SetAnnotation(constants.WorkloadActiveAnnotation, "false").Obj(),
+			wantErr: nil,
+		},
+		{
+			name:    "set an invalid workload active annotation",
+			oldJob:  testingutil.MakeJob("job", "default").Queue("queue").Obj(),
+			newJob:  testingutil.MakeJob("job", "default").Queue("queue").SetAnnotation(constants.WorkloadActiveAnnotation, "no").Obj(),
+			wantErr: field.ErrorList{field.Invalid(workloadActivePath, "no", "must be true or false")},
+		},
+		{
+			name:    "set the max exec time label",
+			oldJob:  testingutil.MakeJob("job", "default").Queue("queue").Obj(),
+			newJob:  testingutil.MakeJob("job", "default").Queue("queue").Label(constants.MaxExecTimeSecondsLabel, "3600").Obj(),
+			wantErr: nil,
+		},
+		{
+			name:    "set an invalid max exec time label",
+			oldJob:  testingutil.MakeJob("job", "default").Queue("queue").Obj(),
+			newJob:  testingutil.MakeJob("job", "default").Queue("queue").Label(constants.MaxExecTimeSecondsLabel, "0").Obj(),
+			wantErr: field.ErrorList{field.Invalid(maxExecTimeLabelPath, "0", "must be a positive integer")},
+		},
+		{
+			name:    "set the preemption grace period label",
+			oldJob:  testingutil.MakeJob("job", "default").Queue("queue").Obj(),
+			newJob:  testingutil.MakeJob("job", "default").Queue("queue").Label(constants.PreemptionGracePeriodSecondsLabel, "60").Obj(),
+			wantErr: nil,
+		},
+		{
+			name:    "set an invalid preemption grace period label",
+			oldJob:  testingutil.MakeJob("job", "default").Queue("queue").Obj(),
+			newJob:  testingutil.MakeJob("job", "default").Queue("queue").Label(constants.PreemptionGracePeriodSecondsLabel, "-1").Obj(),
+			wantErr: field.ErrorList{field.Invalid(gracePeriodLabelPath, "-1", "must be a non-negative integer")},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -19,8 +19,8 @@ package jobset
 import (
 	"context"
 	"strings"
+	"time"
 
-	"k8s.io/apimachinery/pkg/api/equality"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -32,7 +32,6 @@ import (
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
-	"sigs.k8s.io/kueue/pkg/util/maps"
 	"sigs.k8s.io/kueue/pkg/util/slices"
 )
 
@@ -47,13 +46,16 @@ func init() {
 		NewReconciler:          NewReconciler,
 		SetupWebhook:           SetupJobSetWebhook,
 		JobType:                &jobsetapi.JobSet{},
+		NewJob:                 NewJob,
 		AddToScheme:            jobsetapi.AddToScheme,
 		IsManagingObjectsOwner: isJobSet,
 	}))
 }
 
 //+kubebuilder:rbac:groups=scheduling.k8s.io,resources=priorityclasses,verbs=list;get;watch
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloadpriorityclasses,verbs=list;get;watch
 //+kubebuilder:rbac:groups="",resources=events,verbs=create;watch;update;patch
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;patch
 //+kubebuilder:rbac:groups=jobset.x-k8s.io,resources=jobsets,verbs=get;list;watch;update;patch
 //+kubebuilder:rbac:groups=jobset.x-k8s.io,resources=jobsets/status,verbs=get;update
 //+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;create;update;patch;delete
@@ -61,7 +63,11 @@ func init() {
 //+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/finalizers,verbs=update
 //+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch
 
-var NewReconciler = jobframework.NewGenericReconciler(func() jobframework.GenericJob { return &JobSet{} }, nil)
+func NewJob() jobframework.GenericJob {
+	return &JobSet{}
+}
+
+var NewReconciler = jobframework.NewGenericReconciler(NewJob, nil)
 
 func isJobSet(owner *metav1.OwnerReference) bool {
 	return owner.Kind == "JobSet" && strings.HasPrefix(owner.APIVersion, "jobset.x-k8s.io/v1")
@@ -71,6 +77,7 @@ type JobSet jobsetapi.JobSet
 
 var _ jobframework.GenericJob = (*JobSet)(nil)
 var _ jobframework.JobWithReclaimablePods = (*JobSet)(nil)
+var _ jobframework.JobWithPreemptionNotice = (*JobSet)(nil)
 
 func fromObject(obj runtime.Object) *JobSet {
 	return (*JobSet)(obj.(*jobsetapi.JobSet))
@@ -122,8 +129,7 @@ func (j *JobSet) RunWithPodSetsInfo(podSetInfos []jobframework.PodSetInfo) error
 	// If there are Jobs already created by the JobSet, their node selectors will be updated by the JobSet controller
 	// before unsuspending the individual Jobs.
 	for index := range j.Spec.ReplicatedJobs {
-		templateSpec := &j.Spec.ReplicatedJobs[index].Template.Spec.Template.Spec
-		templateSpec.NodeSelector = maps.MergeKeepFirst(podSetInfos[index].NodeSelector, templateSpec.NodeSelector)
+		jobframework.MergePodTemplate(&j.Spec.ReplicatedJobs[index].Template.Spec.Template, podSetInfos[index])
 	}
 	return nil
 }
@@ -134,11 +140,7 @@ func (j *JobSet) RestorePodSetsInfo(podSetInfos []jobframework.PodSetInfo) bool
 	}
 	changed := false
 	for index := range j.Spec.ReplicatedJobs {
-		if equality.Semantic.DeepEqual(j.Spec.ReplicatedJobs[index].Template.Spec.Template.Spec.NodeSelector, podSetInfos[index].NodeSelector) {
-			continue
-		}
-		changed = true
-		j.Spec.ReplicatedJobs[index].Template.Spec.Template.Spec.NodeSelector = maps.Clone(podSetInfos[index].NodeSelector)
+		changed = jobframework.RestorePodTemplate(&j.Spec.ReplicatedJobs[index].Template.Spec.Template, podSetInfos[index]) || changed
 	}
 	return changed
 }
@@ -177,6 +179,12 @@ func (j *JobSet) PodsReady() bool {
 	return replicas == readyReplicas
 }
 
+// NotifyPreemption sets the preemption deadline annotation in the running
+// pods of the JobSet. The pods can read it through a downwardAPI volume.
+func (j *JobSet) NotifyPreemption(ctx context.Context, c client.Client, deadline time.Time) error {
+	return jobframework.NotifyPodsPreemption(ctx, c, deadline, client.InNamespace(j.Namespace), client.MatchingLabels{jobsetapi.JobSetNameKey: j.Name})
+}
+
 func (j *JobSet) ReclaimablePods() []kueue.ReclaimablePod {
 	if len(j.Status.ReplicatedJobsStatus) == 0 {
 		return nil
@@ -18,6 +18,7 @@ package jobset
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -29,6 +30,7 @@ import (
 	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	testingjobset "sigs.k8s.io/kueue/pkg/util/testingjobs/jobset"
@@ -293,3 +295,36 @@ func TestReconciler(t *testing.T) {
 	}
 
 }
+
+func TestNotifyPreemption(t *testing.T) {
+	deadline := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	pod := func(name, owner string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", Labels: map[string]string{jobset.JobSetNameKey: owner}},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+	ctx, _ := utiltesting.ContextWithLog(t)
+	cl := utiltesting.NewClientBuilder().WithObjects(
+		pod("running", "job"),
+		pod("other", "other"),
+	).Build()
+
+	if err := (*JobSet)(testingjobset.MakeJobSet("job", "ns").Obj()).NotifyPreemption(ctx, cl, deadline); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	wantAnnotations := map[string]string{
+		"running": "2023-10-01T12:00:00Z",
+		"other":   "",
+	}
+	for name, want := range wantAnnotations {
+		var got corev1.Pod
+		if err := cl.Get(ctx, client.ObjectKey{Namespace: "ns", Name: name}, &got); err != nil {
+			t.Fatalf("Getting pod %s: %v", name, err)
+		}
+		if got := got.Annotations[constants.PreemptionDeadlineAnnotation]; got != want {
+			t.Errorf("Unexpected annotation in pod %s, want=%q, got=%q", name, want, got)
+		}
+	}
+}
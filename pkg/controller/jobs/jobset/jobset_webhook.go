@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -71,7 +72,16 @@ func (w *JobSetWebhook) ValidateCreate(ctx context.Context, obj runtime.Object)
 	jobSet := fromObject(obj)
 	log := ctrl.LoggerFrom(ctx).WithName("jobset-webhook")
 	log.Info("Validating create", "jobset", klog.KObj(jobSet))
-	return nil, jobframework.ValidateCreateForQueueName(jobSet).ToAggregate()
+	return nil, validateCreate(jobSet).ToAggregate()
+}
+
+func validateCreate(jobSet *JobSet) field.ErrorList {
+	allErrs := jobframework.ValidateCreateForQueueName(jobSet)
+	allErrs = append(allErrs, jobframework.ValidateCreateForWorkloadPriorityClassName(jobSet)...)
+	allErrs = append(allErrs, jobframework.ValidateWorkloadActive(jobSet)...)
+	allErrs = append(allErrs, jobframework.ValidateMaxExecTime(jobSet)...)
+	allErrs = append(allErrs, jobframework.ValidatePreemptionGracePeriod(jobSet)...)
+	return allErrs
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
@@ -81,7 +91,8 @@ func (w *JobSetWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runti
 	log := ctrl.LoggerFrom(ctx).WithName("jobset-webhook")
 	log.Info("Validating update", "jobset", klog.KObj(newJobSet))
 	allErrs := jobframework.ValidateUpdateForQueueName(oldJobSet, newJobSet)
-	allErrs = append(allErrs, jobframework.ValidateCreateForQueueName(newJobSet)...)
+	allErrs = append(allErrs, jobframework.ValidateUpdateForWorkloadPriorityClassName(oldJobSet, newJobSet)...)
+	allErrs = append(allErrs, validateCreate(newJobSet)...)
 	return nil, allErrs.ToAggregate()
 }
 
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobset
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	testingjobset "sigs.k8s.io/kueue/pkg/util/testingjobs/jobset"
+)
+
+func TestValidateUpdate(t *testing.T) {
+	testcases := map[string]struct {
+		oldJobSet *jobset.JobSet
+		newJobSet *jobset.JobSet
+		wantErr   error
+	}{
+		"deactivate the jobset": {
+			oldJobSet: testingjobset.MakeJobSet("jobset", "ns").Queue("queue").Obj(),
+			newJobSet: testingjobset.MakeJobSet("jobset", "ns").Queue("queue").Annotation(constants.WorkloadActiveAnnotation, "false").Obj(),
+		},
+		"invalid workload active annotation": {
+			oldJobSet: testingjobset.MakeJobSet("jobset", "ns").Queue("queue").Obj(),
+			newJobSet: testingjobset.MakeJobSet("jobset", "ns").Queue("queue").Annotation(constants.WorkloadActiveAnnotation, "no").Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("metadata", "annotations").Key(constants.WorkloadActiveAnnotation), "no", "must be true or false"),
+			}.ToAggregate(),
+		},
+		"set the max exec time label": {
+			oldJobSet: testingjobset.MakeJobSet("jobset", "ns").Queue("queue").Obj(),
+			newJobSet: testingjobset.MakeJobSet("jobset", "ns").Queue("queue").Label(constants.MaxExecTimeSecondsLabel, "3600").Obj(),
+		},
+		"invalid max exec time label": {
+			oldJobSet: testingjobset.MakeJobSet("jobset", "ns").Queue("queue").Obj(),
+			newJobSet: testingjobset.MakeJobSet("jobset", "ns").Queue("queue").Label(constants.MaxExecTimeSecondsLabel, "0").Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("metadata", "labels").Key(constants.MaxExecTimeSecondsLabel), "0", "must be a positive integer"),
+			}.ToAggregate(),
+		},
+		"set the preemption grace period label": {
+			oldJobSet: testingjobset.MakeJobSet("jobset", "ns").Queue("queue").Obj(),
+			newJobSet: testingjobset.MakeJobSet("jobset", "ns").Queue("queue").Label(constants.PreemptionGracePeriodSecondsLabel, "60").Obj(),
+		},
+		"invalid preemption grace period label": {
+			oldJobSet: testingjobset.MakeJobSet("jobset", "ns").Queue("queue").Obj(),
+			newJobSet: testingjobset.MakeJobSet("jobset", "ns").Queue("queue").Label(constants.PreemptionGracePeriodSecondsLabel, "-1").Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("metadata", "labels").Key(constants.PreemptionGracePeriodSecondsLabel), "-1", "must be a non-negative integer"),
+			}.ToAggregate(),
+		},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			_, gotErr := new(JobSetWebhook).ValidateUpdate(context.Background(), tc.oldJobSet, tc.newJobSet)
+			if diff := cmp.Diff(tc.wantErr, gotErr); diff != "" {
+				t.Errorf("ValidateUpdate() mismatch (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
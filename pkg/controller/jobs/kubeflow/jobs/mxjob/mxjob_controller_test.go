@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mxjob
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kftraining "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+)
+
+func TestOrderedReplicaTypes(t *testing.T) {
+	testcases := map[string]struct {
+		replicaSpecs map[kftraining.ReplicaType]*kftraining.ReplicaSpec
+		want         []kftraining.ReplicaType
+	}{
+		"all replica types": {
+			replicaSpecs: map[kftraining.ReplicaType]*kftraining.ReplicaSpec{
+				kftraining.MXJobReplicaTypeTuner:        {},
+				kftraining.MXJobReplicaTypeTunerServer:  {},
+				kftraining.MXJobReplicaTypeTunerTracker: {},
+				kftraining.MXJobReplicaTypeWorker:       {},
+				kftraining.MXJobReplicaTypeServer:       {},
+				kftraining.MXJobReplicaTypeScheduler:    {},
+			},
+			want: []kftraining.ReplicaType{
+				kftraining.MXJobReplicaTypeScheduler,
+				kftraining.MXJobReplicaTypeServer,
+				kftraining.MXJobReplicaTypeWorker,
+				kftraining.MXJobReplicaTypeTunerTracker,
+				kftraining.MXJobReplicaTypeTunerServer,
+				kftraining.MXJobReplicaTypeTuner,
+			},
+		},
+		"some replica types": {
+			replicaSpecs: map[kftraining.ReplicaType]*kftraining.ReplicaSpec{
+				kftraining.MXJobReplicaTypeWorker:    {},
+				kftraining.MXJobReplicaTypeServer:    {},
+				kftraining.MXJobReplicaTypeScheduler: {},
+			},
+			want: []kftraining.ReplicaType{
+				kftraining.MXJobReplicaTypeScheduler,
+				kftraining.MXJobReplicaTypeServer,
+				kftraining.MXJobReplicaTypeWorker,
+			},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			job := &JobControl{Spec: kftraining.MXJobSpec{MXReplicaSpecs: tc.replicaSpecs}}
+			if diff := cmp.Diff(tc.want, job.OrderedReplicaTypes()); diff != "" {
+				t.Errorf("Unexpected replica types (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
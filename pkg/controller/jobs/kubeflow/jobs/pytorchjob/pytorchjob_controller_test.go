@@ -0,0 +1,160 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pytorchjob
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	kftraining "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingpytorchjob "sigs.k8s.io/kueue/pkg/util/testingjobs/pytorchjob"
+)
+
+var (
+	jobCmpOpts = []cmp.Option{
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(kftraining.PyTorchJob{}, "TypeMeta", "ObjectMeta"),
+	}
+	workloadCmpOpts = []cmp.Option{
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(kueue.Workload{}, "TypeMeta", "ObjectMeta"),
+		cmpopts.IgnoreFields(kueue.WorkloadSpec{}, "Priority"),
+		cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime"),
+		cmpopts.IgnoreFields(kueue.PodSet{}, "Template"),
+	}
+)
+
+func TestReconciler(t *testing.T) {
+	onDemand := map[corev1.ResourceName]kueue.ResourceFlavorReference{corev1.ResourceCPU: "on-demand"}
+	admission := utiltesting.MakeAdmission("cq").
+		PodSets(
+			kueue.PodSetAssignment{Name: "master", Flavors: onDemand},
+			kueue.PodSetAssignment{Name: "worker", Flavors: onDemand},
+		).
+		Obj()
+	// The workload of the admitted job needs to match the containers of the job.
+	baseJob := fromObject(testingpytorchjob.MakePyTorchJob("pytorchjob", "ns").Queue("user-queue").Parallelism(2).Obj())
+	cases := map[string]struct {
+		reconcilerOptions []jobframework.Option
+		job               *kftraining.PyTorchJob
+		workloads         []kueue.Workload
+		wantJob           *kftraining.PyTorchJob
+		wantWorkloads     []kueue.Workload
+		wantErr           error
+	}{
+		"workload is created with podsets": {
+			reconcilerOptions: []jobframework.Option{
+				jobframework.WithManageJobsWithoutQueueName(true),
+			},
+			job:     testingpytorchjob.MakePyTorchJob("pytorchjob", "ns").Parallelism(2).Obj(),
+			wantJob: testingpytorchjob.MakePyTorchJob("pytorchjob", "ns").Parallelism(2).Obj(),
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("pytorchjob", "ns").
+					PodSets(
+						*utiltesting.MakePodSet("master", 1).Obj(),
+						*utiltesting.MakePodSet("worker", 2).Obj(),
+					).
+					Obj(),
+			},
+		},
+		"job is unsuspended when the workload is admitted": {
+			job: testingpytorchjob.MakePyTorchJob("pytorchjob", "ns").Queue("user-queue").Parallelism(2).Obj(),
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("pytorchjob", "ns").
+					Queue("user-queue").
+					PodSets(baseJob.PodSets()...).
+					Admit(admission).
+					Obj(),
+			},
+			wantJob: testingpytorchjob.MakePyTorchJob("pytorchjob", "ns").
+				Queue("user-queue").
+				Parallelism(2).
+				Suspend(false).
+				NodeSelector(kftraining.PyTorchJobReplicaTypeMaster, "instance-type", "on-demand").
+				NodeSelector(kftraining.PyTorchJobReplicaTypeWorker, "instance-type", "on-demand").
+				Obj(),
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("pytorchjob", "ns").
+					Queue("user-queue").
+					PodSets(baseJob.PodSets()...).
+					Admit(admission).
+					Obj(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			clientBuilder := utiltesting.NewClientBuilder(kftraining.AddToScheme)
+			if err := SetupIndexes(ctx, utiltesting.AsIndexer(clientBuilder)); err != nil {
+				t.Fatalf("Could not setup indexes: %v", err)
+			}
+			kcBuilder := clientBuilder.
+				WithObjects(tc.job).
+				WithObjects(utiltesting.MakeResourceFlavor("on-demand").Label("instance-type", "on-demand").Obj())
+			for i := range tc.workloads {
+				kcBuilder = kcBuilder.WithStatusSubresource(&tc.workloads[i])
+			}
+			kClient := kcBuilder.Build()
+			for i := range tc.workloads {
+				if err := ctrl.SetControllerReference(tc.job, &tc.workloads[i], kClient.Scheme()); err != nil {
+					t.Fatalf("Could not setup owner reference in Workloads: %v", err)
+				}
+				if err := kClient.Create(ctx, &tc.workloads[i]); err != nil {
+					t.Fatalf("Could not create workload: %v", err)
+				}
+			}
+			recorder := record.NewBroadcaster().NewRecorder(kClient.Scheme(), corev1.EventSource{Component: "test"})
+			reconciler := NewReconciler(kClient, recorder, tc.reconcilerOptions...)
+
+			jobKey := client.ObjectKeyFromObject(tc.job)
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: jobKey,
+			})
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Reconcile returned error (-want,+got):\n%s", diff)
+			}
+
+			var gotJob kftraining.PyTorchJob
+			if err := kClient.Get(ctx, jobKey, &gotJob); err != nil {
+				t.Fatalf("Could not get Job after reconcile: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantJob, &gotJob, jobCmpOpts...); diff != "" {
+				t.Errorf("Job after reconcile (-want,+got):\n%s", diff)
+			}
+			var gotWorkloads kueue.WorkloadList
+			if err := kClient.List(ctx, &gotWorkloads); err != nil {
+				t.Fatalf("Could not get Workloads after reconcile: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantWorkloads, gotWorkloads.Items, workloadCmpOpts...); diff != "" {
+				t.Errorf("Workloads after reconcile (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeflowjob
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	kftraining "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+)
+
+// KFJobControl gives access to the fields shared by the jobs of the kubeflow
+// training-operator. It is implemented by each of the job kinds.
+type KFJobControl interface {
+	// Object returns the job instance.
+	Object() client.Object
+	// GVK returns the GroupVersionKind of the job.
+	GVK() schema.GroupVersionKind
+	// RunPolicy returns the RunPolicy of the job.
+	RunPolicy() *kftraining.RunPolicy
+	// ReplicaSpecs returns the ReplicaSpecs of the job.
+	ReplicaSpecs() map[kftraining.ReplicaType]*kftraining.ReplicaSpec
+	// JobStatus returns the status of the job.
+	JobStatus() kftraining.JobStatus
+	// OrderedReplicaTypes returns the replica types of the job, in the order
+	// of the PodSets of its workload.
+	OrderedReplicaTypes() []kftraining.ReplicaType
+}
+
+// KubeflowJob implements the GenericJob interface for the jobs of the kubeflow
+// training-operator.
+type KubeflowJob struct {
+	KFJobControl KFJobControl
+}
+
+var _ jobframework.GenericJob = (*KubeflowJob)(nil)
+var _ jobframework.JobWithPriorityClass = (*KubeflowJob)(nil)
+var _ jobframework.JobWithPreemptionNotice = (*KubeflowJob)(nil)
+
+func (j *KubeflowJob) Object() client.Object {
+	return j.KFJobControl.Object()
+}
+
+func (j *KubeflowJob) IsSuspended() bool {
+	return j.KFJobControl.RunPolicy().Suspend != nil && *j.KFJobControl.RunPolicy().Suspend
+}
+
+func (j *KubeflowJob) Suspend() {
+	j.KFJobControl.RunPolicy().Suspend = pointer.Bool(true)
+}
+
+func (j *KubeflowJob) RunWithPodSetsInfo(podSetsInfo []jobframework.PodSetInfo) error {
+	j.KFJobControl.RunPolicy().Suspend = pointer.Bool(false)
+	orderedReplicaTypes := j.KFJobControl.OrderedReplicaTypes()
+
+	if len(podSetsInfo) != len(orderedReplicaTypes) {
+		return jobframework.BadPodSetsInfoLenError(len(orderedReplicaTypes), len(podSetsInfo))
+	}
+
+	// The node selectors are provided in the same order as the generated list of
+	// podSets, use the same ordering logic to restore them.
+	for index := range podSetsInfo {
+		replicaType := orderedReplicaTypes[index]
+		jobframework.MergePodTemplate(&j.KFJobControl.ReplicaSpecs()[replicaType].Template, podSetsInfo[index])
+	}
+	return nil
+}
+
+func (j *KubeflowJob) RestorePodSetsInfo(podSetsInfo []jobframework.PodSetInfo) bool {
+	orderedReplicaTypes := j.KFJobControl.OrderedReplicaTypes()
+	changed := false
+	for index, info := range podSetsInfo {
+		replicaType := orderedReplicaTypes[index]
+		changed = jobframework.RestorePodTemplate(&j.KFJobControl.ReplicaSpecs()[replicaType].Template, info) || changed
+	}
+	return changed
+}
+
+func (j *KubeflowJob) Finished() (metav1.Condition, bool) {
+	var conditionType kftraining.JobConditionType
+	var finished bool
+	for _, c := range j.KFJobControl.JobStatus().Conditions {
+		if (c.Type == kftraining.JobSucceeded || c.Type == kftraining.JobFailed) && c.Status == corev1.ConditionTrue {
+			conditionType = c.Type
+			finished = true
+			break
+		}
+	}
+	message := "Job finished successfully"
+	if conditionType == kftraining.JobFailed {
+		message = "Job failed"
+	}
+	condition := metav1.Condition{
+		Type:    kueue.WorkloadFinished,
+		Status:  metav1.ConditionTrue,
+		Reason:  "JobFinished",
+		Message: message,
+	}
+	return condition, finished
+}
+
+// PodSets returns a PodSet for each replica type of the job, named after the
+// lower cased replica type.
+func (j *KubeflowJob) PodSets() []kueue.PodSet {
+	replicaTypes := j.KFJobControl.OrderedReplicaTypes()
+	podSets := make([]kueue.PodSet, len(replicaTypes))
+	for index, replicaType := range replicaTypes {
+		podSets[index] = kueue.PodSet{
+			Name:     strings.ToLower(string(replicaType)),
+			Template: *j.KFJobControl.ReplicaSpecs()[replicaType].Template.DeepCopy(),
+			Count:    podsCount(j.KFJobControl.ReplicaSpecs(), replicaType),
+		}
+	}
+	return podSets
+}
+
+func (j *KubeflowJob) IsActive() bool {
+	for _, replicaStatus := range j.KFJobControl.JobStatus().ReplicaStatuses {
+		if replicaStatus.Active != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (j *KubeflowJob) PodsReady() bool {
+	for _, c := range j.KFJobControl.JobStatus().Conditions {
+		if c.Type == kftraining.JobRunning && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// NotifyPreemption sets the preemption deadline annotation in the running
+// pods of the job. The pods can read it through a downwardAPI volume.
+func (j *KubeflowJob) NotifyPreemption(ctx context.Context, c client.Client, deadline time.Time) error {
+	object := j.Object()
+	var pods corev1.PodList
+	if err := c.List(ctx, &pods, client.InNamespace(object.GetNamespace()), client.MatchingLabels{kftraining.JobNameLabel: object.GetName()}); err != nil {
+		return fmt.Errorf("listing pods: %w", err)
+	}
+	for i := range pods.Items {
+		// The jobs of all the kinds of the training-operator share the job
+		// name label.
+		if !metav1.IsControlledBy(&pods.Items[i], object) {
+			continue
+		}
+		if err := jobframework.NotifyPodPreemption(ctx, c, &pods.Items[i], deadline); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *KubeflowJob) GetGVK() schema.GroupVersionKind {
+	return j.KFJobControl.GVK()
+}
+
+// PriorityClass calculates the priorityClass name needed for workload according to the following priorities:
+//  1. .spec.runPolicy.schedulingPolicy.priorityClass
+//  2. the priorityClassName of the first replica type, in order, that sets it.
+func (j *KubeflowJob) PriorityClass() string {
+	if j.KFJobControl.RunPolicy().SchedulingPolicy != nil && len(j.KFJobControl.RunPolicy().SchedulingPolicy.PriorityClass) != 0 {
+		return j.KFJobControl.RunPolicy().SchedulingPolicy.PriorityClass
+	}
+	for _, replicaType := range j.KFJobControl.OrderedReplicaTypes() {
+		if spec := j.KFJobControl.ReplicaSpecs()[replicaType]; spec != nil && len(spec.Template.Spec.PriorityClassName) != 0 {
+			return spec.Template.Spec.PriorityClassName
+		}
+	}
+	return ""
+}
+
+// OrderedReplicaTypes returns the replica types present in the specs, in the
+// given order.
+func OrderedReplicaTypes(specs map[kftraining.ReplicaType]*kftraining.ReplicaSpec, order ...kftraining.ReplicaType) []kftraining.ReplicaType {
+	var result []kftraining.ReplicaType
+	for _, replicaType := range order {
+		if _, found := specs[replicaType]; found {
+			result = append(result, replicaType)
+		}
+	}
+	return result
+}
+
+func podsCount(specs map[kftraining.ReplicaType]*kftraining.ReplicaSpec, replicaType kftraining.ReplicaType) int32 {
+	return pointer.Int32Deref(specs[replicaType].Replicas, 1)
+}
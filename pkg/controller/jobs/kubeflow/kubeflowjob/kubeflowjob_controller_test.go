@@ -0,0 +1,396 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeflowjob
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	kftraining "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingpytorchjob "sigs.k8s.io/kueue/pkg/util/testingjobs/pytorchjob"
+)
+
+// testJobControl exercises the shared helpers through a PyTorchJob.
+type testJobControl kftraining.PyTorchJob
+
+var _ KFJobControl = (*testJobControl)(nil)
+
+func (j *testJobControl) Object() client.Object {
+	return (*kftraining.PyTorchJob)(j)
+}
+
+func (j *testJobControl) GVK() schema.GroupVersionKind {
+	return kftraining.SchemeGroupVersion.WithKind(kftraining.PyTorchJobKind)
+}
+
+func (j *testJobControl) RunPolicy() *kftraining.RunPolicy {
+	return &j.Spec.RunPolicy
+}
+
+func (j *testJobControl) ReplicaSpecs() map[kftraining.ReplicaType]*kftraining.ReplicaSpec {
+	return j.Spec.PyTorchReplicaSpecs
+}
+
+func (j *testJobControl) JobStatus() kftraining.JobStatus {
+	return j.Status
+}
+
+func (j *testJobControl) OrderedReplicaTypes() []kftraining.ReplicaType {
+	return OrderedReplicaTypes(j.Spec.PyTorchReplicaSpecs, kftraining.PyTorchJobReplicaTypeMaster, kftraining.PyTorchJobReplicaTypeWorker)
+}
+
+func fromObject(job *kftraining.PyTorchJob) *KubeflowJob {
+	return &KubeflowJob{KFJobControl: (*testJobControl)(job)}
+}
+
+func TestSuspend(t *testing.T) {
+	testcases := map[string]struct {
+		job           *kftraining.PyTorchJob
+		wantSuspended bool
+	}{
+		"suspended": {
+			job:           testingpytorchjob.MakePyTorchJob("job", "ns").Obj(),
+			wantSuspended: true,
+		},
+		"running": {
+			job: testingpytorchjob.MakePyTorchJob("job", "ns").Suspend(false).Obj(),
+		},
+		"suspend not set": {
+			job: func() *kftraining.PyTorchJob {
+				job := testingpytorchjob.MakePyTorchJob("job", "ns").Obj()
+				job.Spec.RunPolicy.Suspend = nil
+				return job
+			}(),
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			job := fromObject(tc.job)
+			if got := job.IsSuspended(); got != tc.wantSuspended {
+				t.Errorf("Unexpected IsSuspended (want: %v, got: %v)", tc.wantSuspended, got)
+			}
+			job.Suspend()
+			if !job.IsSuspended() {
+				t.Error("Job isn't suspended after Suspend")
+			}
+		})
+	}
+}
+
+func TestRunWithPodSetsInfo(t *testing.T) {
+	testcases := map[string]struct {
+		job         *kftraining.PyTorchJob
+		podSetsInfo []jobframework.PodSetInfo
+		wantJob     *kftraining.PyTorchJob
+		wantErr     error
+	}{
+		"node selectors are added to the replica types in order": {
+			job: testingpytorchjob.MakePyTorchJob("job", "ns").Obj(),
+			podSetsInfo: []jobframework.PodSetInfo{
+				{Name: "master", NodeSelector: map[string]string{"instance-type": "on-demand"}},
+				{Name: "worker", NodeSelector: map[string]string{"instance-type": "spot"}},
+			},
+			wantJob: testingpytorchjob.MakePyTorchJob("job", "ns").
+				Suspend(false).
+				NodeSelector(kftraining.PyTorchJobReplicaTypeMaster, "instance-type", "on-demand").
+				NodeSelector(kftraining.PyTorchJobReplicaTypeWorker, "instance-type", "spot").
+				Obj(),
+		},
+		"wrong number of podSetsInfo": {
+			job: testingpytorchjob.MakePyTorchJob("job", "ns").Obj(),
+			podSetsInfo: []jobframework.PodSetInfo{
+				{Name: "master", NodeSelector: map[string]string{"instance-type": "on-demand"}},
+			},
+			wantJob: testingpytorchjob.MakePyTorchJob("job", "ns").Suspend(false).Obj(),
+			wantErr: jobframework.ErrInvalidPodsetInfo,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			err := fromObject(tc.job).RunWithPodSetsInfo(tc.podSetsInfo)
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("RunWithPodSetsInfo returned error (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantJob, tc.job); diff != "" {
+				t.Errorf("Unexpected job (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRestorePodSetsInfo(t *testing.T) {
+	testcases := map[string]struct {
+		job         *kftraining.PyTorchJob
+		podSetsInfo []jobframework.PodSetInfo
+		wantJob     *kftraining.PyTorchJob
+		wantChanged bool
+	}{
+		"node selectors are restored in the replica types in order": {
+			job: testingpytorchjob.MakePyTorchJob("job", "ns").
+				NodeSelector(kftraining.PyTorchJobReplicaTypeMaster, "instance-type", "on-demand").
+				NodeSelector(kftraining.PyTorchJobReplicaTypeWorker, "instance-type", "spot").
+				Obj(),
+			podSetsInfo: []jobframework.PodSetInfo{
+				{Name: "master"},
+				{Name: "worker", NodeSelector: map[string]string{"pool": "workers"}},
+			},
+			wantJob: testingpytorchjob.MakePyTorchJob("job", "ns").
+				NodeSelector(kftraining.PyTorchJobReplicaTypeWorker, "pool", "workers").
+				Obj(),
+			wantChanged: true,
+		},
+		"nothing to restore": {
+			job: testingpytorchjob.MakePyTorchJob("job", "ns").
+				NodeSelector(kftraining.PyTorchJobReplicaTypeWorker, "pool", "workers").
+				Obj(),
+			podSetsInfo: []jobframework.PodSetInfo{
+				{Name: "master"},
+				{Name: "worker", NodeSelector: map[string]string{"pool": "workers"}},
+			},
+			wantJob: testingpytorchjob.MakePyTorchJob("job", "ns").
+				NodeSelector(kftraining.PyTorchJobReplicaTypeWorker, "pool", "workers").
+				Obj(),
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			if got := fromObject(tc.job).RestorePodSetsInfo(tc.podSetsInfo); got != tc.wantChanged {
+				t.Errorf("Unexpected changed (want: %v, got: %v)", tc.wantChanged, got)
+			}
+			if diff := cmp.Diff(tc.wantJob, tc.job, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected job (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFinished(t *testing.T) {
+	testcases := map[string]struct {
+		conditions    []kftraining.JobCondition
+		wantCondition metav1.Condition
+		wantFinished  bool
+	}{
+		"running": {
+			conditions: []kftraining.JobCondition{
+				{Type: kftraining.JobRunning, Status: corev1.ConditionTrue},
+			},
+			wantCondition: metav1.Condition{
+				Type:    kueue.WorkloadFinished,
+				Status:  metav1.ConditionTrue,
+				Reason:  "JobFinished",
+				Message: "Job finished successfully",
+			},
+		},
+		"succeeded": {
+			conditions: []kftraining.JobCondition{
+				{Type: kftraining.JobRunning, Status: corev1.ConditionFalse},
+				{Type: kftraining.JobSucceeded, Status: corev1.ConditionTrue},
+			},
+			wantCondition: metav1.Condition{
+				Type:    kueue.WorkloadFinished,
+				Status:  metav1.ConditionTrue,
+				Reason:  "JobFinished",
+				Message: "Job finished successfully",
+			},
+			wantFinished: true,
+		},
+		"failed": {
+			conditions: []kftraining.JobCondition{
+				{Type: kftraining.JobRunning, Status: corev1.ConditionFalse},
+				{Type: kftraining.JobFailed, Status: corev1.ConditionTrue},
+			},
+			wantCondition: metav1.Condition{
+				Type:    kueue.WorkloadFinished,
+				Status:  metav1.ConditionTrue,
+				Reason:  "JobFinished",
+				Message: "Job failed",
+			},
+			wantFinished: true,
+		},
+		"succeeded condition not true": {
+			conditions: []kftraining.JobCondition{
+				{Type: kftraining.JobSucceeded, Status: corev1.ConditionFalse},
+			},
+			wantCondition: metav1.Condition{
+				Type:    kueue.WorkloadFinished,
+				Status:  metav1.ConditionTrue,
+				Reason:  "JobFinished",
+				Message: "Job finished successfully",
+			},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			job := testingpytorchjob.MakePyTorchJob("job", "ns").Obj()
+			job.Status.Conditions = tc.conditions
+			gotCondition, gotFinished := fromObject(job).Finished()
+			if gotFinished != tc.wantFinished {
+				t.Errorf("Unexpected finished (want: %v, got: %v)", tc.wantFinished, gotFinished)
+			}
+			if diff := cmp.Diff(tc.wantCondition, gotCondition); diff != "" {
+				t.Errorf("Unexpected condition (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPodsReady(t *testing.T) {
+	testcases := map[string]struct {
+		conditions []kftraining.JobCondition
+		want       bool
+	}{
+		"no conditions": {},
+		"created": {
+			conditions: []kftraining.JobCondition{
+				{Type: kftraining.JobCreated, Status: corev1.ConditionTrue},
+			},
+		},
+		"running": {
+			conditions: []kftraining.JobCondition{
+				{Type: kftraining.JobCreated, Status: corev1.ConditionTrue},
+				{Type: kftraining.JobRunning, Status: corev1.ConditionTrue},
+			},
+			want: true,
+		},
+		"no longer running": {
+			conditions: []kftraining.JobCondition{
+				{Type: kftraining.JobRunning, Status: corev1.ConditionFalse},
+				{Type: kftraining.JobSucceeded, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			job := testingpytorchjob.MakePyTorchJob("job", "ns").Obj()
+			job.Status.Conditions = tc.conditions
+			if got := fromObject(job).PodsReady(); got != tc.want {
+				t.Errorf("Unexpected PodsReady (want: %v, got: %v)", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestPriorityClass(t *testing.T) {
+	testcases := map[string]struct {
+		job                   *kftraining.PyTorchJob
+		wantPriorityClassName string
+	}{
+		"none priority class name specified": {
+			job: testingpytorchjob.MakePyTorchJob("job", "ns").Obj(),
+		},
+		"priority specified at runPolicy and replicas; use priority in runPolicy": {
+			job: func() *kftraining.PyTorchJob {
+				job := testingpytorchjob.MakePyTorchJob("job", "ns").PriorityClass("scheduling-priority").Obj()
+				job.Spec.PyTorchReplicaSpecs[kftraining.PyTorchJobReplicaTypeMaster].Template.Spec.PriorityClassName = "master-priority"
+				return job
+			}(),
+			wantPriorityClassName: "scheduling-priority",
+		},
+		"specified on master takes precedence over worker": {
+			job: func() *kftraining.PyTorchJob {
+				job := testingpytorchjob.MakePyTorchJob("job", "ns").Obj()
+				job.Spec.PyTorchReplicaSpecs[kftraining.PyTorchJobReplicaTypeMaster].Template.Spec.PriorityClassName = "master-priority"
+				job.Spec.PyTorchReplicaSpecs[kftraining.PyTorchJobReplicaTypeWorker].Template.Spec.PriorityClassName = "worker-priority"
+				return job
+			}(),
+			wantPriorityClassName: "master-priority",
+		},
+		"master present, but without priority; fallback to worker": {
+			job: func() *kftraining.PyTorchJob {
+				job := testingpytorchjob.MakePyTorchJob("job", "ns").Obj()
+				job.Spec.PyTorchReplicaSpecs[kftraining.PyTorchJobReplicaTypeWorker].Template.Spec.PriorityClassName = "worker-priority"
+				return job
+			}(),
+			wantPriorityClassName: "worker-priority",
+		},
+		"master missing; fallback to worker": {
+			job: func() *kftraining.PyTorchJob {
+				job := testingpytorchjob.MakePyTorchJob("job", "ns").Obj()
+				delete(job.Spec.PyTorchReplicaSpecs, kftraining.PyTorchJobReplicaTypeMaster)
+				job.Spec.PyTorchReplicaSpecs[kftraining.PyTorchJobReplicaTypeWorker].Template.Spec.PriorityClassName = "worker-priority"
+				return job
+			}(),
+			wantPriorityClassName: "worker-priority",
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			if got := fromObject(tc.job).PriorityClass(); got != tc.wantPriorityClassName {
+				t.Errorf("Unexpected response (want: %v, got: %v)", tc.wantPriorityClassName, got)
+			}
+		})
+	}
+}
+
+func TestNotifyPreemption(t *testing.T) {
+	deadline := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	job := testingpytorchjob.MakePyTorchJob("job", "ns").UID("job-uid").Obj()
+	otherKindJob := testingpytorchjob.MakePyTorchJob("job", "ns").UID("other-kind-uid").Obj()
+	pod := func(name, jobName string, owner *kftraining.PyTorchJob) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       "ns",
+				Labels:          map[string]string{kftraining.JobNameLabel: jobName},
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(owner, kftraining.SchemeGroupVersion.WithKind(kftraining.PyTorchJobKind))},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+	ctx, _ := utiltesting.ContextWithLog(t)
+	cl := utiltesting.NewClientBuilder().WithObjects(
+		pod("running", "job", job),
+		pod("other-kind", "job", otherKindJob),
+		pod("other", "other", job),
+	).Build()
+
+	if err := fromObject(job).NotifyPreemption(ctx, cl, deadline); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	wantAnnotations := map[string]string{
+		"running":    "2023-10-01T12:00:00Z",
+		"other-kind": "",
+		"other":      "",
+	}
+	for name, want := range wantAnnotations {
+		var got corev1.Pod
+		if err := cl.Get(ctx, client.ObjectKey{Namespace: "ns", Name: name}, &got); err != nil {
+			t.Fatalf("Getting pod %s: %v", name, err)
+		}
+		if got := got.Annotations[constants.PreemptionDeadlineAnnotation]; got != want {
+			t.Errorf("Unexpected annotation in pod %s, want=%q, got=%q", name, want, got)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeflowjob
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+)
+
+// KubeflowJobWebhook is the webhook shared by the jobs of the kubeflow
+// training-operator.
+type KubeflowJobWebhook struct {
+	manageJobsWithoutQueueName bool
+	fromObject                 func(runtime.Object) *KubeflowJob
+}
+
+// SetupWebhook configures the webhook for the kind of obj. The webhook
+// markers are declared in the packages of each kind.
+func SetupWebhook(mgr ctrl.Manager, obj client.Object, fromObject func(runtime.Object) *KubeflowJob, opts ...jobframework.Option) error {
+	wh := NewWebhook(fromObject, opts...)
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(obj).
+		WithDefaulter(wh).
+		WithValidator(wh).
+		Complete()
+}
+
+// NewWebhook returns the webhook for the jobs wrapped by fromObject.
+func NewWebhook(fromObject func(runtime.Object) *KubeflowJob, opts ...jobframework.Option) *KubeflowJobWebhook {
+	options := jobframework.DefaultOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return &KubeflowJobWebhook{
+		manageJobsWithoutQueueName: options.ManageJobsWithoutQueueName,
+		fromObject:                 fromObject,
+	}
+}
+
+var _ webhook.CustomDefaulter = &KubeflowJobWebhook{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the type
+func (w *KubeflowJobWebhook) Default(ctx context.Context, obj runtime.Object) error {
+	job := w.fromObject(obj)
+	log := ctrl.LoggerFrom(ctx).WithName("kubeflow-job-webhook")
+	log.V(5).Info("Applying defaults", "job", klog.KObj(job.Object()))
+
+	jobframework.ApplyDefaultForSuspend(job, w.manageJobsWithoutQueueName)
+	return nil
+}
+
+var _ webhook.CustomValidator = &KubeflowJobWebhook{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type
+func (w *KubeflowJobWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	job := w.fromObject(obj)
+	log := ctrl.LoggerFrom(ctx).WithName("kubeflow-job-webhook")
+	log.Info("Validating create", "job", klog.KObj(job.Object()))
+	return nil, validateCreate(job).ToAggregate()
+}
+
+func validateCreate(job jobframework.GenericJob) field.ErrorList {
+	allErrs := jobframework.ValidateCreateForQueueName(job)
+	allErrs = append(allErrs, jobframework.ValidateCreateForWorkloadPriorityClassName(job)...)
+	allErrs = append(allErrs, jobframework.ValidateWorkloadActive(job)...)
+	allErrs = append(allErrs, jobframework.ValidateMaxExecTime(job)...)
+	allErrs = append(allErrs, jobframework.ValidatePreemptionGracePeriod(job)...)
+	return allErrs
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
+func (w *KubeflowJobWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldJob := w.fromObject(oldObj)
+	newJob := w.fromObject(newObj)
+	log := ctrl.LoggerFrom(ctx).WithName("kubeflow-job-webhook")
+	log.Info("Validating update", "job", klog.KObj(newJob.Object()))
+	allErrs := jobframework.ValidateUpdateForQueueName(oldJob, newJob)
+	allErrs = append(allErrs, jobframework.ValidateUpdateForWorkloadPriorityClassName(oldJob, newJob)...)
+	allErrs = append(allErrs, validateCreate(newJob)...)
+	return nil, allErrs.ToAggregate()
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
+func (w *KubeflowJobWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
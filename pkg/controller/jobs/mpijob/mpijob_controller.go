@@ -19,10 +19,11 @@ package mpijob
 import (
 	"context"
 	"strings"
+	"time"
 
+	common "github.com/kubeflow/common/pkg/apis/common/v1"
 	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -32,7 +33,6 @@ import (
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
-	"sigs.k8s.io/kueue/pkg/util/maps"
 )
 
 var (
@@ -47,13 +47,16 @@ func init() {
 		NewReconciler:          NewReconciler,
 		SetupWebhook:           SetupMPIJobWebhook,
 		JobType:                &kubeflow.MPIJob{},
+		NewJob:                 NewJob,
 		AddToScheme:            kubeflow.AddToScheme,
 		IsManagingObjectsOwner: isMPIJob,
 	}))
 }
 
 // +kubebuilder:rbac:groups=scheduling.k8s.io,resources=priorityclasses,verbs=list;get;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloadpriorityclasses,verbs=list;get;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;watch;update;patch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;patch
 // +kubebuilder:rbac:groups=kubeflow.org,resources=mpijobs,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=kubeflow.org,resources=mpijobs/status,verbs=get;update
 // +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;create;update;patch;delete
@@ -61,7 +64,11 @@ func init() {
 // +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/finalizers,verbs=update
 // +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch
 
-var NewReconciler = jobframework.NewGenericReconciler(func() jobframework.GenericJob { return &MPIJob{} }, nil)
+func NewJob() jobframework.GenericJob {
+	return &MPIJob{}
+}
+
+var NewReconciler = jobframework.NewGenericReconciler(NewJob, nil)
 
 func isMPIJob(owner *metav1.OwnerReference) bool {
 	return owner.Kind == "MPIJob" && strings.HasPrefix(owner.APIVersion, "kubeflow.org/v2")
@@ -71,6 +78,7 @@ type MPIJob kubeflow.MPIJob
 
 var _ jobframework.GenericJob = (*MPIJob)(nil)
 var _ jobframework.JobWithPriorityClass = (*MPIJob)(nil)
+var _ jobframework.JobWithPreemptionNotice = (*MPIJob)(nil)
 
 func (j *MPIJob) Object() client.Object {
 	return (*kubeflow.MPIJob)(j)
@@ -126,9 +134,7 @@ func (j *MPIJob) RunWithPodSetsInfo(podSetInfos []jobframework.PodSetInfo) error
 	// podSets, use the same ordering logic to restore them.
 	for index := range podSetInfos {
 		replicaType := orderedReplicaTypes[index]
-		info := podSetInfos[index]
-		replicaSpec := &j.Spec.MPIReplicaSpecs[replicaType].Template.Spec
-		replicaSpec.NodeSelector = maps.MergeKeepFirst(info.NodeSelector, replicaSpec.NodeSelector)
+		jobframework.MergePodTemplate(&j.Spec.MPIReplicaSpecs[replicaType].Template, podSetInfos[index])
 	}
 	return nil
 }
@@ -138,11 +144,7 @@ func (j *MPIJob) RestorePodSetsInfo(podSetInfos []jobframework.PodSetInfo) bool
 	changed := false
 	for index, info := range podSetInfos {
 		replicaType := orderedReplicaTypes[index]
-		replicaSpec := &j.Spec.MPIReplicaSpecs[replicaType].Template.Spec
-		if !equality.Semantic.DeepEqual(replicaSpec.NodeSelector, info.NodeSelector) {
-			changed = true
-			replicaSpec.NodeSelector = maps.Clone(info.NodeSelector)
-		}
+		changed = jobframework.RestorePodTemplate(&j.Spec.MPIReplicaSpecs[replicaType].Template, info) || changed
 	}
 	return changed
 }
@@ -198,6 +200,15 @@ func (j *MPIJob) PodsReady() bool {
 	return false
 }
 
+// NotifyPreemption sets the preemption deadline annotation in the running
+// pods of the job. The pods can read it through a downwardAPI volume.
+func (j *MPIJob) NotifyPreemption(ctx context.Context, c client.Client, deadline time.Time) error {
+	return jobframework.NotifyPodsPreemption(ctx, c, deadline, client.InNamespace(j.Namespace), client.MatchingLabels{
+		common.OperatorNameLabel: kubeflow.OperatorName,
+		common.JobNameLabel:      j.Name,
+	})
+}
+
 func SetupIndexes(ctx context.Context, indexer client.FieldIndexer) error {
 	return jobframework.SetupWorkloadOwnerIndex(ctx, indexer, gvk)
 }
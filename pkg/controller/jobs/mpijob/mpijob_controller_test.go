@@ -18,6 +18,7 @@ package mpijob
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -30,6 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	testingmpijob "sigs.k8s.io/kueue/pkg/util/testingjobs/mpijob"
@@ -256,3 +258,43 @@ func TestReconciler(t *testing.T) {
 	}
 
 }
+
+func TestNotifyPreemption(t *testing.T) {
+	deadline := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	pod := func(name, owner string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "ns",
+				Labels: map[string]string{
+					common.OperatorNameLabel: kubeflow.OperatorName,
+					common.JobNameLabel:      owner,
+				},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+	ctx, _ := utiltesting.ContextWithLog(t)
+	cl := utiltesting.NewClientBuilder().WithObjects(
+		pod("running", "job"),
+		pod("other", "other"),
+	).Build()
+
+	if err := (*MPIJob)(testingmpijob.MakeMPIJob("job", "ns").Obj()).NotifyPreemption(ctx, cl, deadline); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	wantAnnotations := map[string]string{
+		"running": "2023-10-01T12:00:00Z",
+		"other":   "",
+	}
+	for name, want := range wantAnnotations {
+		var got corev1.Pod
+		if err := cl.Get(ctx, client.ObjectKey{Namespace: "ns", Name: name}, &got); err != nil {
+			t.Fatalf("Getting pod %s: %v", name, err)
+		}
+		if got := got.Annotations[constants.PreemptionDeadlineAnnotation]; got != want {
+			t.Errorf("Unexpected annotation in pod %s, want=%q, got=%q", name, want, got)
+		}
+	}
+}
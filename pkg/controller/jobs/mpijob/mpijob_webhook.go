@@ -77,7 +77,12 @@ func (w *MPIJobWebhook) ValidateCreate(ctx context.Context, obj runtime.Object)
 }
 
 func validateCreate(job jobframework.GenericJob) field.ErrorList {
-	return jobframework.ValidateCreateForQueueName(job)
+	allErrs := jobframework.ValidateCreateForQueueName(job)
+	allErrs = append(allErrs, jobframework.ValidateCreateForWorkloadPriorityClassName(job)...)
+	allErrs = append(allErrs, jobframework.ValidateWorkloadActive(job)...)
+	allErrs = append(allErrs, jobframework.ValidateMaxExecTime(job)...)
+	allErrs = append(allErrs, jobframework.ValidatePreemptionGracePeriod(job)...)
+	return allErrs
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
@@ -87,6 +92,8 @@ func (w *MPIJobWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runti
 	log := ctrl.LoggerFrom(ctx).WithName("job-webhook")
 	log.Info("Validating update", "job", klog.KObj(newJob))
 	allErrs := jobframework.ValidateUpdateForQueueName(oldJob, newJob)
+	allErrs = append(allErrs, jobframework.ValidateUpdateForWorkloadPriorityClassName(oldJob, newJob)...)
+	allErrs = append(allErrs, validateCreate(newJob)...)
 	return nil, allErrs.ToAggregate()
 }
 
@@ -22,7 +22,9 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	"sigs.k8s.io/kueue/pkg/controller/constants"
 	testingutil "sigs.k8s.io/kueue/pkg/util/testingjobs/mpijob"
 )
 
@@ -54,3 +56,53 @@ func TestDefault(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateUpdate(t *testing.T) {
+	testcases := map[string]struct {
+		oldJob  *kubeflow.MPIJob
+		newJob  *kubeflow.MPIJob
+		wantErr error
+	}{
+		"deactivate the job": {
+			oldJob: testingutil.MakeMPIJob("job", "default").Queue("queue").Obj(),
+			newJob: testingutil.MakeMPIJob("job", "default").Queue("queue").Annotation(constants.WorkloadActiveAnnotation, "false").Obj(),
+		},
+		"invalid workload active annotation": {
+			oldJob: testingutil.MakeMPIJob("job", "default").Queue("queue").Obj(),
+			newJob: testingutil.MakeMPIJob("job", "default").Queue("queue").Annotation(constants.WorkloadActiveAnnotation, "no").Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("metadata", "annotations").Key(constants.WorkloadActiveAnnotation), "no", "must be true or false"),
+			}.ToAggregate(),
+		},
+		"set the max exec time label": {
+			oldJob: testingutil.MakeMPIJob("job", "default").Queue("queue").Obj(),
+			newJob: testingutil.MakeMPIJob("job", "default").Queue("queue").Label(constants.MaxExecTimeSecondsLabel, "3600").Obj(),
+		},
+		"invalid max exec time label": {
+			oldJob: testingutil.MakeMPIJob("job", "default").Queue("queue").Obj(),
+			newJob: testingutil.MakeMPIJob("job", "default").Queue("queue").Label(constants.MaxExecTimeSecondsLabel, "0").Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("metadata", "labels").Key(constants.MaxExecTimeSecondsLabel), "0", "must be a positive integer"),
+			}.ToAggregate(),
+		},
+		"set the preemption grace period label": {
+			oldJob: testingutil.MakeMPIJob("job", "default").Queue("queue").Obj(),
+			newJob: testingutil.MakeMPIJob("job", "default").Queue("queue").Label(constants.PreemptionGracePeriodSecondsLabel, "60").Obj(),
+		},
+		"invalid preemption grace period label": {
+			oldJob: testingutil.MakeMPIJob("job", "default").Queue("queue").Obj(),
+			newJob: testingutil.MakeMPIJob("job", "default").Queue("queue").Label(constants.PreemptionGracePeriodSecondsLabel, "-1").Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("metadata", "labels").Key(constants.PreemptionGracePeriodSecondsLabel), "-1", "must be a non-negative integer"),
+			}.ToAggregate(),
+		},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			_, gotErr := new(MPIJobWebhook).ValidateUpdate(context.Background(), tc.oldJob, tc.newJob)
+			if diff := cmp.Diff(tc.wantErr, gotErr); diff != "" {
+				t.Errorf("ValidateUpdate() mismatch (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+)
+
+const (
+	// SchedulingGateName is the scheduling gate added by the webhook to the
+	// managed pods. It is removed when the workload of the pod is admitted.
+	SchedulingGateName = "kueue.x-k8s.io/admission"
+
+	FrameworkName = "pod"
+)
+
+var (
+	gvk = corev1.SchemeGroupVersion.WithKind("Pod")
+)
+
+func init() {
+	utilruntime.Must(jobframework.RegisterIntegration(FrameworkName, jobframework.IntegrationCallbacks{
+		SetupIndexes:  SetupIndexes,
+		NewReconciler: NewReconciler,
+		SetupWebhook:  SetupWebhook,
+		JobType:       &corev1.Pod{},
+		NewJob:        NewJob,
+	}))
+}
+
+//+kubebuilder:rbac:groups=scheduling.k8s.io,resources=priorityclasses,verbs=list;get;watch
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloadpriorityclasses,verbs=list;get;watch
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;watch;update;patch
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=pods/status,verbs=get
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/finalizers,verbs=update
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch
+
+func NewJob() jobframework.GenericJob {
+	return &Pod{}
+}
+
+// Reconciler reconciles the pods labeled by the webhook as managed by Kueue.
+type Reconciler struct {
+	*jobframework.JobReconciler
+}
+
+func NewReconciler(c client.Client, record record.EventRecorder, opts ...jobframework.Option) jobframework.JobReconcilerInterface {
+	return &Reconciler{
+		JobReconciler: jobframework.NewReconciler(c, record, opts...),
+	}
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if isGroupRequest(req) {
+		return r.ReconcileGenericJob(ctx, req, &Group{})
+	}
+	return r.ReconcileGenericJob(ctx, req, &Pod{})
+}
+
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Only the pods gated by the webhook are reconciled. Any other pod
+	// would be seen as a running job without a workload, and deleted.
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("pod").
+		Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(podRequests),
+			builder.WithPredicates(predicate.NewPredicateFuncs(isManagedByKueue))).
+		Watches(&kueue.Workload{}, handler.EnqueueRequestsFromMapFunc(workloadRequests)).
+		Complete(r)
+}
+
+// podRequests maps the pods of a group to the request of the group, and any
+// other pod to its own request.
+func podRequests(_ context.Context, obj client.Object) []reconcile.Request {
+	if groupName := obj.GetLabels()[GroupNameLabel]; groupName != "" {
+		return []reconcile.Request{groupRequest(obj.GetNamespace(), groupName)}
+	}
+	return []reconcile.Request{{NamespacedName: client.ObjectKeyFromObject(obj)}}
+}
+
+// workloadRequests maps the workloads of the groups to the request of the
+// group, and the workloads of single pods to the request of their owner.
+func workloadRequests(_ context.Context, obj client.Object) []reconcile.Request {
+	if obj.GetAnnotations()[IsGroupWorkloadAnnotation] == "true" {
+		return []reconcile.Request{groupRequest(obj.GetNamespace(), obj.GetLabels()[GroupNameLabel])}
+	}
+	owner := metav1.GetControllerOf(obj)
+	if owner == nil || owner.Kind != gvk.Kind || owner.APIVersion != gvk.GroupVersion().String() {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: owner.Name}}}
+}
+
+func isManagedByKueue(obj client.Object) bool {
+	return obj.GetLabels()[constants.ManagedByKueueLabel] == "true"
+}
+
+type Pod corev1.Pod
+
+var _ jobframework.GenericJob = (*Pod)(nil)
+var _ jobframework.JobWithCustomStop = (*Pod)(nil)
+var _ jobframework.JobWithPreemptionNotice = (*Pod)(nil)
+
+func fromObject(obj runtime.Object) *Pod {
+	return (*Pod)(obj.(*corev1.Pod))
+}
+
+func (p *Pod) Object() client.Object {
+	return (*corev1.Pod)(p)
+}
+
+func gateIndex(p *Pod) int {
+	for i := range p.Spec.SchedulingGates {
+		if p.Spec.SchedulingGates[i].Name == SchedulingGateName {
+			return i
+		}
+	}
+	return -1
+}
+
+// IsSuspended returns whether the pod still has the Kueue scheduling gate.
+func (p *Pod) IsSuspended() bool {
+	return gateIndex(p) != -1
+}
+
+// Suspend is a no-op, the scheduling gates can't be added back to a pod.
+// The pods are stopped by deleting them.
+func (p *Pod) Suspend() {
+}
+
+func (p *Pod) IsActive() bool {
+	return p.Status.Phase == corev1.PodRunning
+}
+
+func (p *Pod) GetGVK() schema.GroupVersionKind {
+	return gvk
+}
+
+func (p *Pod) PodSets() []kueue.PodSet {
+	template := corev1.PodTemplateSpec{
+		Spec: *p.Spec.DeepCopy(),
+	}
+	if idx := gateIndex(p); idx != -1 {
+		gates := template.Spec.SchedulingGates
+		template.Spec.SchedulingGates = append(gates[:idx:idx], gates[idx+1:]...)
+	}
+	return []kueue.PodSet{
+		{
+			Name:     kueue.DefaultPodSetName,
+			Count:    1,
+			Template: template,
+		},
+	}
+}
+
+// RunWithPodSetsInfo removes the scheduling gate and injects the node
+// selector, tolerations and labels of the admission into the pod.
+func (p *Pod) RunWithPodSetsInfo(podSetsInfo []jobframework.PodSetInfo) error {
+	if len(podSetsInfo) != 1 {
+		return jobframework.BadPodSetsInfoLenError(1, len(podSetsInfo))
+	}
+	if idx := gateIndex(p); idx != -1 {
+		p.Spec.SchedulingGates = append(p.Spec.SchedulingGates[:idx], p.Spec.SchedulingGates[idx+1:]...)
+	}
+	template := corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      p.Labels,
+			Annotations: p.Annotations,
+		},
+		Spec: p.Spec,
+	}
+	jobframework.MergePodTemplate(&template, podSetsInfo[0])
+	p.Labels = template.Labels
+	p.Annotations = template.Annotations
+	p.Spec = template.Spec
+	return nil
+}
+
+// RestorePodSetsInfo is a no-op, the pods are deleted when stopped.
+func (p *Pod) RestorePodSetsInfo(_ []jobframework.PodSetInfo) bool {
+	return false
+}
+
+func (p *Pod) Finished() (metav1.Condition, bool) {
+	condition := metav1.Condition{
+		Type:   kueue.WorkloadFinished,
+		Status: metav1.ConditionTrue,
+		Reason: "PodFinished",
+	}
+	switch p.Status.Phase {
+	case corev1.PodSucceeded:
+		condition.Message = "Pod succeeded"
+		return condition, true
+	case corev1.PodFailed:
+		condition.Message = "Pod failed"
+		return condition, true
+	}
+	return metav1.Condition{}, false
+}
+
+func (p *Pod) PodsReady() bool {
+	for i := range p.Status.Conditions {
+		c := &p.Status.Conditions[i]
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// Stop deletes the pod, unless it already finished or is being deleted.
+func (p *Pod) Stop(ctx context.Context, c client.Client, _ []jobframework.PodSetInfo) (bool, error) {
+	if _, finished := p.Finished(); finished || !p.DeletionTimestamp.IsZero() {
+		return false, nil
+	}
+	if err := c.Delete(ctx, p.Object()); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	return true, nil
+}
+
+// NotifyPreemption sets the preemption deadline annotation in the pod. The
+// pod can read it through a downwardAPI volume.
+func (p *Pod) NotifyPreemption(ctx context.Context, c client.Client, deadline time.Time) error {
+	return jobframework.NotifyPodPreemption(ctx, c, (*corev1.Pod)(p), deadline)
+}
+
+func SetupIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	return jobframework.SetupWorkloadOwnerIndex(ctx, indexer, gvk)
+}
+
+func GetWorkloadNameForPod(podName string) string {
+	return jobframework.GetWorkloadNameForOwnerWithGVK(podName, gvk)
+}
@@ -0,0 +1,546 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingpod "sigs.k8s.io/kueue/pkg/util/testingjobs/pod"
+)
+
+func TestPodsReady(t *testing.T) {
+	testcases := map[string]struct {
+		pod  *corev1.Pod
+		want bool
+	}{
+		"pod is ready": {
+			pod: testingpod.MakePod("pod", "ns").
+				StatusConditions(corev1.PodCondition{Type: corev1.PodReady, Status: corev1.ConditionTrue}).
+				Obj(),
+			want: true,
+		},
+		"pod is not ready": {
+			pod: testingpod.MakePod("pod", "ns").
+				StatusConditions(corev1.PodCondition{Type: corev1.PodReady, Status: corev1.ConditionFalse}).
+				Obj(),
+		},
+		"pod without conditions": {
+			pod: testingpod.MakePod("pod", "ns").Obj(),
+		},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			if got := fromObject(tc.pod).PodsReady(); got != tc.want {
+				t.Errorf("Unexpected response (want: %v, got: %v)", tc.want, got)
+			}
+		})
+	}
+}
+
+var (
+	podCmpOpts = []cmp.Option{
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(corev1.Pod{}, "TypeMeta"),
+		cmpopts.IgnoreFields(metav1.ObjectMeta{}, "ResourceVersion"),
+	}
+	workloadCmpOpts = []cmp.Option{
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(kueue.Workload{}, "TypeMeta", "ObjectMeta"),
+		cmpopts.IgnoreFields(kueue.WorkloadSpec{}, "Priority"),
+		cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime"),
+		cmpopts.IgnoreFields(kueue.PodSet{}, "Template"),
+	}
+)
+
+func TestReconciler(t *testing.T) {
+	basePod := testingpod.MakePod("pod", "ns").
+		Queue("user-queue").
+		Label(constants.ManagedByKueueLabel, "true").
+		Request(corev1.ResourceCPU, "1")
+	baseWorkload := utiltesting.MakeWorkload("wl", "ns").
+		Queue("user-queue").
+		PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 1).Containers(basePod.Spec.Containers...).Obj())
+	admission := utiltesting.MakeAdmission("cq").Assignment(corev1.ResourceCPU, "on-demand", "1").Obj()
+
+	cases := map[string]struct {
+		pod           *corev1.Pod
+		workloads     []kueue.Workload
+		wantPod       *corev1.Pod
+		wantWorkloads []kueue.Workload
+		wantErr       error
+	}{
+		"workload is created for the gated pod": {
+			pod:     basePod.Clone().KueueSchedulingGate().Obj(),
+			wantPod: basePod.Clone().KueueSchedulingGate().Obj(),
+			wantWorkloads: []kueue.Workload{
+				*baseWorkload.Clone().Obj(),
+			},
+		},
+		"pod is ungated when the workload is admitted": {
+			pod: basePod.Clone().KueueSchedulingGate().Gate("other-gate").Obj(),
+			workloads: []kueue.Workload{
+				*baseWorkload.Clone().Admit(admission).Obj(),
+			},
+			wantPod: basePod.Clone().
+				Gate("other-gate").
+				NodeSelector("instance-type", "on-demand").
+				Obj(),
+			wantWorkloads: []kueue.Workload{
+				*baseWorkload.Clone().Admit(admission).Obj(),
+			},
+		},
+		"pod is deleted when the workload is evicted": {
+			pod: basePod.Clone().
+				NodeSelector("instance-type", "on-demand").
+				Phase(corev1.PodRunning).
+				Obj(),
+			workloads: []kueue.Workload{
+				*baseWorkload.Clone().
+					Admit(admission).
+					Condition(metav1.Condition{
+						Type:   kueue.WorkloadEvicted,
+						Status: metav1.ConditionTrue,
+						Reason: kueue.WorkloadEvictedByPreemption,
+					}).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*baseWorkload.Clone().
+					Admit(admission).
+					Condition(metav1.Condition{
+						Type:   kueue.WorkloadEvicted,
+						Status: metav1.ConditionTrue,
+						Reason: kueue.WorkloadEvictedByPreemption,
+					}).
+					Obj(),
+			},
+		},
+		"ungated pod without a workload is deleted": {
+			pod: basePod.Clone().Phase(corev1.PodRunning).Obj(),
+		},
+		"workload is finished when the pod succeeds": {
+			pod: basePod.Clone().
+				NodeSelector("instance-type", "on-demand").
+				Phase(corev1.PodSucceeded).
+				Obj(),
+			workloads: []kueue.Workload{
+				*baseWorkload.Clone().Admit(admission).Obj(),
+			},
+			wantPod: basePod.Clone().
+				NodeSelector("instance-type", "on-demand").
+				Phase(corev1.PodSucceeded).
+				Obj(),
+			wantWorkloads: []kueue.Workload{
+				func() kueue.Workload {
+					wl := baseWorkload.Clone().Admit(admission).Obj()
+					// The status is applied, which the fake client implements by
+					// replacing the conditions.
+					wl.Status.Conditions = []metav1.Condition{{
+						Type:    kueue.WorkloadFinished,
+						Status:  metav1.ConditionTrue,
+						Reason:  "PodFinished",
+						Message: "Pod succeeded",
+					}}
+					return *wl
+				}(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			clientBuilder := utiltesting.NewClientBuilder()
+			if err := SetupIndexes(ctx, utiltesting.AsIndexer(clientBuilder)); err != nil {
+				t.Fatalf("Could not setup indexes: %v", err)
+			}
+			kcBuilder := clientBuilder.
+				WithObjects(tc.pod).
+				WithObjects(utiltesting.MakeResourceFlavor("on-demand").Label("instance-type", "on-demand").Obj())
+			for i := range tc.workloads {
+				kcBuilder = kcBuilder.WithStatusSubresource(&tc.workloads[i])
+			}
+			kClient := kcBuilder.Build()
+			for i := range tc.workloads {
+				if err := ctrl.SetControllerReference(tc.pod, &tc.workloads[i], kClient.Scheme()); err != nil {
+					t.Fatalf("Could not setup owner reference in Workloads: %v", err)
+				}
+				if err := kClient.Create(ctx, &tc.workloads[i]); err != nil {
+					t.Fatalf("Could not create workload: %v", err)
+				}
+			}
+			recorder := record.NewBroadcaster().NewRecorder(kClient.Scheme(), corev1.EventSource{Component: "test"})
+			reconciler := NewReconciler(kClient, recorder)
+
+			podKey := client.ObjectKeyFromObject(tc.pod)
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: podKey,
+			})
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Reconcile returned error (-want,+got):\n%s", diff)
+			}
+
+			var gotPod corev1.Pod
+			err = kClient.Get(ctx, podKey, &gotPod)
+			if tc.wantPod == nil {
+				if !apierrors.IsNotFound(err) {
+					t.Errorf("Expected the pod to be deleted, got error: %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("Could not get Pod after reconcile: %v", err)
+			} else if diff := cmp.Diff(tc.wantPod, &gotPod, podCmpOpts...); diff != "" {
+				t.Errorf("Pod after reconcile (-want,+got):\n%s", diff)
+			}
+			var gotWorkloads kueue.WorkloadList
+			if err := kClient.List(ctx, &gotWorkloads); err != nil {
+				t.Fatalf("Could not get Workloads after reconcile: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantWorkloads, gotWorkloads.Items, workloadCmpOpts...); diff != "" {
+				t.Errorf("Workloads after reconcile (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReconcilerGroup(t *testing.T) {
+	groupPod := testingpod.MakePod("", "ns").
+		Queue("user-queue").
+		Label(constants.ManagedByKueueLabel, "true").
+		Group("group", "2").
+		Request(corev1.ResourceCPU, "1")
+	groupPod.Annotation(RoleHashAnnotation, roleHash(groupPod.Obj()))
+	bigGroupPod := groupPod.Clone().Request(corev1.ResourceCPU, "2")
+	bigGroupPod.Annotation(RoleHashAnnotation, roleHash(bigGroupPod.Obj()))
+	smallRole := groupPod.Annotations[RoleHashAnnotation]
+	bigRole := bigGroupPod.Annotations[RoleHashAnnotation]
+
+	makePod := func(base *testingpod.PodWrapper, name string) *testingpod.PodWrapper {
+		p := base.Clone().UID(name)
+		p.Name = name
+		return p
+	}
+	ownerRefs := func(names ...string) []metav1.OwnerReference {
+		refs := make([]metav1.OwnerReference, len(names))
+		for i, name := range names {
+			refs[i] = metav1.OwnerReference{APIVersion: "v1", Kind: "Pod", Name: name, UID: types.UID(name)}
+		}
+		return refs
+	}
+	groupWorkload := func(podSets ...kueue.PodSet) *utiltesting.WorkloadWrapper {
+		return utiltesting.MakeWorkload(groupWorkloadName("group"), "ns").
+			Queue("user-queue").
+			Labels(map[string]string{GroupNameLabel: "group"}).
+			Annotation(IsGroupWorkloadAnnotation, "true").
+			PodSets(podSets...)
+	}
+	smallPodSet := *utiltesting.MakePodSet(smallRole, 2).Containers(groupPod.Spec.Containers...).Obj()
+	admission := utiltesting.MakeAdmission("cq", smallRole).Assignment(corev1.ResourceCPU, "on-demand", "2").AssignmentPodCount(2).Obj()
+	evictedCondition := metav1.Condition{
+		Type:   kueue.WorkloadEvicted,
+		Status: metav1.ConditionTrue,
+		Reason: kueue.WorkloadEvictedByPreemption,
+	}
+	groupCmpOpts := []cmp.Option{
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(kueue.Workload{}, "TypeMeta"),
+		cmpopts.IgnoreFields(metav1.ObjectMeta{}, "ResourceVersion", "Labels"),
+		cmpopts.IgnoreFields(kueue.WorkloadSpec{}, "Priority"),
+		cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime"),
+		cmpopts.IgnoreFields(kueue.PodSet{}, "Template"),
+	}
+
+	cases := map[string]struct {
+		pods          []corev1.Pod
+		workloads     []kueue.Workload
+		wantPods      []corev1.Pod
+		wantWorkloads []kueue.Workload
+		wantErr       error
+		// wantAlreadyExists indicates that creating the workload of the group
+		// fails because of another workload with its name.
+		wantAlreadyExists bool
+	}{
+		"workload isn't created until all the pods exist": {
+			pods: []corev1.Pod{
+				*makePod(groupPod, "pod-a").KueueSchedulingGate().Obj(),
+			},
+			wantPods: []corev1.Pod{
+				*makePod(groupPod, "pod-a").KueueSchedulingGate().Obj(),
+			},
+		},
+		"workload is created with a PodSet per role": {
+			pods: []corev1.Pod{
+				*makePod(groupPod, "pod-a").KueueSchedulingGate().Obj(),
+				*makePod(bigGroupPod, "pod-b").KueueSchedulingGate().Obj(),
+			},
+			wantPods: []corev1.Pod{
+				*makePod(groupPod, "pod-a").KueueSchedulingGate().Obj(),
+				*makePod(bigGroupPod, "pod-b").KueueSchedulingGate().Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				func() kueue.Workload {
+					wl := groupWorkload(
+						*utiltesting.MakePodSet(smallRole, 1).Containers(groupPod.Spec.Containers...).Obj(),
+						*utiltesting.MakePodSet(bigRole, 1).Containers(bigGroupPod.Spec.Containers...).Obj(),
+					).Obj()
+					wl.OwnerReferences = ownerRefs("pod-a", "pod-b")
+					return *wl
+				}(),
+			},
+		},
+		"all the pods are ungated when the workload is admitted": {
+			pods: []corev1.Pod{
+				*makePod(groupPod, "pod-a").KueueSchedulingGate().Obj(),
+				*makePod(groupPod, "pod-b").KueueSchedulingGate().Obj(),
+			},
+			workloads: []kueue.Workload{
+				*groupWorkload(smallPodSet).Admit(admission).Obj(),
+			},
+			wantPods: []corev1.Pod{
+				*makePod(groupPod, "pod-a").NodeSelector("instance-type", "on-demand").Obj(),
+				*makePod(groupPod, "pod-b").NodeSelector("instance-type", "on-demand").Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				func() kueue.Workload {
+					wl := groupWorkload(smallPodSet).Admit(admission).Obj()
+					wl.OwnerReferences = ownerRefs("pod-a", "pod-b")
+					return *wl
+				}(),
+			},
+		},
+		"all the pods are deleted when the workload is evicted": {
+			pods: []corev1.Pod{
+				*makePod(groupPod, "pod-a").NodeSelector("instance-type", "on-demand").Phase(corev1.PodRunning).Obj(),
+				*makePod(groupPod, "pod-b").NodeSelector("instance-type", "on-demand").Phase(corev1.PodRunning).Obj(),
+			},
+			workloads: []kueue.Workload{
+				*groupWorkload(smallPodSet).Admit(admission).Condition(evictedCondition).Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				func() kueue.Workload {
+					wl := groupWorkload(smallPodSet).Admit(admission).Condition(evictedCondition).Obj()
+					wl.OwnerReferences = ownerRefs("pod-a", "pod-b")
+					return *wl
+				}(),
+			},
+		},
+		"workload of a previous group is deleted": {
+			pods: []corev1.Pod{
+				*makePod(groupPod, "pod-a").KueueSchedulingGate().Obj(),
+				*makePod(groupPod, "pod-b").KueueSchedulingGate().Obj(),
+			},
+			workloads: []kueue.Workload{
+				*groupWorkload(smallPodSet).Obj(),
+			},
+			wantPods: []corev1.Pod{
+				*makePod(groupPod, "pod-a").KueueSchedulingGate().Obj(),
+				*makePod(groupPod, "pod-b").KueueSchedulingGate().Obj(),
+			},
+			wantErr: jobframework.ErrNoMatchingWorkloads,
+		},
+		"workload of a single pod named as the group doesn't clash": {
+			pods: []corev1.Pod{
+				*makePod(groupPod, "pod-a").KueueSchedulingGate().Obj(),
+				*makePod(groupPod, "pod-b").KueueSchedulingGate().Obj(),
+			},
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload(GetWorkloadNameForPod("group"), "ns").Queue("user-queue").PodSets(smallPodSet).Obj(),
+			},
+			wantPods: []corev1.Pod{
+				*makePod(groupPod, "pod-a").KueueSchedulingGate().Obj(),
+				*makePod(groupPod, "pod-b").KueueSchedulingGate().Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload(GetWorkloadNameForPod("group"), "ns").Queue("user-queue").PodSets(smallPodSet).Obj(),
+				func() kueue.Workload {
+					wl := groupWorkload(smallPodSet).Obj()
+					wl.OwnerReferences = ownerRefs("pod-a", "pod-b")
+					return *wl
+				}(),
+			},
+		},
+		"workload with the name of the group workload that isn't a group workload is kept": {
+			pods: []corev1.Pod{
+				*makePod(groupPod, "pod-a").KueueSchedulingGate().Obj(),
+				*makePod(groupPod, "pod-b").KueueSchedulingGate().Obj(),
+			},
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload(groupWorkloadName("group"), "ns").Queue("user-queue").PodSets(smallPodSet).Obj(),
+			},
+			wantPods: []corev1.Pod{
+				*makePod(groupPod, "pod-a").KueueSchedulingGate().Obj(),
+				*makePod(groupPod, "pod-b").KueueSchedulingGate().Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload(groupWorkloadName("group"), "ns").Queue("user-queue").PodSets(smallPodSet).Obj(),
+			},
+			wantAlreadyExists: true,
+		},
+		"replacement pods are added to the owners of the workload": {
+			pods: []corev1.Pod{
+				*makePod(groupPod, "pod-a").NodeSelector("instance-type", "on-demand").Phase(corev1.PodRunning).Obj(),
+				*makePod(groupPod, "pod-b").NodeSelector("instance-type", "on-demand").Phase(corev1.PodFailed).Obj(),
+				*makePod(groupPod, "pod-c").KueueSchedulingGate().Obj(),
+			},
+			workloads: []kueue.Workload{
+				*groupWorkload(smallPodSet).Admit(admission).Obj(),
+			},
+			wantPods: []corev1.Pod{
+				*makePod(groupPod, "pod-a").NodeSelector("instance-type", "on-demand").Phase(corev1.PodRunning).Obj(),
+				*makePod(groupPod, "pod-b").NodeSelector("instance-type", "on-demand").Phase(corev1.PodFailed).Obj(),
+				*makePod(groupPod, "pod-c").NodeSelector("instance-type", "on-demand").Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				func() kueue.Workload {
+					wl := groupWorkload(smallPodSet).Admit(admission).Obj()
+					wl.OwnerReferences = ownerRefs("pod-a", "pod-b", "pod-c")
+					return *wl
+				}(),
+			},
+		},
+		"workload is finished when all the pods finish": {
+			pods: []corev1.Pod{
+				*makePod(groupPod, "pod-a").NodeSelector("instance-type", "on-demand").Phase(corev1.PodSucceeded).Obj(),
+				*makePod(groupPod, "pod-b").NodeSelector("instance-type", "on-demand").Phase(corev1.PodFailed).Obj(),
+			},
+			workloads: []kueue.Workload{
+				*groupWorkload(smallPodSet).Admit(admission).Obj(),
+			},
+			wantPods: []corev1.Pod{
+				*makePod(groupPod, "pod-a").NodeSelector("instance-type", "on-demand").Phase(corev1.PodSucceeded).Obj(),
+				*makePod(groupPod, "pod-b").NodeSelector("instance-type", "on-demand").Phase(corev1.PodFailed).Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				func() kueue.Workload {
+					wl := groupWorkload(smallPodSet).Admit(admission).Obj()
+					wl.OwnerReferences = ownerRefs("pod-a", "pod-b")
+					// The status is applied, which the fake client implements by
+					// replacing the conditions.
+					wl.Status.Conditions = []metav1.Condition{{
+						Type:    kueue.WorkloadFinished,
+						Status:  metav1.ConditionTrue,
+						Reason:  "PodsFinished",
+						Message: "Pods succeeded: 1/2",
+					}}
+					return *wl
+				}(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			clientBuilder := utiltesting.NewClientBuilder()
+			if err := SetupIndexes(ctx, utiltesting.AsIndexer(clientBuilder)); err != nil {
+				t.Fatalf("Could not setup indexes: %v", err)
+			}
+			kcBuilder := clientBuilder.
+				WithObjects(utiltesting.MakeResourceFlavor("on-demand").Label("instance-type", "on-demand").Obj())
+			for i := range tc.pods {
+				kcBuilder = kcBuilder.WithObjects(&tc.pods[i])
+			}
+			for i := range tc.workloads {
+				kcBuilder = kcBuilder.WithStatusSubresource(&tc.workloads[i])
+			}
+			kClient := kcBuilder.Build()
+			for i := range tc.workloads {
+				// The workloads without owners belong to a previous group.
+				if tc.workloads[i].Status.Admission != nil {
+					tc.workloads[i].OwnerReferences = ownerRefs("pod-a", "pod-b")
+				}
+				if err := kClient.Create(ctx, &tc.workloads[i]); err != nil {
+					t.Fatalf("Could not create workload: %v", err)
+				}
+			}
+			recorder := record.NewBroadcaster().NewRecorder(kClient.Scheme(), corev1.EventSource{Component: "test"})
+			reconciler := NewReconciler(kClient, recorder)
+
+			_, err := reconciler.Reconcile(ctx, groupRequest("ns", "group"))
+			if tc.wantAlreadyExists {
+				if !apierrors.IsAlreadyExists(err) {
+					t.Errorf("Reconcile returned error %v, want an already exists error", err)
+				}
+			} else if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Reconcile returned error (-want,+got):\n%s", diff)
+			}
+
+			var gotPods corev1.PodList
+			if err := kClient.List(ctx, &gotPods); err != nil {
+				t.Fatalf("Could not get Pods after reconcile: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantPods, gotPods.Items, podCmpOpts...); diff != "" {
+				t.Errorf("Pods after reconcile (-want,+got):\n%s", diff)
+			}
+			var gotWorkloads kueue.WorkloadList
+			if err := kClient.List(ctx, &gotWorkloads); err != nil {
+				t.Fatalf("Could not get Workloads after reconcile: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantWorkloads, gotWorkloads.Items, groupCmpOpts...); diff != "" {
+				t.Errorf("Workloads after reconcile (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNotifyPreemption(t *testing.T) {
+	deadline := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	single := testingpod.MakePod("single", "ns").Obj()
+	single.Status.Phase = corev1.PodRunning
+	running := testingpod.MakePod("running", "ns").Group("group", "2").Obj()
+	running.Status.Phase = corev1.PodRunning
+	failed := testingpod.MakePod("failed", "ns").Group("group", "2").Obj()
+	failed.Status.Phase = corev1.PodFailed
+	ctx, _ := utiltesting.ContextWithLog(t)
+	cl := utiltesting.NewClientBuilder().WithObjects(single, running, failed).Build()
+
+	if err := (*Pod)(single.DeepCopy()).NotifyPreemption(ctx, cl, deadline); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	group := &Group{name: "group", totalCount: 2, pods: []corev1.Pod{*running.DeepCopy(), *failed.DeepCopy()}}
+	if err := group.NotifyPreemption(ctx, cl, deadline); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	wantAnnotations := map[string]string{
+		"single":  "2023-10-01T12:00:00Z",
+		"running": "2023-10-01T12:00:00Z",
+		"failed":  "",
+	}
+	for name, want := range wantAnnotations {
+		var got corev1.Pod
+		if err := cl.Get(ctx, client.ObjectKey{Namespace: "ns", Name: name}, &got); err != nil {
+			t.Fatalf("Getting pod %s: %v", name, err)
+		}
+		if got := got.Annotations[constants.PreemptionDeadlineAnnotation]; got != want {
+			t.Errorf("Unexpected annotation in pod %s, want=%q, got=%q", name, want, got)
+		}
+	}
+}
@@ -0,0 +1,456 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+)
+
+const (
+	// GroupNameLabel is the label of the pods that are admitted together,
+	// through a single workload named after the group.
+	GroupNameLabel = "kueue.x-k8s.io/pod-group-name"
+	// GroupTotalCountAnnotation is the number of pods in the group. The
+	// workload of the group is created once all the pods exist.
+	GroupTotalCountAnnotation = "kueue.x-k8s.io/pod-group-total-count"
+	// RoleHashAnnotation is set by the webhook on the pods of a group to the
+	// hash of their shape. The pods with the same hash form a PodSet.
+	RoleHashAnnotation = "kueue.x-k8s.io/role-hash"
+	// IsGroupWorkloadAnnotation marks the workloads of the pod groups.
+	IsGroupWorkloadAnnotation = "kueue.x-k8s.io/is-group-workload"
+
+	// groupRequestPrefix is prepended to the group name in the reconcile
+	// requests of the groups. Pod names can't contain a slash.
+	groupRequestPrefix = "group/"
+)
+
+// groupWorkloadGVK is used to derive the names of the workloads of the
+// groups, so that they can't clash with the workloads of single pods.
+var groupWorkloadGVK = gvk.GroupVersion().WithKind("PodGroup")
+
+// groupWorkloadName returns the name of the workload of the group.
+func groupWorkloadName(groupName string) string {
+	return jobframework.GetWorkloadNameForOwnerWithGVK(groupName, groupWorkloadGVK)
+}
+
+// Group is the set of managed pods sharing the same group name label.
+type Group struct {
+	name       string
+	totalCount int
+	pods       []corev1.Pod
+}
+
+var _ jobframework.GenericJob = (*Group)(nil)
+var _ jobframework.ComposableJob = (*Group)(nil)
+var _ jobframework.JobWithCustomStop = (*Group)(nil)
+var _ jobframework.JobWithPreemptionNotice = (*Group)(nil)
+
+func groupRequest(namespace, groupName string) reconcile.Request {
+	return reconcile.Request{NamespacedName: types.NamespacedName{
+		Namespace: namespace,
+		Name:      groupRequestPrefix + groupName,
+	}}
+}
+
+func isGroupRequest(req reconcile.Request) bool {
+	return strings.HasPrefix(req.Name, groupRequestPrefix)
+}
+
+func groupTotalCount(p *corev1.Pod) (int, error) {
+	value := p.Annotations[GroupTotalCountAnnotation]
+	count, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("parsing the %s annotation: %w", GroupTotalCountAnnotation, err)
+	}
+	if count < 1 {
+		return 0, fmt.Errorf("the %s annotation must be a positive integer, got %q", GroupTotalCountAnnotation, value)
+	}
+	return count, nil
+}
+
+// Load lists the managed pods of the group.
+func (g *Group) Load(ctx context.Context, c client.Client, key types.NamespacedName) (bool, error) {
+	g.name = strings.TrimPrefix(key.Name, groupRequestPrefix)
+	var pods corev1.PodList
+	if err := c.List(ctx, &pods, client.InNamespace(key.Namespace), client.MatchingLabels{
+		GroupNameLabel:                g.name,
+		constants.ManagedByKueueLabel: "true",
+	}); err != nil {
+		return false, err
+	}
+	if len(pods.Items) == 0 {
+		return false, nil
+	}
+	sort.Slice(pods.Items, func(i, j int) bool {
+		return pods.Items[i].Name < pods.Items[j].Name
+	})
+	g.pods = pods.Items
+	count, err := groupTotalCount(&g.pods[0])
+	if err != nil {
+		return false, err
+	}
+	g.totalCount = count
+	return true, nil
+}
+
+// Object returns the first pod of the group, which carries the queue name
+// and the other job level labels and annotations.
+func (g *Group) Object() client.Object {
+	if len(g.pods) == 0 {
+		return &corev1.Pod{}
+	}
+	return &g.pods[0]
+}
+
+// members returns the pods of the group that are not being deleted.
+func (g *Group) members() []*corev1.Pod {
+	members := make([]*corev1.Pod, 0, len(g.pods))
+	for i := range g.pods {
+		if g.pods[i].DeletionTimestamp.IsZero() {
+			members = append(members, &g.pods[i])
+		}
+	}
+	return members
+}
+
+// IsSuspended returns whether any pod of the group still has the Kueue
+// scheduling gate.
+func (g *Group) IsSuspended() bool {
+	for _, p := range g.members() {
+		if (*Pod)(p).IsSuspended() {
+			return true
+		}
+	}
+	return false
+}
+
+// Suspend is a no-op, the pods of the group are stopped by deleting them.
+func (g *Group) Suspend() {
+}
+
+func (g *Group) IsActive() bool {
+	for i := range g.pods {
+		if (*Pod)(&g.pods[i]).IsActive() {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Group) GetGVK() schema.GroupVersionKind {
+	return gvk
+}
+
+// PodSets returns a PodSet for each role of the group, in the order in which
+// the roles appear in the pods sorted by name.
+func (g *Group) PodSets() []kueue.PodSet {
+	var podSets []kueue.PodSet
+	indexes := make(map[string]int)
+	for _, p := range g.members() {
+		role := podRole(p)
+		if idx, found := indexes[role]; found {
+			podSets[idx].Count++
+			continue
+		}
+		indexes[role] = len(podSets)
+		podSet := (*Pod)(p).PodSets()[0]
+		podSet.Name = role
+		podSets = append(podSets, podSet)
+	}
+	return podSets
+}
+
+// RunWithPodSetsInfo removes the scheduling gate of the gated pods of the
+// group and injects the podSetInfo of their role.
+func (g *Group) RunWithPodSetsInfo(podSetsInfo []jobframework.PodSetInfo) error {
+	infos := make(map[string]jobframework.PodSetInfo, len(podSetsInfo))
+	for _, info := range podSetsInfo {
+		infos[info.Name] = info
+	}
+	for _, p := range g.members() {
+		if !(*Pod)(p).IsSuspended() {
+			continue
+		}
+		info, found := infos[podRole(p)]
+		if !found {
+			return fmt.Errorf("%w: no podSetInfo for the role %q of pod %q", jobframework.ErrInvalidPodsetInfo, podRole(p), p.Name)
+		}
+		if err := (*Pod)(p).RunWithPodSetsInfo([]jobframework.PodSetInfo{info}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RestorePodSetsInfo is a no-op, the pods are deleted when stopped.
+func (g *Group) RestorePodSetsInfo(_ []jobframework.PodSetInfo) bool {
+	return false
+}
+
+// Finished returns whether all the pods of the group succeeded or failed.
+func (g *Group) Finished() (metav1.Condition, bool) {
+	if len(g.pods) < g.totalCount {
+		return metav1.Condition{}, false
+	}
+	succeeded := 0
+	for i := range g.pods {
+		switch g.pods[i].Status.Phase {
+		case corev1.PodSucceeded:
+			succeeded++
+		case corev1.PodFailed:
+		default:
+			return metav1.Condition{}, false
+		}
+	}
+	return metav1.Condition{
+		Type:    kueue.WorkloadFinished,
+		Status:  metav1.ConditionTrue,
+		Reason:  "PodsFinished",
+		Message: fmt.Sprintf("Pods succeeded: %d/%d", succeeded, len(g.pods)),
+	}, true
+}
+
+// PodsReady returns whether all the pods of the group are ready or succeeded.
+func (g *Group) PodsReady() bool {
+	members := g.members()
+	if len(members) < g.totalCount {
+		return false
+	}
+	for _, p := range members {
+		if p.Status.Phase != corev1.PodSucceeded && !(*Pod)(p).PodsReady() {
+			return false
+		}
+	}
+	return true
+}
+
+// Stop deletes all the pods of the group that didn't finish yet.
+func (g *Group) Stop(ctx context.Context, c client.Client, _ []jobframework.PodSetInfo) (bool, error) {
+	stopped := false
+	for _, p := range g.members() {
+		stoppedNow, err := (*Pod)(p).Stop(ctx, c, nil)
+		if err != nil {
+			return stopped, err
+		}
+		stopped = stopped || stoppedNow
+	}
+	return stopped, nil
+}
+
+// NotifyPreemption sets the preemption deadline annotation in the running
+// pods of the group. The pods can read it through a downwardAPI volume.
+func (g *Group) NotifyPreemption(ctx context.Context, c client.Client, deadline time.Time) error {
+	for _, p := range g.members() {
+		if err := jobframework.NotifyPodPreemption(ctx, c, p, deadline); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run updates the pods ungated by RunWithPodSetsInfo.
+func (g *Group) Run(ctx context.Context, c client.Client, podSetsInfo []jobframework.PodSetInfo) error {
+	var gated []*corev1.Pod
+	for _, p := range g.members() {
+		if (*Pod)(p).IsSuspended() {
+			gated = append(gated, p)
+		}
+	}
+	if err := g.RunWithPodSetsInfo(podSetsInfo); err != nil {
+		return err
+	}
+	for _, p := range gated {
+		if err := c.Update(ctx, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ConstructComposableWorkload returns the workload of the group, named after
+// the group and owned by all its pods, once all the pods of the group exist.
+// The workload is labeled with the group name.
+func (g *Group) ConstructComposableWorkload(ctx context.Context, c client.Client) (*kueue.Workload, error) {
+	members := g.members()
+	if len(members) < g.totalCount {
+		return nil, nil
+	}
+	wl, err := jobframework.NewWorkload(ctx, c, g)
+	if err != nil {
+		return nil, err
+	}
+	wl.Name = groupWorkloadName(g.name)
+	// The workload isn't the workload of the first pod only.
+	delete(wl.Labels, constants.JobUIDLabel)
+	if wl.Labels == nil {
+		wl.Labels = make(map[string]string, 1)
+	}
+	wl.Labels[GroupNameLabel] = g.name
+	if wl.Annotations == nil {
+		wl.Annotations = make(map[string]string, 1)
+	}
+	wl.Annotations[IsGroupWorkloadAnnotation] = "true"
+	for _, p := range members {
+		if err := controllerutil.SetOwnerReference(p, wl, c.Scheme()); err != nil {
+			return nil, err
+		}
+	}
+	return wl, nil
+}
+
+// FindMatchingWorkloads returns the workload of the group if it is owned by
+// any of the pods of the group and has a PodSet for the role of every pod.
+// The pods that joined the group later, for example to replace failed pods,
+// are added to the owners of the workload.
+// A workload with the name of the workload of the group that isn't the
+// workload of a group is left untouched, so creating the workload of the
+// group fails.
+func (g *Group) FindMatchingWorkloads(ctx context.Context, c client.Client) (*kueue.Workload, []*kueue.Workload, error) {
+	var wl kueue.Workload
+	if err := c.Get(ctx, types.NamespacedName{Namespace: g.Object().GetNamespace(), Name: groupWorkloadName(g.name)}, &wl); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	if wl.Annotations[IsGroupWorkloadAnnotation] != "true" {
+		return nil, nil, nil
+	}
+	if !g.equivalentToWorkload(&wl) {
+		return nil, []*kueue.Workload{&wl}, nil
+	}
+	owners := sets.New[types.UID]()
+	for _, ref := range wl.OwnerReferences {
+		owners.Insert(ref.UID)
+	}
+	updated := false
+	for _, p := range g.members() {
+		if !owners.Has(p.UID) {
+			if err := controllerutil.SetOwnerReference(p, &wl, c.Scheme()); err != nil {
+				return nil, nil, err
+			}
+			updated = true
+		}
+	}
+	if updated {
+		if err := c.Update(ctx, &wl); err != nil {
+			return nil, nil, err
+		}
+	}
+	return &wl, nil, nil
+}
+
+func (g *Group) equivalentToWorkload(wl *kueue.Workload) bool {
+	owners := sets.New[types.UID]()
+	for _, ref := range wl.OwnerReferences {
+		if ref.Kind == gvk.Kind && ref.APIVersion == gvk.GroupVersion().String() {
+			owners.Insert(ref.UID)
+		}
+	}
+	roles := sets.New[string]()
+	for i := range wl.Spec.PodSets {
+		roles.Insert(wl.Spec.PodSets[i].Name)
+	}
+	owned := false
+	for i := range g.pods {
+		owned = owned || owners.Has(g.pods[i].UID)
+		if !roles.Has(podRole(&g.pods[i])) {
+			return false
+		}
+	}
+	// A workload that isn't owned by any of the pods belongs to a previous
+	// group with the same name.
+	return owned
+}
+
+// podRole returns the role hash of the pod set by the webhook, or computes it
+// for the pods created before it was set.
+func podRole(p *corev1.Pod) string {
+	if role, found := p.Annotations[RoleHashAnnotation]; found {
+		return role
+	}
+	return roleHash(p)
+}
+
+type containerShape struct {
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	Ports     []corev1.ContainerPort      `json:"ports,omitempty"`
+}
+
+type podShape struct {
+	InitContainers            []containerShape                  `json:"initContainers,omitempty"`
+	Containers                []containerShape                  `json:"containers,omitempty"`
+	NodeSelector              map[string]string                 `json:"nodeSelector,omitempty"`
+	Affinity                  *corev1.Affinity                  `json:"affinity,omitempty"`
+	Tolerations               []corev1.Toleration               `json:"tolerations,omitempty"`
+	RuntimeClassName          *string                           `json:"runtimeClassName,omitempty"`
+	PriorityClassName         string                            `json:"priorityClassName,omitempty"`
+	Overhead                  corev1.ResourceList               `json:"overhead,omitempty"`
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+}
+
+func containersShape(containers []corev1.Container) []containerShape {
+	shapes := make([]containerShape, len(containers))
+	for i := range containers {
+		shapes[i] = containerShape{
+			Resources: containers[i].Resources,
+			Ports:     containers[i].Ports,
+		}
+	}
+	return shapes
+}
+
+// roleHash returns a hash of the fields of the pod spec that are relevant
+// for its admission. The pods of a group with the same hash are
+// interchangeable for Kueue, and are counted in the same PodSet.
+func roleHash(p *corev1.Pod) string {
+	shape := podShape{
+		InitContainers:            containersShape(p.Spec.InitContainers),
+		Containers:                containersShape(p.Spec.Containers),
+		NodeSelector:              p.Spec.NodeSelector,
+		Affinity:                  p.Spec.Affinity,
+		Tolerations:               p.Spec.Tolerations,
+		RuntimeClassName:          p.Spec.RuntimeClassName,
+		PriorityClassName:         p.Spec.PriorityClassName,
+		Overhead:                  p.Spec.Overhead,
+		TopologySpreadConstraints: p.Spec.TopologySpreadConstraints,
+	}
+	// The shape only holds API types, which are always serializable.
+	shapeJSON, _ := json.Marshal(shape)
+	return fmt.Sprintf("%x", sha256.Sum256(shapeJSON))[:8]
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+)
+
+var (
+	managedLabelPath        = field.NewPath("metadata", "labels").Key(constants.ManagedByKueueLabel)
+	groupNameLabelPath      = field.NewPath("metadata", "labels").Key(GroupNameLabel)
+	groupTotalCountAnnoPath = field.NewPath("metadata", "annotations").Key(GroupTotalCountAnnotation)
+	roleHashAnnoPath        = field.NewPath("metadata", "annotations").Key(RoleHashAnnotation)
+
+	errPodOptsNotFound = errors.New("pod integration options not found")
+)
+
+type PodWebhook struct {
+	client                     client.Client
+	manageJobsWithoutQueueName bool
+	namespaceSelector          labels.Selector
+	podSelector                labels.Selector
+}
+
+// SetupWebhook configures the webhook for plain pods.
+func SetupWebhook(mgr ctrl.Manager, opts ...jobframework.Option) error {
+	options := jobframework.DefaultOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	podOpts, ok := options.IntegrationOptions[FrameworkName].(*configapi.PodIntegrationOptions)
+	if !ok || podOpts == nil {
+		return errPodOptsNotFound
+	}
+	wh, err := newPodWebhook(mgr.GetClient(), options.ManageJobsWithoutQueueName, podOpts)
+	if err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&corev1.Pod{}).
+		WithDefaulter(wh).
+		WithValidator(wh).
+		Complete()
+}
+
+func newPodWebhook(c client.Client, manageJobsWithoutQueueName bool, podOpts *configapi.PodIntegrationOptions) (*PodWebhook, error) {
+	namespaceSelector, err := metav1.LabelSelectorAsSelector(podOpts.NamespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the namespace selector: %w", err)
+	}
+	podSelector, err := metav1.LabelSelectorAsSelector(podOpts.PodSelector)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the pod selector: %w", err)
+	}
+	return &PodWebhook{
+		client:                     c,
+		manageJobsWithoutQueueName: manageJobsWithoutQueueName,
+		namespaceSelector:          namespaceSelector,
+		podSelector:                podSelector,
+	}, nil
+}
+
+// +kubebuilder:webhook:path=/mutate--v1-pod,mutating=true,failurePolicy=fail,sideEffects=None,groups="",resources=pods,verbs=create,versions=v1,name=mpod.kb.io,admissionReviewVersions=v1
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+var _ webhook.CustomDefaulter = &PodWebhook{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the type
+func (w *PodWebhook) Default(ctx context.Context, obj runtime.Object) error {
+	pod := fromObject(obj)
+	log := ctrl.LoggerFrom(ctx).WithName("pod-webhook")
+	log.V(5).Info("Applying defaults", "pod", klog.KObj(pod))
+
+	if owner := metav1.GetControllerOf(pod); owner != nil && isOwnerManagedByKueue(owner) {
+		return nil
+	}
+	if jobframework.QueueName(pod) == "" && !w.manageJobsWithoutQueueName {
+		return nil
+	}
+	if !w.podSelector.Matches(labels.Set(pod.Labels)) {
+		return nil
+	}
+	namespace := pod.Namespace
+	if namespace == "" {
+		if req, err := admission.RequestFromContext(ctx); err == nil {
+			namespace = req.Namespace
+		}
+	}
+	var ns corev1.Namespace
+	if err := w.client.Get(ctx, client.ObjectKey{Name: namespace}, &ns); err != nil {
+		return fmt.Errorf("getting the namespace of the pod: %w", err)
+	}
+	if !w.namespaceSelector.Matches(labels.Set(ns.Labels)) {
+		return nil
+	}
+
+	if pod.Labels == nil {
+		pod.Labels = make(map[string]string, 1)
+	}
+	pod.Labels[constants.ManagedByKueueLabel] = "true"
+	if gateIndex(pod) == -1 {
+		log.V(5).Info("Adding the scheduling gate", "pod", klog.KObj(pod))
+		pod.Spec.SchedulingGates = append(pod.Spec.SchedulingGates, corev1.PodSchedulingGate{Name: SchedulingGateName})
+	}
+	if _, isGroup := pod.Labels[GroupNameLabel]; isGroup {
+		if pod.Annotations == nil {
+			pod.Annotations = make(map[string]string, 1)
+		}
+		pod.Annotations[RoleHashAnnotation] = roleHash(pod.Object().(*corev1.Pod))
+	}
+	return nil
+}
+
+// isOwnerManagedByKueue returns whether the owner is a job that can be
+// managed by Kueue. The pods of those jobs are admitted through their jobs.
+func isOwnerManagedByKueue(owner *metav1.OwnerReference) bool {
+	// The batch/job integration doesn't register its Jobs as owners of
+	// managed objects, they can't be the parents of other Jobs.
+	if owner.Kind == "Job" && owner.APIVersion == batchv1.SchemeGroupVersion.String() {
+		return true
+	}
+	return jobframework.IsOwnerManagedByKueue(owner)
+}
+
+// +kubebuilder:webhook:path=/validate--v1-pod,mutating=false,failurePolicy=fail,sideEffects=None,groups="",resources=pods,verbs=create;update,versions=v1,name=vpod.kb.io,admissionReviewVersions=v1
+
+var _ webhook.CustomValidator = &PodWebhook{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type
+func (w *PodWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	pod := fromObject(obj)
+	if !isManagedByKueue(pod.Object()) {
+		return nil, nil
+	}
+	log := ctrl.LoggerFrom(ctx).WithName("pod-webhook")
+	log.V(5).Info("Validating create", "pod", klog.KObj(pod))
+	return nil, validateCreate(pod).ToAggregate()
+}
+
+func validateCreate(pod *Pod) field.ErrorList {
+	allErrs := jobframework.ValidateCreateForQueueName(pod)
+	allErrs = append(allErrs, jobframework.ValidateCreateForWorkloadPriorityClassName(pod)...)
+	allErrs = append(allErrs, jobframework.ValidateWorkloadActive(pod)...)
+	allErrs = append(allErrs, jobframework.ValidateMaxExecTime(pod)...)
+	allErrs = append(allErrs, jobframework.ValidatePreemptionGracePeriod(pod)...)
+	allErrs = append(allErrs, validateGroup(pod)...)
+	return allErrs
+}
+
+// validateGroup checks that the pods of a group set a valid group name and
+// total count.
+func validateGroup(pod *Pod) field.ErrorList {
+	var allErrs field.ErrorList
+	groupName, isGroup := pod.Labels[GroupNameLabel]
+	countValue, hasCount := pod.Annotations[GroupTotalCountAnnotation]
+	if !isGroup {
+		if hasCount {
+			allErrs = append(allErrs, field.Forbidden(groupTotalCountAnnoPath, fmt.Sprintf("requires the %s label", GroupNameLabel)))
+		}
+		return allErrs
+	}
+	// The group name is also the name of its workload.
+	for _, msg := range validation.IsDNS1123Subdomain(groupName) {
+		allErrs = append(allErrs, field.Invalid(groupNameLabelPath, groupName, msg))
+	}
+	if !hasCount {
+		allErrs = append(allErrs, field.Required(groupTotalCountAnnoPath, fmt.Sprintf("required by the %s label", GroupNameLabel)))
+	} else if _, err := groupTotalCount(pod.Object().(*corev1.Pod)); err != nil {
+		allErrs = append(allErrs, field.Invalid(groupTotalCountAnnoPath, countValue, "must be a positive integer"))
+	}
+	return allErrs
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
+func (w *PodWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldPod := fromObject(oldObj)
+	newPod := fromObject(newObj)
+	if !isManagedByKueue(oldPod.Object()) && !isManagedByKueue(newPod.Object()) {
+		return nil, nil
+	}
+	log := ctrl.LoggerFrom(ctx).WithName("pod-webhook")
+	log.V(5).Info("Validating update", "pod", klog.KObj(newPod))
+	return nil, validateUpdate(oldPod, newPod).ToAggregate()
+}
+
+func validateUpdate(oldPod, newPod *Pod) field.ErrorList {
+	var allErrs field.ErrorList
+	if oldPod.Labels[constants.ManagedByKueueLabel] != newPod.Labels[constants.ManagedByKueueLabel] {
+		allErrs = append(allErrs, field.Forbidden(managedLabelPath, "this label is immutable"))
+	}
+	allErrs = append(allErrs, jobframework.ValidateUpdateForQueueName(oldPod, newPod)...)
+	allErrs = append(allErrs, jobframework.ValidateUpdateForWorkloadPriorityClassName(oldPod, newPod)...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newPod.Labels[GroupNameLabel], oldPod.Labels[GroupNameLabel], groupNameLabelPath)...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newPod.Annotations[GroupTotalCountAnnotation], oldPod.Annotations[GroupTotalCountAnnotation], groupTotalCountAnnoPath)...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newPod.Annotations[RoleHashAnnotation], oldPod.Annotations[RoleHashAnnotation], roleHashAnnoPath)...)
+	allErrs = append(allErrs, validateCreate(newPod)...)
+	return allErrs
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
+func (w *PodWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,308 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	kubeflow "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingpod "sigs.k8s.io/kueue/pkg/util/testingjobs/pod"
+
+	// without this only the pod framework is registered
+	_ "sigs.k8s.io/kueue/pkg/controller/jobs/mpijob"
+)
+
+func TestDefault(t *testing.T) {
+	defaultPodOptions := &configapi.PodIntegrationOptions{
+		NamespaceSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{
+					Key:      "kubernetes.io/metadata.name",
+					Operator: metav1.LabelSelectorOpNotIn,
+					Values:   []string{"kube-system", "kueue-system"},
+				},
+			},
+		},
+		PodSelector: &metav1.LabelSelector{},
+	}
+	testcases := map[string]struct {
+		pod                        *corev1.Pod
+		manageJobsWithoutQueueName bool
+		podOptions                 *configapi.PodIntegrationOptions
+		want                       *corev1.Pod
+	}{
+		"pod with a queue name is gated": {
+			pod: testingpod.MakePod("pod", "default").Queue("queue").Obj(),
+			want: testingpod.MakePod("pod", "default").
+				Queue("queue").
+				Label(constants.ManagedByKueueLabel, "true").
+				KueueSchedulingGate().
+				Obj(),
+		},
+		"pod without a queue name is not gated": {
+			pod:  testingpod.MakePod("pod", "default").Obj(),
+			want: testingpod.MakePod("pod", "default").Obj(),
+		},
+		"pod without a queue name is gated with 'manageJobsWithoutQueueName=true'": {
+			pod:                        testingpod.MakePod("pod", "default").Obj(),
+			manageJobsWithoutQueueName: true,
+			want: testingpod.MakePod("pod", "default").
+				Label(constants.ManagedByKueueLabel, "true").
+				KueueSchedulingGate().
+				Obj(),
+		},
+		"pod in an excluded namespace is not gated": {
+			pod:  testingpod.MakePod("pod", "kube-system").Queue("queue").Obj(),
+			want: testingpod.MakePod("pod", "kube-system").Queue("queue").Obj(),
+		},
+		"pod not matching the pod selector is not gated": {
+			pod: testingpod.MakePod("pod", "default").Queue("queue").Obj(),
+			podOptions: &configapi.PodIntegrationOptions{
+				NamespaceSelector: &metav1.LabelSelector{},
+				PodSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"kueue-job": "true"},
+				},
+			},
+			want: testingpod.MakePod("pod", "default").Queue("queue").Obj(),
+		},
+		"pod owned by a managed job is not gated": {
+			pod: testingpod.MakePod("pod", "default").
+				Queue("queue").
+				OwnerReference("job", batchv1.SchemeGroupVersion.WithKind("Job")).
+				Obj(),
+			want: testingpod.MakePod("pod", "default").
+				Queue("queue").
+				OwnerReference("job", batchv1.SchemeGroupVersion.WithKind("Job")).
+				Obj(),
+		},
+		"pod owned by a managed MPIJob is not gated": {
+			pod: testingpod.MakePod("pod", "default").
+				Queue("queue").
+				OwnerReference("mpijob", kubeflow.SchemeGroupVersionKind).
+				Obj(),
+			want: testingpod.MakePod("pod", "default").
+				Queue("queue").
+				OwnerReference("mpijob", kubeflow.SchemeGroupVersionKind).
+				Obj(),
+		},
+		"pod of a group gets the role hash": {
+			pod: testingpod.MakePod("pod", "default").Queue("queue").Group("group", "2").Obj(),
+			want: testingpod.MakePod("pod", "default").
+				Queue("queue").
+				Group("group", "2").
+				Label(constants.ManagedByKueueLabel, "true").
+				Annotation(RoleHashAnnotation, roleHash(testingpod.MakePod("pod", "default").Obj())).
+				KueueSchedulingGate().
+				Obj(),
+		},
+		"gated pod is not gated twice": {
+			pod: testingpod.MakePod("pod", "default").
+				Queue("queue").
+				KueueSchedulingGate().
+				Obj(),
+			want: testingpod.MakePod("pod", "default").
+				Queue("queue").
+				Label(constants.ManagedByKueueLabel, "true").
+				KueueSchedulingGate().
+				Obj(),
+		},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			kClient := utiltesting.NewClientBuilder().
+				WithObjects(
+					&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+						Name:   "default",
+						Labels: map[string]string{"kubernetes.io/metadata.name": "default"},
+					}},
+					&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+						Name:   "kube-system",
+						Labels: map[string]string{"kubernetes.io/metadata.name": "kube-system"},
+					}},
+				).
+				Build()
+			podOptions := tc.podOptions
+			if podOptions == nil {
+				podOptions = defaultPodOptions
+			}
+			w, err := newPodWebhook(kClient, tc.manageJobsWithoutQueueName, podOptions)
+			if err != nil {
+				t.Fatalf("Creating the webhook: %v", err)
+			}
+			if err := w.Default(context.Background(), tc.pod); err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, tc.pod, cmpopts.EquateEmpty()); len(diff) != 0 {
+				t.Errorf("Default() mismatch (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateCreate(t *testing.T) {
+	managedPod := testingpod.MakePod("pod", "default").
+		Queue("queue").
+		Label(constants.ManagedByKueueLabel, "true")
+	testcases := map[string]struct {
+		pod     *corev1.Pod
+		wantErr field.ErrorList
+	}{
+		"valid pod": {
+			pod: managedPod.Clone().Obj(),
+		},
+		"valid pod of a group": {
+			pod: managedPod.Clone().Group("group", "3").Obj(),
+		},
+		"group without total count": {
+			pod: managedPod.Clone().Label(GroupNameLabel, "group").Obj(),
+			wantErr: field.ErrorList{
+				field.Required(groupTotalCountAnnoPath, ""),
+			},
+		},
+		"total count without group": {
+			pod: managedPod.Clone().Annotation(GroupTotalCountAnnotation, "3").Obj(),
+			wantErr: field.ErrorList{
+				field.Forbidden(groupTotalCountAnnoPath, ""),
+			},
+		},
+		"invalid total count": {
+			pod: managedPod.Clone().Group("group", "zero").Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(groupTotalCountAnnoPath, "zero", ""),
+			},
+		},
+		"non positive total count": {
+			pod: managedPod.Clone().Group("group", "0").Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(groupTotalCountAnnoPath, "0", ""),
+			},
+		},
+		"invalid group name": {
+			pod: managedPod.Clone().Group("Group", "3").Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(groupNameLabelPath, "Group", ""),
+			},
+		},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validateCreate(fromObject(tc.pod))
+			if diff := cmp.Diff(tc.wantErr, gotErr, cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail")); diff != "" {
+				t.Errorf("validateCreate() mismatch (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	managedPod := testingpod.MakePod("pod", "default").
+		Queue("queue").
+		Label(constants.ManagedByKueueLabel, "true")
+	testcases := map[string]struct {
+		oldPod  *corev1.Pod
+		newPod  *corev1.Pod
+		wantErr field.ErrorList
+	}{
+		"unmanaged pod": {
+			oldPod: testingpod.MakePod("pod", "default").Queue("queue").Obj(),
+			newPod: testingpod.MakePod("pod", "default").Queue("other-queue").Obj(),
+		},
+		"ungating the pod": {
+			oldPod: managedPod.Clone().KueueSchedulingGate().Obj(),
+			newPod: managedPod.Clone().Obj(),
+		},
+		"changing the queue of a gated pod": {
+			oldPod: managedPod.Clone().KueueSchedulingGate().Obj(),
+			newPod: managedPod.Clone().Queue("other-queue").KueueSchedulingGate().Obj(),
+		},
+		"changing the queue of an ungated pod": {
+			oldPod: managedPod.Clone().Obj(),
+			newPod: managedPod.Clone().Queue("other-queue").Obj(),
+			wantErr: field.ErrorList{
+				field.Forbidden(field.NewPath("metadata", "labels").Key(constants.QueueLabel), ""),
+			},
+		},
+		"changing the group of a pod": {
+			oldPod: managedPod.Clone().Group("group", "2").KueueSchedulingGate().Obj(),
+			newPod: managedPod.Clone().Group("other-group", "2").KueueSchedulingGate().Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(groupNameLabelPath, "", ""),
+			},
+		},
+		"changing the total count of a group": {
+			oldPod: managedPod.Clone().Group("group", "2").KueueSchedulingGate().Obj(),
+			newPod: managedPod.Clone().Group("group", "3").KueueSchedulingGate().Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(groupTotalCountAnnoPath, "", ""),
+			},
+		},
+		"deactivating the pod": {
+			oldPod: managedPod.Clone().KueueSchedulingGate().Obj(),
+			newPod: managedPod.Clone().Annotation(constants.WorkloadActiveAnnotation, "false").KueueSchedulingGate().Obj(),
+		},
+		"setting an invalid workload active annotation": {
+			oldPod: managedPod.Clone().KueueSchedulingGate().Obj(),
+			newPod: managedPod.Clone().Annotation(constants.WorkloadActiveAnnotation, "no").KueueSchedulingGate().Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("metadata", "annotations").Key(constants.WorkloadActiveAnnotation), "", ""),
+			},
+		},
+		"setting an invalid max exec time label": {
+			oldPod: managedPod.Clone().KueueSchedulingGate().Obj(),
+			newPod: managedPod.Clone().Label(constants.MaxExecTimeSecondsLabel, "0").KueueSchedulingGate().Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("metadata", "labels").Key(constants.MaxExecTimeSecondsLabel), "", ""),
+			},
+		},
+		"setting an invalid preemption grace period label": {
+			oldPod: managedPod.Clone().KueueSchedulingGate().Obj(),
+			newPod: managedPod.Clone().Label(constants.PreemptionGracePeriodSecondsLabel, "-1").KueueSchedulingGate().Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("metadata", "labels").Key(constants.PreemptionGracePeriodSecondsLabel), "", ""),
+			},
+		},
+		"removing the managed label": {
+			oldPod: managedPod.Clone().KueueSchedulingGate().Obj(),
+			newPod: testingpod.MakePod("pod", "default").Queue("queue").KueueSchedulingGate().Obj(),
+			wantErr: field.ErrorList{
+				field.Forbidden(managedLabelPath, ""),
+			},
+		},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			var gotErr field.ErrorList
+			if isManagedByKueue(tc.oldPod) || isManagedByKueue(tc.newPod) {
+				gotErr = validateUpdate(fromObject(tc.oldPod), fromObject(tc.newPod))
+			}
+			if diff := cmp.Diff(tc.wantErr, gotErr, cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail")); diff != "" {
+				t.Errorf("validateUpdate() mismatch (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
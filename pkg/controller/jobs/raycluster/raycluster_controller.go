@@ -0,0 +1,254 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package raycluster
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	rayjobapi "github.com/ray-project/kuberay/ray-operator/apis/ray/v1alpha1"
+	rayutils "github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+)
+
+var (
+	gvk           = rayjobapi.GroupVersion.WithKind("RayCluster")
+	FrameworkName = "ray.io/raycluster"
+)
+
+const (
+	headGroupPodSetName = "head"
+
+	// StartedAnnotation is set on the RayClusters started by Kueue, and
+	// removed when Kueue suspends them. A RayCluster that is suspended while
+	// it has the annotation was suspended by its user.
+	StartedAnnotation = "kueue.x-k8s.io/started"
+
+	// SuspendedFinishedReason is the reason of the Finished condition of
+	// the workloads of the RayClusters suspended by their users.
+	SuspendedFinishedReason = "Suspended"
+
+	// DeletedFinishedReason is the reason of the Finished condition of the
+	// workloads of the deleted RayClusters.
+	DeletedFinishedReason = "Deleted"
+)
+
+func init() {
+	utilruntime.Must(jobframework.RegisterIntegration(FrameworkName, jobframework.IntegrationCallbacks{
+		SetupIndexes:           SetupIndexes,
+		NewReconciler:          NewReconciler,
+		SetupWebhook:           SetupRayClusterWebhook,
+		JobType:                &rayjobapi.RayCluster{},
+		NewJob:                 NewJob,
+		AddToScheme:            rayjobapi.AddToScheme,
+		IsManagingObjectsOwner: isRayCluster,
+	}))
+}
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;watch;update
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=ray.io,resources=rayclusters,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=ray.io,resources=rayclusters/status,verbs=get;update
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/finalizers,verbs=update
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch
+
+func NewJob() jobframework.GenericJob {
+	return &RayCluster{}
+}
+
+// Reconciler reconciles the RayClusters that aren't created by a RayJob.
+type Reconciler struct {
+	*jobframework.JobReconciler
+}
+
+func NewReconciler(c client.Client, record record.EventRecorder, opts ...jobframework.Option) jobframework.JobReconcilerInterface {
+	return &Reconciler{
+		JobReconciler: jobframework.NewReconciler(c, record, opts...),
+	}
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.ReconcileGenericJob(ctx, req, &RayCluster{})
+}
+
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// The RayClusters created by a RayJob are admitted through the workload
+	// of the RayJob, they would be counted twice otherwise.
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&rayjobapi.RayCluster{},
+			builder.WithPredicates(predicate.NewPredicateFuncs(func(o client.Object) bool { return !isOwnedByRayJob(o) }))).
+		Owns(&kueue.Workload{}).
+		Complete(r)
+}
+
+type RayCluster rayjobapi.RayCluster
+
+var _ jobframework.GenericJob = (*RayCluster)(nil)
+var _ jobframework.JobWithPreemptionNotice = (*RayCluster)(nil)
+
+func (j *RayCluster) Object() client.Object {
+	return (*rayjobapi.RayCluster)(j)
+}
+
+func (j *RayCluster) IsSuspended() bool {
+	return pointer.BoolDeref(j.Spec.Suspend, false)
+}
+
+// IsActive returns whether the cluster can still have pods. KubeRay deletes
+// the pods of a suspended cluster before setting its state to suspended.
+func (j *RayCluster) IsActive() bool {
+	return j.Status.State != "" && j.Status.State != rayjobapi.Suspended
+}
+
+func (j *RayCluster) Suspend() {
+	j.Spec.Suspend = pointer.Bool(true)
+	delete(j.Annotations, StartedAnnotation)
+}
+
+func (j *RayCluster) GetGVK() schema.GroupVersionKind {
+	return gvk
+}
+
+func (j *RayCluster) PodSets() []kueue.PodSet {
+	// len = workerGroups + head
+	podSets := make([]kueue.PodSet, len(j.Spec.WorkerGroupSpecs)+1)
+
+	// head
+	podSets[0] = kueue.PodSet{
+		Name:     headGroupPodSetName,
+		Template: *j.Spec.HeadGroupSpec.Template.DeepCopy(),
+		Count:    1,
+	}
+
+	// workers
+	for index := range j.Spec.WorkerGroupSpecs {
+		wgs := &j.Spec.WorkerGroupSpecs[index]
+		replicas := int32(1)
+		if wgs.Replicas != nil {
+			replicas = *wgs.Replicas
+		}
+		podSets[index+1] = kueue.PodSet{
+			Name:     strings.ToLower(wgs.GroupName),
+			Template: *wgs.Template.DeepCopy(),
+			Count:    replicas,
+		}
+	}
+	return podSets
+}
+
+func (j *RayCluster) RunWithPodSetsInfo(podSetInfos []jobframework.PodSetInfo) error {
+	expectedLen := len(j.Spec.WorkerGroupSpecs) + 1
+	if len(podSetInfos) != expectedLen {
+		return jobframework.BadPodSetsInfoLenError(expectedLen, len(podSetInfos))
+	}
+	j.Spec.Suspend = pointer.Bool(false)
+	if j.Annotations == nil {
+		j.Annotations = make(map[string]string, 1)
+	}
+	j.Annotations[StartedAnnotation] = "true"
+
+	// head
+	jobframework.MergePodTemplate(&j.Spec.HeadGroupSpec.Template, podSetInfos[0])
+
+	// workers
+	for index := range j.Spec.WorkerGroupSpecs {
+		jobframework.MergePodTemplate(&j.Spec.WorkerGroupSpecs[index].Template, podSetInfos[index+1])
+	}
+	return nil
+}
+
+func (j *RayCluster) RestorePodSetsInfo(podSetInfos []jobframework.PodSetInfo) bool {
+	if len(podSetInfos) != len(j.Spec.WorkerGroupSpecs)+1 {
+		return false
+	}
+
+	changed := false
+	// head
+	changed = jobframework.RestorePodTemplate(&j.Spec.HeadGroupSpec.Template, podSetInfos[0]) || changed
+
+	// workers
+	for index := range j.Spec.WorkerGroupSpecs {
+		changed = jobframework.RestorePodTemplate(&j.Spec.WorkerGroupSpecs[index].Template, podSetInfos[index+1]) || changed
+	}
+	return changed
+}
+
+// Finished returns true once the cluster is being deleted, or after its user
+// suspends it. A RayCluster doesn't complete on its own.
+func (j *RayCluster) Finished() (metav1.Condition, bool) {
+	condition := metav1.Condition{
+		Type:   kueue.WorkloadFinished,
+		Status: metav1.ConditionTrue,
+	}
+	if j.DeletionTimestamp != nil {
+		condition.Reason = DeletedFinishedReason
+		condition.Message = "The RayCluster is deleted"
+		return condition, true
+	}
+	if j.IsSuspended() && isStarted(j) {
+		condition.Reason = SuspendedFinishedReason
+		condition.Message = "The RayCluster is suspended"
+		return condition, true
+	}
+	return condition, false
+}
+
+func (j *RayCluster) PodsReady() bool {
+	return j.Status.State == rayjobapi.Ready
+}
+
+// NotifyPreemption sets the preemption deadline annotation in the running
+// pods of the cluster. The pods can read it through a downwardAPI volume.
+func (j *RayCluster) NotifyPreemption(ctx context.Context, c client.Client, deadline time.Time) error {
+	return jobframework.NotifyPodsPreemption(ctx, c, deadline, client.InNamespace(j.Namespace), client.MatchingLabels{rayutils.RayClusterLabelKey: j.Name})
+}
+
+func SetupIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	return jobframework.SetupWorkloadOwnerIndex(ctx, indexer, gvk)
+}
+
+func GetWorkloadNameForRayCluster(clusterName string) string {
+	return jobframework.GetWorkloadNameForOwnerWithGVK(clusterName, gvk)
+}
+
+func isStarted(j *RayCluster) bool {
+	_, found := j.Annotations[StartedAnnotation]
+	return found
+}
+
+func isRayCluster(owner *metav1.OwnerReference) bool {
+	return owner.Kind == "RayCluster" && strings.HasPrefix(owner.APIVersion, "ray.io/")
+}
+
+func isOwnedByRayJob(o client.Object) bool {
+	owner := metav1.GetControllerOf(o)
+	return owner != nil && owner.Kind == "RayJob" && strings.HasPrefix(owner.APIVersion, "ray.io/")
+}
@@ -0,0 +1,401 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package raycluster
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	rayjobapi "github.com/ray-project/kuberay/ray-operator/apis/ray/v1alpha1"
+	rayutils "github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/util/pointer"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingraycluster "sigs.k8s.io/kueue/pkg/util/testingjobs/raycluster"
+)
+
+func TestPodSets(t *testing.T) {
+	cluster := testingraycluster.MakeCluster("cluster", "ns").
+		WithHeadGroupSpec(
+			rayjobapi.HeadGroupSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "head_c"}},
+					},
+				},
+			},
+		).
+		WithWorkerGroups(
+			rayjobapi.WorkerGroupSpec{
+				GroupName: "Group1",
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "group1_c"}},
+					},
+				},
+			},
+			rayjobapi.WorkerGroupSpec{
+				GroupName: "group2",
+				Replicas:  pointer.Int32(3),
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "group2_c"}},
+					},
+				},
+			},
+		).
+		Obj()
+
+	wantPodSets := []kueue.PodSet{
+		{
+			Name:  "head",
+			Count: 1,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "head_c"}},
+				},
+			},
+		},
+		{
+			Name:  "group1",
+			Count: 1,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "group1_c"}},
+				},
+			},
+		},
+		{
+			Name:  "group2",
+			Count: 3,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "group2_c"}},
+				},
+			},
+		},
+	}
+
+	result := ((*RayCluster)(cluster)).PodSets()
+
+	if diff := cmp.Diff(wantPodSets, result); diff != "" {
+		t.Errorf("PodSets() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRunAndRestorePodSetsInfo(t *testing.T) {
+	cluster := (*RayCluster)(testingraycluster.MakeCluster("cluster", "ns").Obj())
+
+	// RunWithPodSetsInfo with invalid info should fail
+	if err := cluster.RunWithPodSetsInfo([]jobframework.PodSetInfo{{}}); !errors.Is(err, jobframework.ErrInvalidPodsetInfo) {
+		t.Errorf("expecting error for bad PodSetsInfo on RunWithPodSetsInfo, got %v", err)
+	}
+	if !cluster.IsSuspended() {
+		t.Errorf("the cluster shouldn't be resumed with bad PodSetsInfo")
+	}
+
+	err := cluster.RunWithPodSetsInfo([]jobframework.PodSetInfo{
+		{NodeSelector: map[string]string{"head-key": "head-value"}},
+		{NodeSelector: map[string]string{"worker-key": "worker-value"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error on RunWithPodSetsInfo: %s", err)
+	}
+	if cluster.IsSuspended() {
+		t.Errorf("the cluster should be resumed by RunWithPodSetsInfo")
+	}
+	if !isStarted(cluster) {
+		t.Errorf("the cluster should be marked as started by RunWithPodSetsInfo")
+	}
+	if diff := cmp.Diff(map[string]string{"head-key": "head-value"}, cluster.Spec.HeadGroupSpec.Template.Spec.NodeSelector); diff != "" {
+		t.Errorf("head node selectors mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]string{"worker-key": "worker-value"}, cluster.Spec.WorkerGroupSpecs[0].Template.Spec.NodeSelector); diff != "" {
+		t.Errorf("worker node selectors mismatch (-want +got):\n%s", diff)
+	}
+
+	cluster.Suspend()
+	changed := cluster.RestorePodSetsInfo([]jobframework.PodSetInfo{{}, {}})
+	if !changed {
+		t.Errorf("RestorePodSetsInfo should report the node selectors as changed")
+	}
+	if isStarted(cluster) {
+		t.Errorf("the cluster suspended by Kueue shouldn't be marked as started")
+	}
+	if _, finished := cluster.Finished(); finished {
+		t.Errorf("the cluster suspended by Kueue shouldn't be finished")
+	}
+	if len(cluster.Spec.HeadGroupSpec.Template.Spec.NodeSelector) != 0 || len(cluster.Spec.WorkerGroupSpecs[0].Template.Spec.NodeSelector) != 0 {
+		t.Errorf("the node selectors should be restored")
+	}
+}
+
+func TestFinished(t *testing.T) {
+	cases := map[string]struct {
+		cluster      *rayjobapi.RayCluster
+		wantFinished bool
+		wantReason   string
+	}{
+		"suspended by kueue": {
+			cluster: testingraycluster.MakeCluster("cluster", "ns").Obj(),
+		},
+		"running": {
+			cluster: testingraycluster.MakeCluster("cluster", "ns").
+				Suspend(false).
+				Annotation(StartedAnnotation, "true").
+				State(rayjobapi.Ready).
+				Obj(),
+		},
+		"suspended by the user": {
+			cluster: testingraycluster.MakeCluster("cluster", "ns").
+				Annotation(StartedAnnotation, "true").
+				State(rayjobapi.Ready).
+				Obj(),
+			wantFinished: true,
+			wantReason:   SuspendedFinishedReason,
+		},
+		"deleted": {
+			cluster: testingraycluster.MakeCluster("cluster", "ns").
+				Suspend(false).
+				Annotation(StartedAnnotation, "true").
+				DeletionTimestamp(metav1.Now()).
+				Obj(),
+			wantFinished: true,
+			wantReason:   DeletedFinishedReason,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			condition, finished := ((*RayCluster)(tc.cluster)).Finished()
+			if finished != tc.wantFinished {
+				t.Errorf("Unexpected finished, want=%v, got=%v", tc.wantFinished, finished)
+			}
+			if finished && condition.Reason != tc.wantReason {
+				t.Errorf("Unexpected reason, want=%q, got=%q", tc.wantReason, condition.Reason)
+			}
+		})
+	}
+}
+
+var (
+	clusterCmpOpts = []cmp.Option{
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(rayjobapi.RayCluster{}, "TypeMeta"),
+		cmpopts.IgnoreFields(metav1.ObjectMeta{}, "ResourceVersion"),
+	}
+	workloadCmpOpts = []cmp.Option{
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(kueue.Workload{}, "TypeMeta", "ObjectMeta"),
+		cmpopts.IgnoreFields(kueue.WorkloadSpec{}, "Priority"),
+		cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime"),
+		cmpopts.IgnoreFields(kueue.PodSet{}, "Template"),
+	}
+)
+
+func TestReconciler(t *testing.T) {
+	onDemand := map[corev1.ResourceName]kueue.ResourceFlavorReference{corev1.ResourceCPU: "on-demand"}
+	admission := utiltesting.MakeAdmission("cq").
+		PodSets(
+			kueue.PodSetAssignment{Name: "head", Flavors: onDemand},
+			kueue.PodSetAssignment{Name: "workers-group-0", Flavors: onDemand},
+		).
+		Obj()
+	basePodSets := ((*RayCluster)(testingraycluster.MakeCluster("cluster", "ns").Obj())).PodSets()
+	cases := map[string]struct {
+		reconcilerOptions []jobframework.Option
+		cluster           *rayjobapi.RayCluster
+		workloads         []kueue.Workload
+		wantCluster       *rayjobapi.RayCluster
+		wantWorkloads     []kueue.Workload
+		wantErr           error
+	}{
+		"workload is created with podsets": {
+			reconcilerOptions: []jobframework.Option{
+				jobframework.WithManageJobsWithoutQueueName(true),
+			},
+			cluster:     testingraycluster.MakeCluster("cluster", "ns").Obj(),
+			wantCluster: testingraycluster.MakeCluster("cluster", "ns").Obj(),
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("cluster", "ns").
+					PodSets(
+						*utiltesting.MakePodSet("head", 1).Obj(),
+						*utiltesting.MakePodSet("workers-group-0", 1).Obj(),
+					).
+					Obj(),
+			},
+		},
+		"cluster is resumed when the workload is admitted": {
+			cluster: testingraycluster.MakeCluster("cluster", "ns").Queue("user-queue").Obj(),
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("cluster", "ns").
+					Queue("user-queue").
+					PodSets(basePodSets...).
+					Admit(admission).
+					Obj(),
+			},
+			wantCluster: func() *rayjobapi.RayCluster {
+				c := testingraycluster.MakeCluster("cluster", "ns").
+					Queue("user-queue").
+					Suspend(false).
+					Annotation(StartedAnnotation, "true").
+					Obj()
+				c.Spec.HeadGroupSpec.Template.Spec.NodeSelector = map[string]string{"instance-type": "on-demand"}
+				c.Spec.WorkerGroupSpecs[0].Template.Spec.NodeSelector = map[string]string{"instance-type": "on-demand"}
+				return c
+			}(),
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("cluster", "ns").
+					Queue("user-queue").
+					PodSets(basePodSets...).
+					Admit(admission).
+					Obj(),
+			},
+		},
+		"workload is finished when the user suspends the cluster": {
+			cluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("user-queue").
+				Annotation(StartedAnnotation, "true").
+				State(rayjobapi.Ready).
+				Obj(),
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("cluster", "ns").
+					Queue("user-queue").
+					PodSets(basePodSets...).
+					Admit(admission).
+					Obj(),
+			},
+			wantCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("user-queue").
+				Annotation(StartedAnnotation, "true").
+				State(rayjobapi.Ready).
+				Obj(),
+			wantWorkloads: []kueue.Workload{
+				func() kueue.Workload {
+					wl := utiltesting.MakeWorkload("cluster", "ns").
+						Queue("user-queue").
+						PodSets(basePodSets...).
+						Admit(admission).
+						Obj()
+					// The status is applied, which the fake client implements by
+					// replacing the conditions.
+					wl.Status.Conditions = []metav1.Condition{{
+						Type:    kueue.WorkloadFinished,
+						Status:  metav1.ConditionTrue,
+						Reason:  SuspendedFinishedReason,
+						Message: "The RayCluster is suspended",
+					}}
+					return *wl
+				}(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			clientBuilder := utiltesting.NewClientBuilder(rayjobapi.AddToScheme)
+			if err := SetupIndexes(ctx, utiltesting.AsIndexer(clientBuilder)); err != nil {
+				t.Fatalf("Could not setup indexes: %v", err)
+			}
+			kcBuilder := clientBuilder.
+				WithObjects(tc.cluster).
+				WithObjects(utiltesting.MakeResourceFlavor("on-demand").Label("instance-type", "on-demand").Obj())
+			for i := range tc.workloads {
+				kcBuilder = kcBuilder.WithStatusSubresource(&tc.workloads[i])
+			}
+			kClient := kcBuilder.Build()
+			for i := range tc.workloads {
+				if err := ctrl.SetControllerReference(tc.cluster, &tc.workloads[i], kClient.Scheme()); err != nil {
+					t.Fatalf("Could not setup owner reference in Workloads: %v", err)
+				}
+				if err := kClient.Create(ctx, &tc.workloads[i]); err != nil {
+					t.Fatalf("Could not create workload: %v", err)
+				}
+			}
+			recorder := record.NewBroadcaster().NewRecorder(kClient.Scheme(), corev1.EventSource{Component: "test"})
+			reconciler := NewReconciler(kClient, recorder, tc.reconcilerOptions...)
+
+			clusterKey := client.ObjectKeyFromObject(tc.cluster)
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: clusterKey,
+			})
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Reconcile returned error (-want,+got):\n%s", diff)
+			}
+
+			var gotCluster rayjobapi.RayCluster
+			if err := kClient.Get(ctx, clusterKey, &gotCluster); err != nil {
+				t.Fatalf("Could not get RayCluster after reconcile: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantCluster, &gotCluster, clusterCmpOpts...); diff != "" {
+				t.Errorf("RayCluster after reconcile (-want,+got):\n%s", diff)
+			}
+			var gotWorkloads kueue.WorkloadList
+			if err := kClient.List(ctx, &gotWorkloads); err != nil {
+				t.Fatalf("Could not get Workloads after reconcile: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantWorkloads, gotWorkloads.Items, workloadCmpOpts...); diff != "" {
+				t.Errorf("Workloads after reconcile (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNotifyPreemption(t *testing.T) {
+	deadline := time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	pod := func(name, owner string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", Labels: map[string]string{rayutils.RayClusterLabelKey: owner}},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+	ctx, _ := utiltesting.ContextWithLog(t)
+	cl := utiltesting.NewClientBuilder().WithObjects(
+		pod("running", "job"),
+		pod("other", "other"),
+	).Build()
+
+	if err := (*RayCluster)(testingraycluster.MakeCluster("job", "ns").Obj()).NotifyPreemption(ctx, cl, deadline); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	wantAnnotations := map[string]string{
+		"running": "2023-10-01T12:00:00Z",
+		"other":   "",
+	}
+	for name, want := range wantAnnotations {
+		var got corev1.Pod
+		if err := cl.Get(ctx, client.ObjectKey{Namespace: "ns", Name: name}, &got); err != nil {
+			t.Fatalf("Getting pod %s: %v", name, err)
+		}
+		if got := got.Annotations[constants.PreemptionDeadlineAnnotation]; got != want {
+			t.Errorf("Unexpected annotation in pod %s, want=%q, got=%q", name, want, got)
+		}
+	}
+}
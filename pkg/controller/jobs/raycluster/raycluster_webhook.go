@@ -0,0 +1,175 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package raycluster
+
+import (
+	"context"
+	"fmt"
+
+	rayjobapi "github.com/ray-project/kuberay/ray-operator/apis/ray/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+)
+
+type RayClusterWebhook struct {
+	client                     client.Client
+	manageJobsWithoutQueueName bool
+}
+
+// SetupRayClusterWebhook configures the webhook for rayjobapi RayCluster.
+func SetupRayClusterWebhook(mgr ctrl.Manager, opts ...jobframework.Option) error {
+	options := jobframework.DefaultOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	wh := &RayClusterWebhook{
+		client:                     mgr.GetClient(),
+		manageJobsWithoutQueueName: options.ManageJobsWithoutQueueName,
+	}
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&rayjobapi.RayCluster{}).
+		WithDefaulter(wh).
+		WithValidator(wh).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-ray-io-v1alpha1-raycluster,mutating=true,failurePolicy=fail,sideEffects=None,groups=ray.io,resources=rayclusters,verbs=create,versions=v1alpha1,name=mraycluster.kb.io,admissionReviewVersions=v1
+
+var _ webhook.CustomDefaulter = &RayClusterWebhook{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the type
+func (w *RayClusterWebhook) Default(ctx context.Context, obj runtime.Object) error {
+	cluster := obj.(*rayjobapi.RayCluster)
+	log := ctrl.LoggerFrom(ctx).WithName("raycluster-webhook")
+	log.V(5).Info("Applying defaults", "cluster", klog.KObj(cluster))
+	// The clusters of the RayJobs are started and stopped by KubeRay along
+	// with their RayJob.
+	if isOwnedByRayJob(cluster) {
+		return nil
+	}
+	jobframework.ApplyDefaultForSuspend((*RayCluster)(cluster), w.manageJobsWithoutQueueName)
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-ray-io-v1alpha1-raycluster,mutating=false,failurePolicy=fail,sideEffects=None,groups=ray.io,resources=rayclusters,verbs=create;update,versions=v1alpha1,name=vraycluster.kb.io,admissionReviewVersions=v1
+
+var _ webhook.CustomValidator = &RayClusterWebhook{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type
+func (w *RayClusterWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	cluster := obj.(*rayjobapi.RayCluster)
+	log := ctrl.LoggerFrom(ctx).WithName("raycluster-webhook")
+	log.Info("Validating create", "cluster", klog.KObj(cluster))
+	return nil, w.validateCreate(cluster).ToAggregate()
+}
+
+func (w *RayClusterWebhook) isManaged(cluster *rayjobapi.RayCluster) bool {
+	return !isOwnedByRayJob(cluster) && (w.manageJobsWithoutQueueName || jobframework.QueueName((*RayCluster)(cluster)) != "")
+}
+
+func (w *RayClusterWebhook) validateCreate(cluster *rayjobapi.RayCluster) field.ErrorList {
+	var allErrors field.ErrorList
+	kueueCluster := (*RayCluster)(cluster)
+
+	if w.isManaged(cluster) {
+		spec := &cluster.Spec
+		specPath := field.NewPath("spec")
+
+		// Should not use auto scaler. Once the resources are reserved by queue the cluster should do it's best to use them.
+		if pointer.BoolDeref(spec.EnableInTreeAutoscaling, false) {
+			allErrors = append(allErrors, field.Invalid(specPath.Child("enableInTreeAutoscaling"), spec.EnableInTreeAutoscaling, "a kueue managed cluster should not use autoscaling"))
+		}
+
+		// Should limit the worker count to 8 - 1 (max podSets num - cluster head)
+		if len(spec.WorkerGroupSpecs) > 7 {
+			allErrors = append(allErrors, field.TooMany(specPath.Child("workerGroupSpecs"), len(spec.WorkerGroupSpecs), 7))
+		}
+
+		// None of the workerGroups should be named "head"
+		for i := range spec.WorkerGroupSpecs {
+			if spec.WorkerGroupSpecs[i].GroupName == headGroupPodSetName {
+				allErrors = append(allErrors, field.Forbidden(specPath.Child("workerGroupSpecs").Index(i).Child("groupName"), fmt.Sprintf("%q is reserved for the head group", headGroupPodSetName)))
+			}
+		}
+	}
+
+	allErrors = append(allErrors, jobframework.ValidateCreateForQueueName(kueueCluster)...)
+	allErrors = append(allErrors, jobframework.ValidateCreateForWorkloadPriorityClassName(kueueCluster)...)
+	allErrors = append(allErrors, jobframework.ValidateWorkloadActive(kueueCluster)...)
+	allErrors = append(allErrors, jobframework.ValidateMaxExecTime(kueueCluster)...)
+	allErrors = append(allErrors, jobframework.ValidatePreemptionGracePeriod(kueueCluster)...)
+	return allErrors
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
+func (w *RayClusterWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldCluster := oldObj.(*rayjobapi.RayCluster)
+	newCluster := newObj.(*rayjobapi.RayCluster)
+	log := ctrl.LoggerFrom(ctx).WithName("raycluster-webhook")
+	if w.isManaged(newCluster) {
+		log.Info("Validating update", "cluster", klog.KObj(newCluster))
+		allErrors := jobframework.ValidateUpdateForQueueName((*RayCluster)(oldCluster), (*RayCluster)(newCluster))
+		allErrors = append(allErrors, jobframework.ValidateUpdateForWorkloadPriorityClassName((*RayCluster)(oldCluster), (*RayCluster)(newCluster))...)
+		resumeErrors, err := w.validateUpdateForResume(ctx, oldCluster, newCluster)
+		if err != nil {
+			return nil, err
+		}
+		allErrors = append(allErrors, resumeErrors...)
+		allErrors = append(allErrors, w.validateCreate(newCluster)...)
+		return nil, allErrors.ToAggregate()
+	}
+	return nil, nil
+}
+
+// validateUpdateForResume forbids resuming a cluster whose workload is
+// finished, for example after being suspended by its user.
+func (w *RayClusterWebhook) validateUpdateForResume(ctx context.Context, oldCluster, newCluster *rayjobapi.RayCluster) (field.ErrorList, error) {
+	var allErrors field.ErrorList
+	if !(*RayCluster)(oldCluster).IsSuspended() || (*RayCluster)(newCluster).IsSuspended() {
+		return allErrors, nil
+	}
+	var wl kueue.Workload
+	key := types.NamespacedName{Namespace: newCluster.Namespace, Name: GetWorkloadNameForRayCluster(newCluster.Name)}
+	if err := w.client.Get(ctx, key, &wl); err != nil {
+		if apierrors.IsNotFound(err) {
+			return allErrors, nil
+		}
+		return nil, fmt.Errorf("getting the workload of the cluster: %w", err)
+	}
+	if metav1.IsControlledBy(&wl, newCluster) && apimeta.IsStatusConditionTrue(wl.Status.Conditions, kueue.WorkloadFinished) {
+		allErrors = append(allErrors, field.Forbidden(field.NewPath("spec", "suspend"), "a kueue managed cluster can't be resumed after being suspended, its workload is finished"))
+	}
+	return allErrors, nil
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
+func (w *RayClusterWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,386 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package raycluster
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	rayjobapi "github.com/ray-project/kuberay/ray-operator/apis/ray/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/util/pointer"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingraycluster "sigs.k8s.io/kueue/pkg/util/testingjobs/raycluster"
+)
+
+var rayJobGVK = rayjobapi.GroupVersion.WithKind("RayJob")
+
+func TestDefault(t *testing.T) {
+	testcases := map[string]struct {
+		cluster     *rayjobapi.RayCluster
+		manageAll   bool
+		wantCluster *rayjobapi.RayCluster
+	}{
+		"unmanaged": {
+			cluster: testingraycluster.MakeCluster("cluster", "ns").
+				Suspend(false).
+				Obj(),
+			wantCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Suspend(false).
+				Obj(),
+		},
+		"managed - by config": {
+			cluster: testingraycluster.MakeCluster("cluster", "ns").
+				Suspend(false).
+				Obj(),
+			manageAll: true,
+			wantCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Suspend(true).
+				Obj(),
+		},
+		"managed - by queue": {
+			cluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				Suspend(false).
+				Obj(),
+			wantCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				Suspend(true).
+				Obj(),
+		},
+		"owned by a RayJob": {
+			cluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				OwnerReference("job", rayJobGVK).
+				Suspend(false).
+				Obj(),
+			manageAll: true,
+			wantCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				OwnerReference("job", rayJobGVK).
+				Suspend(false).
+				Obj(),
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			wh := &RayClusterWebhook{
+				manageJobsWithoutQueueName: tc.manageAll,
+			}
+			result := tc.cluster.DeepCopy()
+			if err := wh.Default(context.Background(), result); err != nil {
+				t.Errorf("unexpected Default() error: %s", err)
+			}
+			if diff := cmp.Diff(tc.wantCluster, result); diff != "" {
+				t.Errorf("Default() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateCreate(t *testing.T) {
+	worker := rayjobapi.WorkerGroupSpec{}
+	bigWorkerGroup := []rayjobapi.WorkerGroupSpec{worker, worker, worker, worker, worker, worker, worker, worker}
+
+	testcases := map[string]struct {
+		cluster   *rayjobapi.RayCluster
+		manageAll bool
+		wantErr   error
+	}{
+		"invalid unmanaged": {
+			cluster: testingraycluster.MakeCluster("cluster", "ns").
+				WithEnableAutoscaling(pointer.Bool(true)).
+				Obj(),
+			wantErr: nil,
+		},
+		"invalid managed - by config": {
+			cluster: testingraycluster.MakeCluster("cluster", "ns").
+				WithEnableAutoscaling(pointer.Bool(true)).
+				Obj(),
+			manageAll: true,
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "enableInTreeAutoscaling"), pointer.Bool(true), "a kueue managed cluster should not use autoscaling"),
+			}.ToAggregate(),
+		},
+		"invalid managed - has auto scaler": {
+			cluster: testingraycluster.MakeCluster("cluster", "ns").Queue("queue").
+				WithEnableAutoscaling(pointer.Bool(true)).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "enableInTreeAutoscaling"), pointer.Bool(true), "a kueue managed cluster should not use autoscaling"),
+			}.ToAggregate(),
+		},
+		"invalid managed - too many worker groups": {
+			cluster: testingraycluster.MakeCluster("cluster", "ns").Queue("queue").
+				WithWorkerGroups(bigWorkerGroup...).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.TooMany(field.NewPath("spec", "workerGroupSpecs"), 8, 7),
+			}.ToAggregate(),
+		},
+		"worker group uses head name": {
+			cluster: testingraycluster.MakeCluster("cluster", "ns").Queue("queue").
+				WithWorkerGroups(rayjobapi.WorkerGroupSpec{
+					GroupName: headGroupPodSetName,
+				}).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "workerGroupSpecs").Index(0).Child("groupName"), fmt.Sprintf("%q is reserved for the head group", headGroupPodSetName)),
+			}.ToAggregate(),
+		},
+		"owned by a RayJob": {
+			cluster: testingraycluster.MakeCluster("cluster", "ns").Queue("queue").
+				OwnerReference("job", rayJobGVK).
+				WithEnableAutoscaling(pointer.Bool(true)).
+				Obj(),
+			wantErr: nil,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			wh := &RayClusterWebhook{
+				manageJobsWithoutQueueName: tc.manageAll,
+			}
+			_, result := wh.ValidateCreate(context.Background(), tc.cluster)
+			if diff := cmp.Diff(tc.wantErr, result); diff != "" {
+				t.Errorf("ValidateCreate() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	finishedWorkload := func(ownerUID string) *kueue.Workload {
+		wl := utiltesting.MakeWorkload(GetWorkloadNameForRayCluster("cluster"), "ns").
+			Condition(metav1.Condition{
+				Type:   kueue.WorkloadFinished,
+				Status: metav1.ConditionTrue,
+				Reason: SuspendedFinishedReason,
+			}).
+			Obj()
+		wl.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: gvk.GroupVersion().String(),
+			Kind:       gvk.Kind,
+			Name:       "cluster",
+			UID:        types.UID(ownerUID),
+			Controller: pointer.Bool(true),
+		}}
+		return wl
+	}
+	testcases := map[string]struct {
+		oldCluster *rayjobapi.RayCluster
+		newCluster *rayjobapi.RayCluster
+		workload   *kueue.Workload
+		manageAll  bool
+		wantErr    error
+	}{
+		"invalid managed - queue name should not change while unsuspended": {
+			oldCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				Suspend(false).
+				Obj(),
+			newCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue2").
+				Suspend(false).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Forbidden(field.NewPath("metadata", "labels").Key(constants.QueueLabel), "must not update queue name when job is unsuspend"),
+			}.ToAggregate(),
+		},
+		"managed - queue name can change while suspended": {
+			oldCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				Obj(),
+			newCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue2").
+				Obj(),
+			wantErr: nil,
+		},
+		"managed - started by kueue": {
+			oldCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				Obj(),
+			newCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				Annotation(StartedAnnotation, "true").
+				Suspend(false).
+				Obj(),
+			wantErr: nil,
+		},
+		"managed - suspended by the user": {
+			oldCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				Annotation(StartedAnnotation, "true").
+				Suspend(false).
+				Obj(),
+			newCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				Annotation(StartedAnnotation, "true").
+				Obj(),
+			wantErr: nil,
+		},
+		"managed - resumed by kueue while its workload isn't finished": {
+			oldCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				UID("cluster-uid").
+				Obj(),
+			newCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				UID("cluster-uid").
+				Annotation(StartedAnnotation, "true").
+				Suspend(false).
+				Obj(),
+			workload: func() *kueue.Workload {
+				wl := finishedWorkload("cluster-uid")
+				wl.Status.Conditions = nil
+				return wl
+			}(),
+			wantErr: nil,
+		},
+		"invalid managed - resumed after being suspended by the user": {
+			oldCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				UID("cluster-uid").
+				Annotation(StartedAnnotation, "true").
+				Obj(),
+			newCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				UID("cluster-uid").
+				Annotation(StartedAnnotation, "true").
+				Suspend(false).
+				Obj(),
+			workload: finishedWorkload("cluster-uid"),
+			wantErr: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "suspend"), "a kueue managed cluster can't be resumed after being suspended, its workload is finished"),
+			}.ToAggregate(),
+		},
+		"invalid managed - resumed without the started annotation after its workload finished": {
+			oldCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				UID("cluster-uid").
+				Obj(),
+			newCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				UID("cluster-uid").
+				Suspend(false).
+				Obj(),
+			workload: finishedWorkload("cluster-uid"),
+			wantErr: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "suspend"), "a kueue managed cluster can't be resumed after being suspended, its workload is finished"),
+			}.ToAggregate(),
+		},
+		"managed - finished workload of a previous cluster with the same name": {
+			oldCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				UID("cluster-uid").
+				Obj(),
+			newCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				UID("cluster-uid").
+				Annotation(StartedAnnotation, "true").
+				Suspend(false).
+				Obj(),
+			workload: finishedWorkload("previous-cluster-uid"),
+			wantErr:  nil,
+		},
+		"unmanaged - resumed after being suspended by the user": {
+			oldCluster: testingraycluster.MakeCluster("cluster", "ns").
+				UID("cluster-uid").
+				Annotation(StartedAnnotation, "true").
+				Obj(),
+			newCluster: testingraycluster.MakeCluster("cluster", "ns").
+				UID("cluster-uid").
+				Annotation(StartedAnnotation, "true").
+				Suspend(false).
+				Obj(),
+			workload: finishedWorkload("cluster-uid"),
+			wantErr:  nil,
+		},
+		"managed - deactivated": {
+			oldCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				Obj(),
+			newCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				Annotation(constants.WorkloadActiveAnnotation, "false").
+				Obj(),
+			wantErr: nil,
+		},
+		"invalid managed - workload active annotation": {
+			oldCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				Obj(),
+			newCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				Annotation(constants.WorkloadActiveAnnotation, "no").
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("metadata", "annotations").Key(constants.WorkloadActiveAnnotation), "no", "must be true or false"),
+			}.ToAggregate(),
+		},
+		"invalid managed - max exec time label": {
+			oldCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				Obj(),
+			newCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				Label(constants.MaxExecTimeSecondsLabel, "0").
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("metadata", "labels").Key(constants.MaxExecTimeSecondsLabel), "0", "must be a positive integer"),
+			}.ToAggregate(),
+		},
+		"invalid managed - preemption grace period label": {
+			oldCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				Obj(),
+			newCluster: testingraycluster.MakeCluster("cluster", "ns").
+				Queue("queue").
+				Label(constants.PreemptionGracePeriodSecondsLabel, "-1").
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("metadata", "labels").Key(constants.PreemptionGracePeriodSecondsLabel), "-1", "must be a non-negative integer"),
+			}.ToAggregate(),
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			clientBuilder := utiltesting.NewClientBuilder(rayjobapi.AddToScheme)
+			if tc.workload != nil {
+				clientBuilder = clientBuilder.WithObjects(tc.workload)
+			}
+			wh := &RayClusterWebhook{
+				client:                     clientBuilder.Build(),
+				manageJobsWithoutQueueName: tc.manageAll,
+			}
+			_, result := wh.ValidateUpdate(context.Background(), tc.oldCluster, tc.newCluster)
+			if diff := cmp.Diff(tc.wantErr, result); diff != "" {
+				t.Errorf("ValidateUpdate() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,9 +19,10 @@ package rayjob
 import (
 	"context"
 	"strings"
+	"time"
 
 	rayjobapi "github.com/ray-project/kuberay/ray-operator/apis/ray/v1alpha1"
-	"k8s.io/apimachinery/pkg/api/equality"
+	rayutils "github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -29,7 +30,6 @@ import (
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
-	"sigs.k8s.io/kueue/pkg/util/maps"
 )
 
 var (
@@ -47,11 +47,13 @@ func init() {
 		NewReconciler: NewReconciler,
 		SetupWebhook:  SetupRayJobWebhook,
 		JobType:       &rayjobapi.RayJob{},
+		NewJob:        NewJob,
 		AddToScheme:   rayjobapi.AddToScheme,
 	}))
 }
 
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;watch;update
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;patch
 // +kubebuilder:rbac:groups=ray.io,resources=rayjobs,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=ray.io,resources=rayjobs/status,verbs=get;update
 // +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;create;update;patch;delete
@@ -59,11 +61,16 @@ func init() {
 // +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/finalizers,verbs=update
 // +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch
 
-var NewReconciler = jobframework.NewGenericReconciler(func() jobframework.GenericJob { return &RayJob{} }, nil)
+func NewJob() jobframework.GenericJob {
+	return &RayJob{}
+}
+
+var NewReconciler = jobframework.NewGenericReconciler(NewJob, nil)
 
 type RayJob rayjobapi.RayJob
 
 var _ jobframework.GenericJob = (*RayJob)(nil)
+var _ jobframework.JobWithPreemptionNotice = (*RayJob)(nil)
 
 func (j *RayJob) Object() client.Object {
 	return (*rayjobapi.RayJob)(j)
@@ -120,13 +127,11 @@ func (j *RayJob) RunWithPodSetsInfo(podSetInfos []jobframework.PodSetInfo) error
 	}
 
 	// head
-	headPodSpec := &j.Spec.RayClusterSpec.HeadGroupSpec.Template.Spec
-	headPodSpec.NodeSelector = maps.MergeKeepFirst(podSetInfos[0].NodeSelector, headPodSpec.NodeSelector)
+	jobframework.MergePodTemplate(&j.Spec.RayClusterSpec.HeadGroupSpec.Template, podSetInfos[0])
 
 	// workers
 	for index := range j.Spec.RayClusterSpec.WorkerGroupSpecs {
-		workerPodSpec := &j.Spec.RayClusterSpec.WorkerGroupSpecs[index].Template.Spec
-		workerPodSpec.NodeSelector = maps.MergeKeepFirst(podSetInfos[index+1].NodeSelector, workerPodSpec.NodeSelector)
+		jobframework.MergePodTemplate(&j.Spec.RayClusterSpec.WorkerGroupSpecs[index].Template, podSetInfos[index+1])
 	}
 	return nil
 }
@@ -138,19 +143,11 @@ func (j *RayJob) RestorePodSetsInfo(podSetInfos []jobframework.PodSetInfo) bool
 
 	changed := false
 	// head
-	headPodSpec := &j.Spec.RayClusterSpec.HeadGroupSpec.Template.Spec
-	if !equality.Semantic.DeepEqual(headPodSpec.NodeSelector, podSetInfos[0].NodeSelector) {
-		headPodSpec.NodeSelector = maps.Clone(podSetInfos[0].NodeSelector)
-		changed = true
-	}
+	changed = jobframework.RestorePodTemplate(&j.Spec.RayClusterSpec.HeadGroupSpec.Template, podSetInfos[0]) || changed
 
 	// workers
 	for index := range j.Spec.RayClusterSpec.WorkerGroupSpecs {
-		workerPodSpec := &j.Spec.RayClusterSpec.WorkerGroupSpecs[index].Template.Spec
-		if !equality.Semantic.DeepEqual(workerPodSpec.NodeSelector, podSetInfos[index+1].NodeSelector) {
-			workerPodSpec.NodeSelector = maps.Clone(podSetInfos[index+1].NodeSelector)
-			changed = true
-		}
+		changed = jobframework.RestorePodTemplate(&j.Spec.RayClusterSpec.WorkerGroupSpecs[index].Template, podSetInfos[index+1]) || changed
 	}
 	return changed
 }
@@ -169,6 +166,16 @@ func (j *RayJob) PodsReady() bool {
 	return j.Status.RayClusterStatus.State == rayjobapi.Ready
 }
 
+// NotifyPreemption sets the preemption deadline annotation in the running
+// pods of the cluster of the job. The pods can read it through a downwardAPI
+// volume.
+func (j *RayJob) NotifyPreemption(ctx context.Context, c client.Client, deadline time.Time) error {
+	if j.Status.RayClusterName == "" {
+		return nil
+	}
+	return jobframework.NotifyPodsPreemption(ctx, c, deadline, client.InNamespace(j.Namespace), client.MatchingLabels{rayutils.RayClusterLabelKey: j.Status.RayClusterName})
+}
+
 func SetupIndexes(ctx context.Context, indexer client.FieldIndexer) error {
 	return jobframework.SetupWorkloadOwnerIndex(ctx, indexer, gvk)
 }
@@ -117,6 +117,10 @@ func (w *RayJobWebhook) validateCreate(job *rayjobapi.RayJob) field.ErrorList {
 	}
 
 	allErrors = append(allErrors, jobframework.ValidateCreateForQueueName(kueueJob)...)
+	allErrors = append(allErrors, jobframework.ValidateCreateForWorkloadPriorityClassName(kueueJob)...)
+	allErrors = append(allErrors, jobframework.ValidateWorkloadActive(kueueJob)...)
+	allErrors = append(allErrors, jobframework.ValidateMaxExecTime(kueueJob)...)
+	allErrors = append(allErrors, jobframework.ValidatePreemptionGracePeriod(kueueJob)...)
 	return allErrors
 }
 
@@ -128,6 +132,7 @@ func (w *RayJobWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runti
 	if w.manageJobsWithoutQueueName || jobframework.QueueName((*RayJob)(newJob)) != "" {
 		log.Info("Validating update", "job", klog.KObj(newJob))
 		allErrors := jobframework.ValidateUpdateForQueueName((*RayJob)(oldJob), (*RayJob)(newJob))
+		allErrors = append(allErrors, jobframework.ValidateUpdateForWorkloadPriorityClassName((*RayJob)(oldJob), (*RayJob)(newJob))...)
 		allErrors = append(allErrors, w.validateCreate(newJob)...)
 		return nil, allErrors.ToAggregate()
 	}
@@ -233,6 +233,52 @@ func TestValidateUpdate(t *testing.T) {
 				Obj(),
 			wantErr: nil,
 		},
+		"managed - deactivated": {
+			oldJob: testingrayutil.MakeJob("job", "ns").
+				Queue("queue").
+				Obj(),
+			newJob: testingrayutil.MakeJob("job", "ns").
+				Queue("queue").
+				Annotation(constants.WorkloadActiveAnnotation, "false").
+				Obj(),
+			wantErr: nil,
+		},
+		"invalid managed - workload active annotation": {
+			oldJob: testingrayutil.MakeJob("job", "ns").
+				Queue("queue").
+				Obj(),
+			newJob: testingrayutil.MakeJob("job", "ns").
+				Queue("queue").
+				Annotation(constants.WorkloadActiveAnnotation, "no").
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("metadata", "annotations").Key(constants.WorkloadActiveAnnotation), "no", "must be true or false"),
+			}.ToAggregate(),
+		},
+		"invalid managed - max exec time label": {
+			oldJob: testingrayutil.MakeJob("job", "ns").
+				Queue("queue").
+				Obj(),
+			newJob: testingrayutil.MakeJob("job", "ns").
+				Queue("queue").
+				Label(constants.MaxExecTimeSecondsLabel, "0").
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("metadata", "labels").Key(constants.MaxExecTimeSecondsLabel), "0", "must be a positive integer"),
+			}.ToAggregate(),
+		},
+		"invalid managed - preemption grace period label": {
+			oldJob: testingrayutil.MakeJob("job", "ns").
+				Queue("queue").
+				Obj(),
+			newJob: testingrayutil.MakeJob("job", "ns").
+				Queue("queue").
+				Label(constants.PreemptionGracePeriodSecondsLabel, "-1").
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("metadata", "labels").Key(constants.PreemptionGracePeriodSecondsLabel), "-1", "must be a non-negative integer"),
+			}.ToAggregate(),
+		},
 	}
 
 	for name, tc := range testcases {
@@ -33,6 +33,22 @@ const (
 	//
 	// Enables partial admission.
 	PartialAdmission featuregate.Feature = "PartialAdmission"
+
+	// alpha: v0.5
+	//
+	// Enables the built-in provisioning request admission check controller.
+	ProvisioningACC featuregate.Feature = "ProvisioningACC"
+
+	// alpha: v0.5
+	//
+	// Enables the visibility API for the pending workloads in the queues.
+	VisibilityOnDemand featuregate.Feature = "VisibilityOnDemand"
+
+	// alpha: v0.5
+	//
+	// Enables the tracking of the capacity of the nodes and of the usage of
+	// the pods for topology-aware scheduling.
+	TopologyAwareScheduling featuregate.Feature = "TopologyAwareScheduling"
 )
 
 func init() {
@@ -47,6 +63,12 @@ func init() {
 // when adding or removing one entry.
 var defaultFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	PartialAdmission: {Default: false, PreRelease: featuregate.Alpha},
+
+	ProvisioningACC: {Default: false, PreRelease: featuregate.Alpha},
+
+	VisibilityOnDemand: {Default: false, PreRelease: featuregate.Alpha},
+
+	TopologyAwareScheduling: {Default: false, PreRelease: featuregate.Alpha},
 }
 
 func SetFeatureGateDuringTest(tb testing.TB, f featuregate.Feature, value bool) func() {
@@ -36,6 +36,9 @@ func TestBestEffortFIFORequeueIfNotPresent(t *testing.T) {
 		RequeueReasonNamespaceMismatch: {
 			wantInadmissible: true,
 		},
+		RequeueReasonLocalQueueLimit: {
+			wantInadmissible: true,
+		},
 		RequeueReasonGeneric: {
 			wantInadmissible: true,
 		},
@@ -18,6 +18,7 @@ package queue
 
 import (
 	"context"
+	"sort"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -33,10 +34,23 @@ import (
 	"sigs.k8s.io/kueue/pkg/workload"
 )
 
+// workloadHeap holds the workloads of a ClusterQueue that are ready to be
+// popped.
+type workloadHeap interface {
+	PushOrUpdate(obj interface{})
+	PushIfNotPresent(obj interface{}) bool
+	Delete(key string)
+	Pop() interface{}
+	GetByKey(key string) interface{}
+	Len() int
+	List() []interface{}
+}
+
 // clusterQueueBase is an incomplete base implementation of ClusterQueue
 // interface. It can be inherited and overwritten by other types.
 type clusterQueueBase struct {
-	heap              heap.Heap
+	heap              workloadHeap
+	lessFunc          func(a, b interface{}) bool
 	cohort            string
 	namespaceSelector labels.Selector
 
@@ -54,8 +68,10 @@ type clusterQueueBase struct {
 }
 
 func newClusterQueueImpl(keyFunc func(obj interface{}) string, lessFunc func(a, b interface{}) bool) *clusterQueueBase {
+	h := heap.New(keyFunc, lessFunc)
 	return &clusterQueueBase{
-		heap:                   heap.New(keyFunc, lessFunc),
+		heap:                   &h,
+		lessFunc:               lessFunc,
 		inadmissibleWorkloads:  make(map[string]*workload.Info),
 		queueInadmissibleCycle: -1,
 	}
@@ -221,6 +237,34 @@ func (c *clusterQueueBase) DumpInadmissible() (sets.Set[string], bool) {
 	return elements, true
 }
 
+func (c *clusterQueueBase) PendingWorkloads() []*workload.Info {
+	active := make([]*workload.Info, 0, c.heap.Len())
+	for _, e := range c.heap.List() {
+		active = append(active, e.(*workload.Info))
+	}
+	c.sort(active)
+	return append(active, c.sortedInadmissible()...)
+}
+
+func (c *clusterQueueBase) sortedInadmissible() []*workload.Info {
+	inadmissible := make([]*workload.Info, 0, len(c.inadmissibleWorkloads))
+	for _, info := range c.inadmissibleWorkloads {
+		inadmissible = append(inadmissible, info)
+	}
+	c.sort(inadmissible)
+	return inadmissible
+}
+
+func (c *clusterQueueBase) sort(infos []*workload.Info) {
+	sort.Slice(infos, func(i, j int) bool {
+		if c.lessFunc(infos[i], infos[j]) != c.lessFunc(infos[j], infos[i]) {
+			return c.lessFunc(infos[i], infos[j])
+		}
+		// Equivalent workloads are sorted by key, to keep the order stable.
+		return workload.Key(infos[i].Obj) < workload.Key(infos[j].Obj)
+	})
+}
+
 func (c *clusterQueueBase) Info(key string) *workload.Info {
 	info := c.heap.GetByKey(key)
 	if info == nil {
@@ -354,3 +354,24 @@ func TestQueueInadmissibleWorkloadsDuringScheduling(t *testing.T) {
 		t.Errorf("Unexpected active workloads after scheduling (-want,+got):\n%s", diff)
 	}
 }
+
+func TestPendingWorkloads(t *testing.T) {
+	now := time.Now()
+	cq := newClusterQueueImpl(keyFunc, queueOrdering)
+	cq.PushOrUpdate(workload.NewInfo(utiltesting.MakeWorkload("low", defaultNamespace).Creation(now).Obj()))
+	cq.PushOrUpdate(workload.NewInfo(utiltesting.MakeWorkload("high", defaultNamespace).Priority(10).Creation(now.Add(time.Second)).Obj()))
+	cq.PushOrUpdate(workload.NewInfo(utiltesting.MakeWorkload("old", defaultNamespace).Creation(now.Add(-time.Second)).Obj()))
+	cq.PushOrUpdate(workload.NewInfo(utiltesting.MakeWorkload("tried", defaultNamespace).Priority(20).Creation(now).Obj()))
+
+	// The workload with the highest priority is tried and becomes inadmissible.
+	cq.requeueIfNotPresent(cq.Pop(), false)
+
+	var got []string
+	for _, info := range cq.PendingWorkloads() {
+		got = append(got, info.Obj.Name)
+	}
+	want := []string{"high", "old", "low", "tried"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected order of pending workloads (-want,+got):\n%s", diff)
+	}
+}
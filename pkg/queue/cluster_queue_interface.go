@@ -34,6 +34,7 @@ const (
 	RequeueReasonNamespaceMismatch     RequeueReason = "NamespaceMismatch"
 	RequeueReasonGeneric               RequeueReason = ""
 	RequeueReasonPendingPreemption     RequeueReason = "PendingPreemption"
+	RequeueReasonLocalQueueLimit       RequeueReason = "LocalQueueLimit"
 )
 
 // ClusterQueue is an interface for a cluster queue to store workloads waiting
@@ -92,6 +93,11 @@ type ClusterQueue interface {
 	// Otherwise returns true.
 	Dump() (sets.Set[string], bool)
 	DumpInadmissible() (sets.Set[string], bool)
+	// PendingWorkloads returns the pending workloads in the order in which
+	// they are evaluated for admission: the workloads in the heap, followed
+	// by the inadmissible workloads.
+	// Users of this method should not modify the returned objects.
+	PendingWorkloads() []*workload.Info
 	// Info returns workload.Info for the workload key.
 	// Users of this method should not modify the returned object.
 	Info(string) *workload.Info
@@ -100,6 +106,7 @@ type ClusterQueue interface {
 var registry = map[kueue.QueueingStrategy]func(cq *kueue.ClusterQueue) (ClusterQueue, error){
 	kueue.StrictFIFO:     newClusterQueueStrictFIFO,
 	kueue.BestEffortFIFO: newClusterQueueBestEffortFIFO,
+	kueue.RoundRobin:     newClusterQueueRoundRobin,
 }
 
 func newClusterQueue(cq *kueue.ClusterQueue) (ClusterQueue, error) {
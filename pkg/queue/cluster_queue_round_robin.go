@@ -0,0 +1,278 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/util/heap"
+	utilpriority "sigs.k8s.io/kueue/pkg/util/priority"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// ClusterQueueRoundRobin is the implementation for the ClusterQueue for
+// RoundRobin.
+// The workloads are taken from the LocalQueues using stride scheduling: every
+// time a LocalQueue is served, its pass advances by the inverse of its weight,
+// and the head of the LocalQueue with the lowest pass is served next. Heads
+// with a higher priority are always served first.
+type ClusterQueueRoundRobin struct {
+	*clusterQueueBase
+
+	// heaps are the workloads of the ClusterQueue, in one heap per
+	// LocalQueue. They are also the heap of the clusterQueueBase.
+	heaps *localQueueHeaps
+
+	// localQueues are the LocalQueues that send workloads to this
+	// ClusterQueue, by key. They provide the weights.
+	localQueues map[string]*LocalQueue
+
+	// passes are the virtual times at which the LocalQueues are served next.
+	passes map[string]float64
+
+	// virtualTime is the pass of the last LocalQueue served. A LocalQueue
+	// that had no workloads for a while is served no earlier than this, so
+	// that it doesn't take the turns it missed all at once.
+	virtualTime float64
+}
+
+var _ ClusterQueue = &ClusterQueueRoundRobin{}
+
+func newClusterQueueRoundRobin(cq *kueue.ClusterQueue) (ClusterQueue, error) {
+	cqImpl := newClusterQueueImpl(keyFunc, queueOrdering)
+	heaps := newLocalQueueHeaps(queueOrdering)
+	cqImpl.heap = heaps
+	cqRR := &ClusterQueueRoundRobin{
+		clusterQueueBase: cqImpl,
+		heaps:            heaps,
+		localQueues:      make(map[string]*LocalQueue),
+		passes:           make(map[string]float64),
+	}
+
+	err := cqRR.Update(cq)
+	return cqRR, err
+}
+
+func (cq *ClusterQueueRoundRobin) AddFromLocalQueue(q *LocalQueue) bool {
+	cq.localQueues[q.Key] = q
+	return cq.clusterQueueBase.AddFromLocalQueue(q)
+}
+
+func (cq *ClusterQueueRoundRobin) DeleteFromLocalQueue(q *LocalQueue) {
+	delete(cq.localQueues, q.Key)
+	delete(cq.passes, q.Key)
+	cq.clusterQueueBase.DeleteFromLocalQueue(q)
+}
+
+// RequeueIfNotPresent requeues the workload the same way as BestEffortFIFO.
+func (cq *ClusterQueueRoundRobin) RequeueIfNotPresent(wInfo *workload.Info, reason RequeueReason) bool {
+	return cq.requeueIfNotPresent(wInfo, reason == RequeueReasonFailedAfterNomination || reason == RequeueReasonPendingPreemption)
+}
+
+// Pop removes the head of the LocalQueue that is served next and returns it.
+// It returns nil if the queue is empty.
+func (cq *ClusterQueueRoundRobin) Pop() *workload.Info {
+	cq.popCycle++
+	if cq.heap.Len() == 0 {
+		return nil
+	}
+	_, info := cq.next(cq.heaps.heads(), cq.passes, &cq.virtualTime)
+	cq.heap.Delete(workload.Key(info.Obj))
+	return info
+}
+
+// PendingWorkloads returns the pending workloads in the order in which they
+// would be popped, followed by the inadmissible workloads.
+func (cq *ClusterQueueRoundRobin) PendingWorkloads() []*workload.Info {
+	byQueue := make(map[string][]*workload.Info)
+	for _, e := range cq.heap.List() {
+		info := e.(*workload.Info)
+		qKey := workload.QueueKey(info.Obj)
+		byQueue[qKey] = append(byQueue[qKey], info)
+	}
+	heads := make(map[string]*workload.Info, len(byQueue))
+	for qKey, infos := range byQueue {
+		cq.sort(infos)
+		heads[qKey] = infos[0]
+	}
+	passes := make(map[string]float64, len(cq.passes))
+	for qKey, pass := range cq.passes {
+		passes[qKey] = pass
+	}
+	virtualTime := cq.virtualTime
+
+	active := make([]*workload.Info, 0, cq.heap.Len())
+	for len(heads) > 0 {
+		qKey, info := cq.next(heads, passes, &virtualTime)
+		active = append(active, info)
+		byQueue[qKey] = byQueue[qKey][1:]
+		if len(byQueue[qKey]) > 0 {
+			heads[qKey] = byQueue[qKey][0]
+		} else {
+			delete(heads, qKey)
+		}
+	}
+	return append(active, cq.sortedInadmissible()...)
+}
+
+// next selects the head to serve among the heads of the LocalQueues and
+// advances the pass of its LocalQueue.
+func (cq *ClusterQueueRoundRobin) next(heads map[string]*workload.Info, passes map[string]float64, virtualTime *float64) (string, *workload.Info) {
+	var bestKey string
+	var best *workload.Info
+	var bestPass float64
+	for qKey, head := range heads {
+		pass := passes[qKey]
+		if pass < *virtualTime {
+			pass = *virtualTime
+		}
+		if best == nil || servedBefore(head, pass, best, bestPass) {
+			bestKey, best, bestPass = qKey, head, pass
+		}
+	}
+	if best == nil {
+		return "", nil
+	}
+	weight := int32(1)
+	if q := cq.localQueues[bestKey]; q != nil && q.Weight > 0 {
+		weight = q.Weight
+	}
+	*virtualTime = bestPass
+	passes[bestKey] = bestPass + 1/float64(weight)
+	return bestKey, best
+}
+
+// servedBefore returns whether the head a of a LocalQueue with pass aPass is
+// served before the head b of a LocalQueue with pass bPass.
+func servedBefore(a *workload.Info, aPass float64, b *workload.Info, bPass float64) bool {
+	if pa, pb := utilpriority.Priority(a.Obj), utilpriority.Priority(b.Obj); pa != pb {
+		return pa > pb
+	}
+	if aPass != bPass {
+		return aPass < bPass
+	}
+	if queueOrdering(a, b) != queueOrdering(b, a) {
+		return queueOrdering(a, b)
+	}
+	return workload.Key(a.Obj) < workload.Key(b.Obj)
+}
+
+// localQueueHeaps keeps the workloads in one heap per LocalQueue, so that the
+// heads of the LocalQueues are found without going through all the workloads.
+type localQueueHeaps struct {
+	lessFunc func(a, b interface{}) bool
+	// heaps are the non-empty heaps, by LocalQueue key.
+	heaps map[string]*heap.Heap
+	// queues are the LocalQueue keys of the workloads, by workload key.
+	queues map[string]string
+}
+
+var _ workloadHeap = &localQueueHeaps{}
+
+func newLocalQueueHeaps(lessFunc func(a, b interface{}) bool) *localQueueHeaps {
+	return &localQueueHeaps{
+		lessFunc: lessFunc,
+		heaps:    make(map[string]*heap.Heap),
+		queues:   make(map[string]string),
+	}
+}
+
+func (h *localQueueHeaps) PushOrUpdate(obj interface{}) {
+	info := obj.(*workload.Info)
+	key, qKey := workload.Key(info.Obj), workload.QueueKey(info.Obj)
+	if oldQKey, found := h.queues[key]; found && oldQKey != qKey {
+		h.Delete(key)
+	}
+	h.queues[key] = qKey
+	h.heap(qKey).PushOrUpdate(info)
+}
+
+func (h *localQueueHeaps) PushIfNotPresent(obj interface{}) bool {
+	info := obj.(*workload.Info)
+	key, qKey := workload.Key(info.Obj), workload.QueueKey(info.Obj)
+	if _, found := h.queues[key]; found {
+		return false
+	}
+	h.queues[key] = qKey
+	return h.heap(qKey).PushIfNotPresent(info)
+}
+
+func (h *localQueueHeaps) Delete(key string) {
+	qKey, found := h.queues[key]
+	if !found {
+		return
+	}
+	delete(h.queues, key)
+	qHeap := h.heaps[qKey]
+	qHeap.Delete(key)
+	if qHeap.Len() == 0 {
+		delete(h.heaps, qKey)
+	}
+}
+
+// Pop removes and returns the first of the heads of the LocalQueues.
+func (h *localQueueHeaps) Pop() interface{} {
+	var best *workload.Info
+	for _, head := range h.heads() {
+		if best == nil || h.lessFunc(head, best) || (!h.lessFunc(best, head) && workload.Key(head.Obj) < workload.Key(best.Obj)) {
+			best = head
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	h.Delete(workload.Key(best.Obj))
+	return best
+}
+
+func (h *localQueueHeaps) GetByKey(key string) interface{} {
+	qKey, found := h.queues[key]
+	if !found {
+		return nil
+	}
+	return h.heaps[qKey].GetByKey(key)
+}
+
+func (h *localQueueHeaps) Len() int {
+	return len(h.queues)
+}
+
+func (h *localQueueHeaps) List() []interface{} {
+	list := make([]interface{}, 0, len(h.queues))
+	for _, qHeap := range h.heaps {
+		list = append(list, qHeap.List()...)
+	}
+	return list
+}
+
+// heads returns the head of each LocalQueue, by LocalQueue key.
+func (h *localQueueHeaps) heads() map[string]*workload.Info {
+	heads := make(map[string]*workload.Info, len(h.heaps))
+	for qKey, qHeap := range h.heaps {
+		heads[qKey] = qHeap.Peek().(*workload.Info)
+	}
+	return heads
+}
+
+func (h *localQueueHeaps) heap(qKey string) *heap.Heap {
+	qHeap, found := h.heaps[qKey]
+	if !found {
+		newHeap := heap.New(keyFunc, h.lessFunc)
+		qHeap = &newHeap
+		h.heaps[qKey] = qHeap
+	}
+	return qHeap
+}
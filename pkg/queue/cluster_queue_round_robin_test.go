@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+func TestRoundRobinPop(t *testing.T) {
+	now := time.Now()
+	makeWorkload := func(name, queue string, created int, priority int32) *kueue.Workload {
+		return utiltesting.MakeWorkload(name, defaultNamespace).
+			Queue(queue).
+			Creation(now.Add(time.Duration(created) * time.Second)).
+			Priority(priority).
+			Obj()
+	}
+	workloads := []*kueue.Workload{
+		makeWorkload("a1", "a", 1, 0),
+		makeWorkload("a2", "a", 2, 0),
+		makeWorkload("a3", "a", 3, 0),
+		makeWorkload("a4", "a", 4, 0),
+		makeWorkload("b1", "b", 5, 0),
+		makeWorkload("b2", "b", 6, 0),
+	}
+
+	cases := map[string]struct {
+		weightA      int32
+		weightB      int32
+		workloads    []*kueue.Workload
+		wantPopOrder []string
+	}{
+		"the LocalQueues take turns": {
+			weightA:      1,
+			weightB:      1,
+			workloads:    workloads,
+			wantPopOrder: []string{"a1", "b1", "a2", "b2", "a3", "a4"},
+		},
+		"the LocalQueue with a higher weight gets more turns": {
+			weightA:      2,
+			weightB:      1,
+			workloads:    workloads,
+			wantPopOrder: []string{"a1", "b1", "a2", "a3", "b2", "a4"},
+		},
+		"workloads with a higher priority go first, using the turn of their LocalQueue": {
+			weightA: 1,
+			weightB: 1,
+			workloads: append([]*kueue.Workload{
+				makeWorkload("b0", "b", 7, 100),
+			}, workloads...),
+			wantPopOrder: []string{"b0", "a1", "a2", "b1", "a3", "b2", "a4"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cq, err := newClusterQueueRoundRobin(utiltesting.MakeClusterQueue("cq").QueueingStrategy(kueue.RoundRobin).Obj())
+			if err != nil {
+				t.Fatalf("Failed creating ClusterQueue: %v", err)
+			}
+			cq.AddFromLocalQueue(newLocalQueue(utiltesting.MakeLocalQueue("a", defaultNamespace).Weight(tc.weightA).Obj()))
+			cq.AddFromLocalQueue(newLocalQueue(utiltesting.MakeLocalQueue("b", defaultNamespace).Weight(tc.weightB).Obj()))
+			for _, wl := range tc.workloads {
+				cq.PushOrUpdate(workload.NewInfo(wl))
+			}
+
+			var gotPending []string
+			for _, info := range cq.PendingWorkloads() {
+				gotPending = append(gotPending, info.Obj.Name)
+			}
+			if diff := cmp.Diff(tc.wantPopOrder, gotPending); diff != "" {
+				t.Errorf("Unexpected pending workloads order (-want,+got):\n%s", diff)
+			}
+
+			var gotPopOrder []string
+			for info := cq.Pop(); info != nil; info = cq.Pop() {
+				gotPopOrder = append(gotPopOrder, info.Obj.Name)
+			}
+			if diff := cmp.Diff(tc.wantPopOrder, gotPopOrder); diff != "" {
+				t.Errorf("Unexpected pop order (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLocalQueueHeaps(t *testing.T) {
+	now := time.Now()
+	h := newLocalQueueHeaps(queueOrdering)
+	a1 := workload.NewInfo(utiltesting.MakeWorkload("a1", defaultNamespace).Queue("a").Creation(now).Obj())
+	a2 := workload.NewInfo(utiltesting.MakeWorkload("a2", defaultNamespace).Queue("a").Creation(now.Add(time.Second)).Obj())
+	b1 := workload.NewInfo(utiltesting.MakeWorkload("b1", defaultNamespace).Queue("b").Creation(now.Add(2 * time.Second)).Obj())
+	for _, info := range []*workload.Info{a2, a1, b1} {
+		if !h.PushIfNotPresent(info) {
+			t.Fatalf("Failed pushing workload %s", workload.Key(info.Obj))
+		}
+	}
+	if h.PushIfNotPresent(a1) {
+		t.Errorf("Pushed workload %s twice", workload.Key(a1.Obj))
+	}
+
+	wantHeads := func(want map[string]string) {
+		t.Helper()
+		got := make(map[string]string)
+		for qKey, info := range h.heads() {
+			got[qKey] = info.Obj.Name
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Unexpected heads (-want,+got):\n%s", diff)
+		}
+	}
+	wantHeads(map[string]string{
+		defaultNamespace + "/a": "a1",
+		defaultNamespace + "/b": "b1",
+	})
+
+	// Moving a workload to another LocalQueue takes it out of the old heap.
+	moved := workload.NewInfo(utiltesting.MakeWorkload("a1", defaultNamespace).Queue("b").Creation(now).Obj())
+	h.PushOrUpdate(moved)
+	wantHeads(map[string]string{
+		defaultNamespace + "/a": "a2",
+		defaultNamespace + "/b": "a1",
+	})
+	if got := h.GetByKey(workload.Key(moved.Obj)); got != moved {
+		t.Errorf("Unexpected workload %v after moving it", got)
+	}
+
+	// Emptied LocalQueues don't have a head.
+	h.Delete(workload.Key(a2.Obj))
+	wantHeads(map[string]string{
+		defaultNamespace + "/b": "a1",
+	})
+	if h.Len() != 2 {
+		t.Errorf("Unexpected number of workloads %d, want 2", h.Len())
+	}
+}
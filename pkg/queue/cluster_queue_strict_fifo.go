@@ -61,7 +61,9 @@ func queueOrdering(a, b interface{}) bool {
 
 // RequeueIfNotPresent requeues if the workload is not present.
 // If the reason for requeue is that the workload doesn't match the CQ's
-// namespace selector, then the requeue is not immediate.
+// namespace selector, or that it exceeds the limits of its LocalQueue, then
+// the requeue is not immediate, so that the workload doesn't block the
+// workloads from other LocalQueues.
 func (cq *ClusterQueueStrictFIFO) RequeueIfNotPresent(wInfo *workload.Info, reason RequeueReason) bool {
-	return cq.requeueIfNotPresent(wInfo, reason != RequeueReasonNamespaceMismatch)
+	return cq.requeueIfNotPresent(wInfo, reason != RequeueReasonNamespaceMismatch && reason != RequeueReasonLocalQueueLimit)
 }
@@ -233,6 +233,9 @@ func TestStrictFIFORequeueIfNotPresent(t *testing.T) {
 		RequeueReasonNamespaceMismatch: {
 			wantInadmissible: true,
 		},
+		RequeueReasonLocalQueueLimit: {
+			wantInadmissible: true,
+		},
 		RequeueReasonGeneric: {
 			wantInadmissible: false,
 		},
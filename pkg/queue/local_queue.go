@@ -19,6 +19,8 @@ package queue
 import (
 	"fmt"
 
+	"k8s.io/utils/pointer"
+
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/workload"
 )
@@ -37,6 +39,12 @@ func Key(q *kueue.LocalQueue) string {
 type LocalQueue struct {
 	Key          string
 	ClusterQueue string
+	// Stopped indicates that the workloads of the LocalQueue shouldn't be
+	// admitted, because of its stop policy.
+	Stopped bool
+	// Weight is the share of the admission turns of the LocalQueue in a
+	// ClusterQueue with the RoundRobin queueing strategy.
+	Weight int32
 
 	items map[string]*workload.Info
 }
@@ -52,6 +60,8 @@ func newLocalQueue(q *kueue.LocalQueue) *LocalQueue {
 
 func (q *LocalQueue) update(apiQueue *kueue.LocalQueue) {
 	q.ClusterQueue = string(apiQueue.Spec.ClusterQueue)
+	q.Stopped = apiQueue.Spec.StopPolicy != nil && *apiQueue.Spec.StopPolicy != kueue.None
+	q.Weight = pointer.Int32Deref(apiQueue.Spec.Weight, 1)
 }
 
 func (q *LocalQueue) AddOrUpdate(info *workload.Info) {
@@ -50,6 +50,8 @@ type Manager struct {
 
 	// Key is cohort's name. Value is a set of associated ClusterQueue names.
 	cohorts map[string]sets.Set[string]
+	// Key is cohort's name. Value is the name of its parent cohort.
+	cohortParents map[string]string
 }
 
 func NewManager(client client.Client, checker StatusChecker) *Manager {
@@ -59,6 +61,7 @@ func NewManager(client client.Client, checker StatusChecker) *Manager {
 		localQueues:   make(map[string]*LocalQueue),
 		clusterQueues: make(map[string]ClusterQueue),
 		cohorts:       make(map[string]sets.Set[string]),
+		cohortParents: make(map[string]string),
 	}
 	m.cond.L = &m.RWMutex
 	return m
@@ -92,7 +95,7 @@ func (m *Manager) AddClusterQueue(ctx context.Context, cq *kueue.ClusterQueue) e
 	addedWorkloads := false
 	for _, q := range queues.Items {
 		qImpl := m.localQueues[Key(&q)]
-		if qImpl != nil {
+		if qImpl != nil && !qImpl.Stopped {
 			added := cqImpl.AddFromLocalQueue(qImpl)
 			addedWorkloads = addedWorkloads || added
 		}
@@ -165,13 +168,13 @@ func (m *Manager) AddLocalQueue(ctx context.Context, q *kueue.LocalQueue) error
 	}
 	for _, w := range workloads.Items {
 		w := w
-		if workload.IsAdmitted(&w) {
+		if workload.HasQuotaReservation(&w) || !workload.IsActive(&w) || workload.IsWaitingForRequeue(&w) {
 			continue
 		}
 		qImpl.AddOrUpdate(workload.NewInfo(&w))
 	}
 	cq := m.clusterQueues[qImpl.ClusterQueue]
-	if cq != nil && cq.AddFromLocalQueue(qImpl) {
+	if cq != nil && !qImpl.Stopped && cq.AddFromLocalQueue(qImpl) {
 		m.Broadcast()
 	}
 	return nil
@@ -184,17 +187,21 @@ func (m *Manager) UpdateLocalQueue(q *kueue.LocalQueue) error {
 	if !ok {
 		return errQueueDoesNotExist
 	}
-	if qImpl.ClusterQueue != string(q.Spec.ClusterQueue) {
-		oldCQ := m.clusterQueues[qImpl.ClusterQueue]
-		if oldCQ != nil {
-			oldCQ.DeleteFromLocalQueue(qImpl)
-		}
-		newCQ := m.clusterQueues[string(q.Spec.ClusterQueue)]
-		if newCQ != nil && newCQ.AddFromLocalQueue(qImpl) {
+	oldCQName, wasStopped := qImpl.ClusterQueue, qImpl.Stopped
+	qImpl.update(q)
+	if oldCQName == qImpl.ClusterQueue && wasStopped == qImpl.Stopped {
+		return nil
+	}
+	if oldCQ := m.clusterQueues[oldCQName]; oldCQ != nil && !wasStopped {
+		oldCQ.DeleteFromLocalQueue(qImpl)
+		m.reportPendingWorkloads(oldCQName, oldCQ)
+	}
+	if newCQ := m.clusterQueues[qImpl.ClusterQueue]; newCQ != nil && !qImpl.Stopped {
+		if newCQ.AddFromLocalQueue(qImpl) {
 			m.Broadcast()
 		}
+		m.reportPendingWorkloads(qImpl.ClusterQueue, newCQ)
 	}
-	qImpl.update(q)
 	return nil
 }
 
@@ -253,6 +260,7 @@ func (m *Manager) ClusterQueueForWorkload(wl *kueue.Workload) (string, bool) {
 }
 
 // AddOrUpdateWorkload adds or updates workload to the corresponding queue.
+// An inactive workload is removed from the queue instead.
 // Returns whether the queue existed.
 func (m *Manager) AddOrUpdateWorkload(w *kueue.Workload) bool {
 	m.Lock()
@@ -266,12 +274,23 @@ func (m *Manager) addOrUpdateWorkload(w *kueue.Workload) bool {
 	if q == nil {
 		return false
 	}
+	if !workload.IsActive(w) || workload.IsWaitingForRequeue(w) {
+		// Inactive workloads are kept out of the queues until reactivated,
+		// and workloads backing off until their requeueAt time.
+		m.deleteWorkloadFromQueueAndClusterQueue(w, qKey)
+		return true
+	}
 	wInfo := workload.NewInfo(w)
 	q.AddOrUpdate(wInfo)
 	cq := m.clusterQueues[q.ClusterQueue]
 	if cq == nil {
 		return false
 	}
+	if q.Stopped {
+		// The workload is only queued in the ClusterQueue once the LocalQueue
+		// is no longer stopped.
+		return true
+	}
 	cq.PushOrUpdate(wInfo)
 	m.reportPendingWorkloads(q.ClusterQueue, cq)
 	m.Broadcast()
@@ -289,7 +308,7 @@ func (m *Manager) RequeueWorkload(ctx context.Context, info *workload.Info, reas
 	// Always get the newest workload to avoid requeuing the out-of-date obj.
 	err := m.client.Get(ctx, client.ObjectKeyFromObject(info.Obj), &w)
 	// Since the client is cached, the only possible error is NotFound
-	if apierrors.IsNotFound(err) || workload.IsAdmitted(&w) {
+	if apierrors.IsNotFound(err) || workload.HasQuotaReservation(&w) || !workload.IsActive(&w) || workload.IsWaitingForRequeue(&w) {
 		return false
 	}
 
@@ -300,7 +319,7 @@ func (m *Manager) RequeueWorkload(ctx context.Context, info *workload.Info, reas
 	info.Update(&w)
 	q.AddOrUpdate(info)
 	cq := m.clusterQueues[q.ClusterQueue]
-	if cq == nil {
+	if cq == nil || q.Stopped {
 		return false
 	}
 
@@ -389,6 +408,7 @@ func (m *Manager) QueueInadmissibleWorkloads(ctx context.Context, cqNames sets.S
 // cohort of this ClusterQueue is empty, it just moves all workloads in this
 // ClusterQueue. If at least one workload is moved, returns true. Otherwise
 // returns false.
+// The cohort includes the whole tree of cohorts the ClusterQueue belongs to.
 // The events listed below could make workloads in the same cohort admissible.
 // Then queueAllInadmissibleWorkloadsInCohort need to be invoked.
 // 1. delete events for any admitted workload in the cohort.
@@ -401,14 +421,49 @@ func (m *Manager) queueAllInadmissibleWorkloadsInCohort(ctx context.Context, cq
 	}
 
 	queued := false
-	for cqName := range m.cohorts[cohort] {
-		if clusterQueue, ok := m.clusterQueues[cqName]; ok {
-			queued = clusterQueue.QueueInadmissibleWorkloads(ctx, m.client) || queued
+	root := m.rootCohort(cohort)
+	for cohortName, cqNames := range m.cohorts {
+		if m.rootCohort(cohortName) != root {
+			continue
+		}
+		for cqName := range cqNames {
+			if clusterQueue, ok := m.clusterQueues[cqName]; ok {
+				queued = clusterQueue.QueueInadmissibleWorkloads(ctx, m.client) || queued
+			}
 		}
 	}
 	return queued
 }
 
+// rootCohort returns the name of the cohort at the top of the tree the cohort
+// belongs to.
+func (m *Manager) rootCohort(cohort string) string {
+	seen := sets.New(cohort)
+	for parent := m.cohortParents[cohort]; parent != "" && !seen.Has(parent); parent = m.cohortParents[cohort] {
+		seen.Insert(parent)
+		cohort = parent
+	}
+	return cohort
+}
+
+// AddOrUpdateCohort records the parent of the cohort, so that the workloads
+// in the whole cohort tree are requeued when quota is released in it.
+func (m *Manager) AddOrUpdateCohort(cohort *kueue.Cohort) {
+	m.Lock()
+	defer m.Unlock()
+	if cohort.Spec.Parent == "" {
+		delete(m.cohortParents, cohort.Name)
+		return
+	}
+	m.cohortParents[cohort.Name] = cohort.Spec.Parent
+}
+
+func (m *Manager) DeleteCohort(name string) {
+	m.Lock()
+	defer m.Unlock()
+	delete(m.cohortParents, name)
+}
+
 // UpdateWorkload updates the workload to the corresponding queue or adds it if
 // it didn't exist. Returns whether the queue existed.
 func (m *Manager) UpdateWorkload(oldW, w *kueue.Workload) bool {
@@ -489,6 +544,31 @@ func (m *Manager) DumpInadmissible() map[string]sets.Set[string] {
 	return dump
 }
 
+// PendingWorkloadsInClusterQueue returns the pending workloads of the
+// ClusterQueue, in the order in which they are evaluated for admission.
+// Users of this method should not modify the returned objects.
+func (m *Manager) PendingWorkloadsInClusterQueue(cqName string) ([]*workload.Info, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	cq := m.clusterQueues[cqName]
+	if cq == nil {
+		return nil, false
+	}
+	return cq.PendingWorkloads(), true
+}
+
+// ClusterQueueForLocalQueue returns the name of the ClusterQueue the
+// LocalQueue with the given key points to.
+func (m *Manager) ClusterQueueForLocalQueue(qKey string) (string, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	q := m.localQueues[qKey]
+	if q == nil {
+		return "", false
+	}
+	return q.ClusterQueue, true
+}
+
 func (m *Manager) heads() []workload.Info {
 	var workloads []workload.Info
 	for cqName, cq := range m.clusterQueues {
@@ -29,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
@@ -239,6 +240,111 @@ func TestUpdateLocalQueue(t *testing.T) {
 	}
 }
 
+// TestStopLocalQueue tests that the workloads of a stopped LocalQueue are
+// only listed in the ClusterQueue once the LocalQueue is resumed.
+func TestStopLocalQueue(t *testing.T) {
+	cq := utiltesting.MakeClusterQueue("cq").Obj()
+	q := utiltesting.MakeLocalQueue("foo", "").ClusterQueue("cq").StopPolicy(kueue.Hold).Obj()
+	ctx := context.Background()
+	manager := NewManager(utiltesting.NewFakeClient(), nil)
+	if err := manager.AddClusterQueue(ctx, cq); err != nil {
+		t.Fatalf("Failed adding clusterQueue %s: %v", cq.Name, err)
+	}
+	if err := manager.AddLocalQueue(ctx, q); err != nil {
+		t.Fatalf("Failed adding queue %s: %v", q.Name, err)
+	}
+	if !manager.AddOrUpdateWorkload(utiltesting.MakeWorkload("a", "").Queue("foo").Obj()) {
+		t.Fatal("Failed adding workload to a stopped queue")
+	}
+	if diff := cmp.Diff(map[string]sets.Set[string](nil), manager.Dump()); diff != "" {
+		t.Errorf("Unexpected workloads in a stopped queue (-want,+got):\n%s", diff)
+	}
+	if pending, err := manager.PendingWorkloads(q); err != nil || pending != 1 {
+		t.Errorf("PendingWorkloads(_) = %d, %v; want 1, nil", pending, err)
+	}
+
+	q = utiltesting.MakeLocalQueue("foo", "").ClusterQueue("cq").StopPolicy(kueue.None).Obj()
+	if err := manager.UpdateLocalQueue(q); err != nil {
+		t.Fatalf("Failed updating queue: %v", err)
+	}
+	wantDump := map[string]sets.Set[string]{
+		"cq": sets.New("/a"),
+	}
+	if diff := cmp.Diff(wantDump, manager.Dump()); diff != "" {
+		t.Errorf("Unexpected workloads after resuming the queue (-want,+got):\n%s", diff)
+	}
+}
+
+// TestDeactivateWorkload tests that an inactive workload is removed from the
+// queues, and that it's queued again once reactivated.
+func TestDeactivateWorkload(t *testing.T) {
+	cq := utiltesting.MakeClusterQueue("cq").Obj()
+	q := utiltesting.MakeLocalQueue("foo", "").ClusterQueue("cq").Obj()
+	ctx := context.Background()
+	manager := NewManager(utiltesting.NewFakeClient(), nil)
+	if err := manager.AddClusterQueue(ctx, cq); err != nil {
+		t.Fatalf("Failed adding clusterQueue %s: %v", cq.Name, err)
+	}
+	if err := manager.AddLocalQueue(ctx, q); err != nil {
+		t.Fatalf("Failed adding queue %s: %v", q.Name, err)
+	}
+	wl := utiltesting.MakeWorkload("a", "").Queue("foo").Obj()
+	manager.AddOrUpdateWorkload(wl)
+
+	inactiveWl := utiltesting.MakeWorkload("a", "").Queue("foo").Active(false).Obj()
+	if !manager.UpdateWorkload(wl, inactiveWl) {
+		t.Fatal("Failed updating the workload")
+	}
+	if diff := cmp.Diff(map[string]sets.Set[string](nil), manager.Dump()); diff != "" {
+		t.Errorf("Unexpected workloads after deactivation (-want,+got):\n%s", diff)
+	}
+	if pending, err := manager.PendingWorkloads(q); err != nil || pending != 0 {
+		t.Errorf("PendingWorkloads(_) = %d, %v; want 0, nil", pending, err)
+	}
+
+	if !manager.UpdateWorkload(inactiveWl, wl) {
+		t.Fatal("Failed updating the workload")
+	}
+	wantDump := map[string]sets.Set[string]{
+		"cq": sets.New("/a"),
+	}
+	if diff := cmp.Diff(wantDump, manager.Dump()); diff != "" {
+		t.Errorf("Unexpected workloads after reactivation (-want,+got):\n%s", diff)
+	}
+}
+
+// TestWorkloadWaitingForRequeue tests that a workload with a requeueAt time
+// is kept out of the queues until the time is cleared.
+func TestWorkloadWaitingForRequeue(t *testing.T) {
+	cq := utiltesting.MakeClusterQueue("cq").Obj()
+	q := utiltesting.MakeLocalQueue("foo", "").ClusterQueue("cq").Obj()
+	ctx := context.Background()
+	manager := NewManager(utiltesting.NewFakeClient(), nil)
+	if err := manager.AddClusterQueue(ctx, cq); err != nil {
+		t.Fatalf("Failed adding clusterQueue %s: %v", cq.Name, err)
+	}
+	if err := manager.AddLocalQueue(ctx, q); err != nil {
+		t.Fatalf("Failed adding queue %s: %v", q.Name, err)
+	}
+	requeueAt := metav1.NewTime(time.Now().Add(time.Minute))
+	waitingWl := utiltesting.MakeWorkload("a", "").Queue("foo").RequeueState(pointer.Int32(1), &requeueAt).Obj()
+	manager.AddOrUpdateWorkload(waitingWl)
+	if diff := cmp.Diff(map[string]sets.Set[string](nil), manager.Dump()); diff != "" {
+		t.Errorf("Unexpected workloads while waiting for requeue (-want,+got):\n%s", diff)
+	}
+
+	wl := utiltesting.MakeWorkload("a", "").Queue("foo").RequeueState(pointer.Int32(1), nil).Obj()
+	if !manager.UpdateWorkload(waitingWl, wl) {
+		t.Fatal("Failed updating the workload")
+	}
+	wantDump := map[string]sets.Set[string]{
+		"cq": sets.New("/a"),
+	}
+	if diff := cmp.Diff(wantDump, manager.Dump()); diff != "" {
+		t.Errorf("Unexpected workloads after the requeueAt time is cleared (-want,+got):\n%s", diff)
+	}
+}
+
 // TestDeleteLocalQueue tests that when a LocalQueue is deleted, all its
 // workloads are not listed in the ClusterQueue.
 func TestDeleteLocalQueue(t *testing.T) {
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1alpha1"
+	"sigs.k8s.io/kueue/pkg/scheduler/flavorassigner"
+	"sigs.k8s.io/kueue/pkg/util/priority"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// DryRun evaluates the admission of the workload like in a scheduling cycle,
+// against a snapshot of the cache. The workload is not admitted and no
+// workloads are preempted.
+func (s *Scheduler) DryRun(ctx context.Context, wl *kueue.Workload) (*visibility.DryRunResult, error) {
+	cqName, _ := s.queues.ClusterQueueForWorkload(wl)
+	if cqName == "" {
+		return nil, apierrors.NewNotFound(kueue.Resource("localqueues"), wl.Spec.QueueName)
+	}
+	result := &visibility.DryRunResult{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: visibility.GroupVersion.String(),
+			Kind:       "DryRunResult",
+		},
+		ObjectMeta:       metav1.ObjectMeta{Name: wl.Name, Namespace: wl.Namespace},
+		ClusterQueueName: cqName,
+		Mode:             flavorassigner.NoFit.String(),
+	}
+
+	info := workload.NewInfo(wl)
+	info.ClusterQueue = cqName
+	snapshot := s.cache.Snapshot()
+	entries := s.nominate(ctx, []workload.Info{*info}, snapshot)
+	if len(entries) == 0 {
+		result.PendingReasons = []string{"Workload is already admitted"}
+		return result, nil
+	}
+	e := entries[0]
+	mode := e.assignment.RepresentativeMode()
+	result.Mode = mode.String()
+	if mode != flavorassigner.NoFit {
+		result.Borrowing = e.assignment.Borrows()
+		result.PodSetAssignments = e.assignment.ToAPI()
+	}
+	for _, target := range e.preemptionTargets {
+		result.PreemptionTargets = append(result.PreemptionTargets, visibility.PreemptionTarget{
+			ObjectMeta:       metav1.ObjectMeta{Name: target.Obj.Name, Namespace: target.Obj.Namespace},
+			Priority:         priority.Priority(target.Obj),
+			ClusterQueueName: target.ClusterQueue,
+		})
+	}
+	if mode != flavorassigner.Fit && e.inadmissibleMsg != "" {
+		result.PendingReasons = append(result.PendingReasons, e.inadmissibleMsg)
+	}
+	if mode == flavorassigner.Preempt && len(e.preemptionTargets) == 0 {
+		result.PendingReasons = append(result.PendingReasons, "Workload requires preemption, but there are no candidate workloads allowed for preemption")
+	}
+	return result, nil
+}
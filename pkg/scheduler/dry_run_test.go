@@ -0,0 +1,148 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1alpha1"
+	"sigs.k8s.io/kueue/pkg/cache"
+	"sigs.k8s.io/kueue/pkg/constants"
+	"sigs.k8s.io/kueue/pkg/queue"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestDryRun(t *testing.T) {
+	cq := utiltesting.MakeClusterQueue("cq").
+		ResourceGroup(*utiltesting.MakeFlavorQuotas("default").
+			Resource(corev1.ResourceCPU, "4").
+			Obj()).
+		Preemption(kueue.ClusterQueuePreemption{
+			WithinClusterQueue: kueue.PreemptionPolicyLowerPriority,
+		}).
+		Obj()
+	lq := utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj()
+	admitted := utiltesting.MakeWorkload("low", "ns").
+		Queue("lq").
+		Request(corev1.ResourceCPU, "3").
+		ReserveQuota(utiltesting.MakeAdmission("cq").Assignment(corev1.ResourceCPU, "default", "3").Obj()).
+		Obj()
+
+	cases := map[string]struct {
+		workload   *kueue.Workload
+		wantResult *visibility.DryRunResult
+		wantErr    bool
+	}{
+		"fit": {
+			workload: utiltesting.MakeWorkload("wl", "ns").Queue("lq").Request(corev1.ResourceCPU, "1").Obj(),
+			wantResult: &visibility.DryRunResult{
+				ObjectMeta:        metav1.ObjectMeta{Name: "wl", Namespace: "ns"},
+				ClusterQueueName:  "cq",
+				Mode:              "Fit",
+				PodSetAssignments: utiltesting.MakeAdmission("cq").Assignment(corev1.ResourceCPU, "default", "1").Obj().PodSetAssignments,
+			},
+		},
+		"preempt": {
+			workload: utiltesting.MakeWorkload("wl", "ns").Queue("lq").Priority(10).Request(corev1.ResourceCPU, "2").Obj(),
+			wantResult: &visibility.DryRunResult{
+				ObjectMeta:        metav1.ObjectMeta{Name: "wl", Namespace: "ns"},
+				ClusterQueueName:  "cq",
+				Mode:              "Preempt",
+				PodSetAssignments: utiltesting.MakeAdmission("cq").Assignment(corev1.ResourceCPU, "default", "2").Obj().PodSetAssignments,
+				PreemptionTargets: []visibility.PreemptionTarget{{
+					ObjectMeta:       metav1.ObjectMeta{Name: "low", Namespace: "ns"},
+					ClusterQueueName: "cq",
+				}},
+				PendingReasons: []string{"couldn't assign flavors to pod set main: insufficient unused quota for cpu in flavor default, 1 more needed"},
+			},
+		},
+		"no candidates for preemption": {
+			workload: utiltesting.MakeWorkload("wl", "ns").Queue("lq").Request(corev1.ResourceCPU, "2").Obj(),
+			wantResult: &visibility.DryRunResult{
+				ObjectMeta:        metav1.ObjectMeta{Name: "wl", Namespace: "ns"},
+				ClusterQueueName:  "cq",
+				Mode:              "Preempt",
+				PodSetAssignments: utiltesting.MakeAdmission("cq").Assignment(corev1.ResourceCPU, "default", "2").Obj().PodSetAssignments,
+				PendingReasons: []string{
+					"couldn't assign flavors to pod set main: insufficient unused quota for cpu in flavor default, 1 more needed",
+					"Workload requires preemption, but there are no candidate workloads allowed for preemption",
+				},
+			},
+		},
+		"no fit": {
+			workload: utiltesting.MakeWorkload("wl", "ns").Queue("lq").Request(corev1.ResourceCPU, "5").Obj(),
+			wantResult: &visibility.DryRunResult{
+				ObjectMeta:       metav1.ObjectMeta{Name: "wl", Namespace: "ns"},
+				ClusterQueueName: "cq",
+				Mode:             "NoFit",
+				PendingReasons:   []string{"couldn't assign flavors to pod set main: insufficient quota for cpu in flavor default in ClusterQueue"},
+			},
+		},
+		"local queue not found": {
+			workload: utiltesting.MakeWorkload("wl", "ns").Queue("other").Request(corev1.ResourceCPU, "1").Obj(),
+			wantErr:  true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns"}}).
+				Build()
+			recorder := record.NewBroadcaster().NewRecorder(runtime.NewScheme(), corev1.EventSource{Component: constants.AdmissionName})
+			cqCache := cache.New(cl)
+			qManager := queue.NewManager(cl, cqCache)
+			cqCache.AddOrUpdateResourceFlavor(utiltesting.MakeResourceFlavor("default").Obj())
+			if err := cqCache.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Inserting clusterQueue in cache: %v", err)
+			}
+			if err := qManager.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Inserting clusterQueue in manager: %v", err)
+			}
+			if err := qManager.AddLocalQueue(ctx, lq); err != nil {
+				t.Fatalf("Inserting queue in manager: %v", err)
+			}
+			if !cqCache.AddOrUpdateWorkload(admitted) {
+				t.Fatalf("Failed to add the admitted workload to the cache")
+			}
+			scheduler := New(qManager, cqCache, cl, recorder)
+
+			got, err := scheduler.DryRun(ctx, tc.workload)
+			if tc.wantErr {
+				if !apierrors.IsNotFound(err) {
+					t.Fatalf("Unexpected error %v, want NotFound", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, got, cmpopts.IgnoreTypes(metav1.TypeMeta{})); diff != "" {
+				t.Errorf("Unexpected result (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -47,12 +47,32 @@ type Assignment struct {
 
 	// representativeMode is the cached representative mode for this assignment.
 	representativeMode *FlavorAssignmentMode
+
+	// localQueueLimited indicates that a flavor couldn't be assigned only
+	// because of the limits of the LocalQueue.
+	localQueueLimited bool
+
+	// tasUsages is the accumulated usage of the topology domains as pod sets
+	// get topology assignments.
+	tasUsages []cache.TASUsage
 }
 
 func (a *Assignment) Borrows() bool {
 	return len(a.TotalBorrow) > 0
 }
 
+// LocalQueueLimited returns whether a flavor that has enough quota in the
+// ClusterQueue couldn't be assigned because of the limits of the LocalQueue.
+func (a *Assignment) LocalQueueLimited() bool {
+	return a.localQueueLimited
+}
+
+// Usage returns the quantities of resources, per flavor, used by the pod sets
+// of the assignment.
+func (a *Assignment) Usage() cache.FlavorResourceQuantities {
+	return a.usage
+}
+
 // RepresentativeMode calculates the representative mode for the assigment as
 // the worst assignment mode among all the pod sets.
 func (a *Assignment) RepresentativeMode() FlavorAssignmentMode {
@@ -74,6 +94,12 @@ func (a *Assignment) RepresentativeMode() FlavorAssignmentMode {
 	return mode
 }
 
+// TASUsages returns the usage of the topology domains by the pod sets of the
+// assignment.
+func (a *Assignment) TASUsages() []cache.TASUsage {
+	return a.tasUsages
+}
+
 func (a *Assignment) Message() string {
 	var builder strings.Builder
 	for _, ps := range a.PodSets {
@@ -152,6 +178,10 @@ type PodSetAssignment struct {
 	Status   *Status
 	Requests corev1.ResourceList
 	Count    int32
+
+	// TopologyAssignment holds the domains where the pods are placed, when
+	// the pod set requests topology-aware scheduling.
+	TopologyAssignment *kueue.TopologyAssignment
 }
 
 // RepresentativeMode calculates the representative mode for this assignment as
@@ -180,10 +210,11 @@ func (psa *PodSetAssignment) toAPI() kueue.PodSetAssignment {
 		flavors[res] = flvAssignment.Name
 	}
 	return kueue.PodSetAssignment{
-		Name:          psa.Name,
-		Flavors:       flavors,
-		ResourceUsage: psa.Requests,
-		Count:         pointer.Int32(psa.Count),
+		Name:               psa.Name,
+		Flavors:            flavors,
+		ResourceUsage:      psa.Requests,
+		Count:              pointer.Int32(psa.Count),
+		TopologyAssignment: psa.TopologyAssignment,
 	}
 }
 
@@ -227,19 +258,22 @@ type FlavorAssignment struct {
 // The result for each pod set is accompanied with reasons why the flavor can't
 // be assigned immediately. Each assigned flavor is accompanied with a
 // FlavorAssignmentMode.
-func AssignFlavors(log logr.Logger, wl *workload.Info, resourceFlavors map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor, cq *cache.ClusterQueue, counts []int32) Assignment {
+// Pod sets requesting topology-aware scheduling only get flavors that refer
+// to a Topology, and the domains for their pods are found in tasFlavors.
+func AssignFlavors(log logr.Logger, wl *workload.Info, resourceFlavors map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor, tasFlavors map[kueue.ResourceFlavorReference]*cache.TASFlavorSnapshot, cq *cache.ClusterQueue, counts []int32) Assignment {
+	lq := cq.LocalQueueLimits[workload.QueueKey(wl.Obj)]
 	if len(counts) == 0 {
-		return assignFlavors(log, wl.TotalRequests, wl.Obj.Spec.PodSets, resourceFlavors, cq)
+		return assignFlavors(log, wl.TotalRequests, wl.Obj.Spec.PodSets, resourceFlavors, tasFlavors, cq, lq)
 	}
 
 	currentResources := make([]workload.PodSetResources, len(wl.TotalRequests))
 	for i := range wl.TotalRequests {
 		currentResources[i] = *wl.TotalRequests[i].ScaledTo(counts[i])
 	}
-	return assignFlavors(log, currentResources, wl.Obj.Spec.PodSets, resourceFlavors, cq)
+	return assignFlavors(log, currentResources, wl.Obj.Spec.PodSets, resourceFlavors, tasFlavors, cq, lq)
 }
 
-func assignFlavors(log logr.Logger, requests []workload.PodSetResources, podSets []kueue.PodSet, resourceFlavors map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor, cq *cache.ClusterQueue) Assignment {
+func assignFlavors(log logr.Logger, requests []workload.PodSetResources, podSets []kueue.PodSet, resourceFlavors map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor, tasFlavors map[kueue.ResourceFlavorReference]*cache.TASFlavorSnapshot, cq *cache.ClusterQueue, lq *cache.LocalQueueLimits) Assignment {
 	assignment := Assignment{
 		TotalBorrow: make(cache.FlavorResourceQuantities),
 		PodSets:     make([]PodSetAssignment, 0, len(requests)),
@@ -256,6 +290,7 @@ func assignFlavors(log logr.Logger, requests []workload.PodSetResources, podSets
 			Requests: podSet.Requests.ToResourceList(),
 			Count:    podSet.Count,
 		}
+		tasRequest := topologyRequest(&podSets[i], &podSet)
 
 		for resName := range podSet.Requests {
 			if _, found := psAssignment.Flavors[resName]; found {
@@ -271,13 +306,21 @@ func assignFlavors(log logr.Logger, requests []workload.PodSetResources, podSets
 				}
 				break
 			}
-			flavors, status := assignment.findFlavorForResourceGroup(log, rg, podSet.Requests, resourceFlavors, cq, &podSets[i].Template.Spec)
+			flavors, ta, status := assignment.findFlavorForResourceGroup(log, rg, podSet.Requests, resourceFlavors, tasFlavors, tasRequest, cq, lq, &podSets[i].Template.Spec)
 			if status.IsError() || len(flavors) == 0 {
 				psAssignment.Flavors = nil
 				psAssignment.Status = status
 				break
 			}
 			psAssignment.append(flavors, status)
+			if psAssignment.TopologyAssignment == nil && ta != nil {
+				psAssignment.TopologyAssignment = ta
+				assignment.tasUsages = append(assignment.tasUsages, cache.TASUsage{
+					Flavor:     flavors[resName].Name,
+					Assignment: ta,
+					PerPod:     tasRequest.PerPod,
+				})
+			}
 		}
 
 		assignment.append(podSet.Requests, &psAssignment)
@@ -324,6 +367,12 @@ func (a *Assignment) append(requests workload.Requests, psAssignment *PodSetAssi
 
 // findFlavorForResourceGroup finds the flavor which can satisfy the resource
 // request, along with the information about resources that need to be borrowed.
+// The flavors are tried in order, and the flavor fungibility of the
+// ClusterQueue determines whether the search stops at a flavor that requires
+// borrowing or preemption.
+// When the pod set requests topology-aware scheduling, only the flavors with
+// a Topology are considered, and the topology assignment is found for a flavor
+// that fits.
 // If the flavor cannot be immediately assigned, it returns a status with
 // reasons or failure.
 func (a *Assignment) findFlavorForResourceGroup(
@@ -331,12 +380,16 @@ func (a *Assignment) findFlavorForResourceGroup(
 	rg *cache.ResourceGroup,
 	requests workload.Requests,
 	resourceFlavors map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor,
+	tasFlavors map[kueue.ResourceFlavorReference]*cache.TASFlavorSnapshot,
+	tasRequest *cache.TopologyRequest,
 	cq *cache.ClusterQueue,
-	spec *corev1.PodSpec) (ResourceAssignment, *Status) {
+	lq *cache.LocalQueueLimits,
+	spec *corev1.PodSpec) (ResourceAssignment, *kueue.TopologyAssignment, *Status) {
 	status := &Status{}
 	requests = filterRequestedResources(requests, rg.CoveredResources)
 
 	var bestAssignment ResourceAssignment
+	var bestTopologyAssignment *kueue.TopologyAssignment
 	bestAssignmentMode := NoFit
 
 	// We will only check against the flavors' labels for the resource.
@@ -348,7 +401,9 @@ func (a *Assignment) findFlavorForResourceGroup(
 			status.append(fmt.Sprintf("flavor %s not found", flvQuotas.Name))
 			continue
 		}
-		taint, untolerated := corev1helpers.FindMatchingUntoleratedTaint(flavor.Spec.NodeTaints, spec.Tolerations, func(t *corev1.Taint) bool {
+		// The tolerations of the flavor are added to the pods when admitted.
+		tolerations := append(append([]corev1.Toleration(nil), spec.Tolerations...), flavor.Spec.Tolerations...)
+		taint, untolerated := corev1helpers.FindMatchingUntoleratedTaint(flavor.Spec.NodeTaints, tolerations, func(t *corev1.Taint) bool {
 			return t.Effect == corev1.TaintEffectNoSchedule || t.Effect == corev1.TaintEffectNoExecute
 		})
 		if untolerated {
@@ -358,15 +413,21 @@ func (a *Assignment) findFlavorForResourceGroup(
 		if match, err := selector.Match(&corev1.Node{ObjectMeta: metav1.ObjectMeta{Labels: flavor.Spec.NodeLabels}}); !match || err != nil {
 			if err != nil {
 				status.err = err
-				return nil, status
+				return nil, nil, status
 			}
 			status.append(fmt.Sprintf("flavor %s doesn't match node affinity", flvQuotas.Name))
 			continue
 		}
+		tasFlavor := tasFlavors[flvQuotas.Name]
+		if tasRequest != nil && (tasFlavor == nil || !tasFlavor.HasLevel(tasRequest.Level)) {
+			status.append(fmt.Sprintf("flavor %s doesn't support topology level %s", flvQuotas.Name, tasRequest.Level))
+			continue
+		}
 
 		assignments := make(ResourceAssignment, len(requests))
 		// Calculate representativeMode for this assignment as the worst mode among all requests.
 		representativeMode := Fit
+		needsBorrowing := false
 		for rName, val := range requests {
 			resQuota := flvQuotas.Resources[rName]
 			// Check considering the flavor usage by previous pod sets.
@@ -374,6 +435,13 @@ func (a *Assignment) findFlavorForResourceGroup(
 			if s != nil {
 				status.reasons = append(status.reasons, s.reasons...)
 			}
+			if mode != NoFit {
+				if s := fitsLocalQueueLimit(flvQuotas.Name, rName, val+a.usage[flvQuotas.Name][rName], lq); s != nil {
+					status.reasons = append(status.reasons, s.reasons...)
+					mode = NoFit
+					a.localQueueLimited = true
+				}
+			}
 			if mode < representativeMode {
 				representativeMode = mode
 			}
@@ -382,6 +450,9 @@ func (a *Assignment) findFlavorForResourceGroup(
 				break
 			}
 
+			if borrow > 0 {
+				needsBorrowing = true
+			}
 			assignments[rName] = &FlavorAssignment{
 				Name:   flvQuotas.Name,
 				Mode:   mode,
@@ -389,16 +460,66 @@ func (a *Assignment) findFlavorForResourceGroup(
 			}
 		}
 
+		var ta *kueue.TopologyAssignment
+		if tasRequest != nil && representativeMode == Fit {
+			var reason string
+			ta, reason = tasFlavor.FindTopologyAssignment(*tasRequest, a.tasUsages)
+			if ta == nil {
+				status.append(fmt.Sprintf("%s in flavor %s", reason, flvQuotas.Name))
+				representativeMode = NoFit
+			}
+		}
+
+		if !shouldTryNextFlavor(representativeMode, needsBorrowing, cq.FlavorFungibility) {
+			if representativeMode == Fit {
+				return assignments, ta, nil
+			}
+			return assignments, ta, status
+		}
 		if representativeMode > bestAssignmentMode {
 			bestAssignment = assignments
+			bestTopologyAssignment = ta
 			bestAssignmentMode = representativeMode
-			if bestAssignmentMode == Fit {
-				// All the resources fit in the cohort, no need to check more flavors.
-				return bestAssignment, nil
-			}
 		}
 	}
-	return bestAssignment, status
+	if bestAssignmentMode == Fit {
+		// The best flavor requires borrowing, which doesn't need more reasons.
+		return bestAssignment, bestTopologyAssignment, nil
+	}
+	return bestAssignment, bestTopologyAssignment, status
+}
+
+// topologyRequest returns the topology requested by the annotations of the
+// pod template, or nil if the pod set doesn't request topology-aware
+// scheduling.
+func topologyRequest(ps *kueue.PodSet, psr *workload.PodSetResources) *cache.TopologyRequest {
+	req := &cache.TopologyRequest{
+		Count:  psr.Count,
+		PerPod: psr.SinglePodRequests(),
+	}
+	if level, found := ps.Template.Annotations[kueue.PodSetRequiredTopologyAnnotation]; found {
+		req.Level = level
+		req.Required = true
+	} else if level, found := ps.Template.Annotations[kueue.PodSetPreferredTopologyAnnotation]; found {
+		req.Level = level
+	} else {
+		return nil
+	}
+	return req
+}
+
+// shouldTryNextFlavor returns whether the next flavors should be evaluated
+// after a flavor with the given mode, according to the flavor fungibility.
+// Unset policies behave as their defaults.
+func shouldTryNextFlavor(mode FlavorAssignmentMode, needsBorrowing bool, fungibility kueue.FlavorFungibility) bool {
+	switch mode {
+	case Fit:
+		// All the resources fit in the ClusterQueue or the cohort.
+		return needsBorrowing && fungibility.WhenCanBorrow == kueue.TryNextFlavor
+	case Preempt:
+		return fungibility.WhenCanPreempt != kueue.Preempt
+	}
+	return true
 }
 
 func flavorSelector(spec *corev1.PodSpec, allowedKeys sets.Set[string]) nodeaffinity.RequiredNodeAffinity {
@@ -448,7 +569,7 @@ func flavorSelector(spec *corev1.PodSpec, allowedKeys sets.Set[string]) nodeaffi
 }
 
 // fitsResourceQuota returns how this flavor could be assigned to the resource,
-// according to the remaining quota in the ClusterQueue and cohort.
+// according to the remaining quota in the ClusterQueue and the cohort tree.
 // If it fits, also returns any borrowing required.
 // If the flavor doesn't satisfy limits immediately (when waiting or preemption
 // could help), it returns a Status with reasons.
@@ -461,20 +582,27 @@ func fitsResourceQuota(fName kueue.ResourceFlavorReference, rName corev1.Resourc
 		// reclaimed from the cohort or assuming all active workloads in the
 		// ClusterQueue are preempted.
 		mode = Preempt
+	} else if canPreemptWhileBorrowing(cq) &&
+		(rQuota.BorrowingLimit == nil || val <= rQuota.Nominal+*rQuota.BorrowingLimit) &&
+		val <= cq.Requestable(fName, rName) {
+		// The request needs borrowing, which can be satisfied by preempting
+		// workloads in the other ClusterQueues of the cohort.
+		mode = Preempt
 	}
 	if rQuota.BorrowingLimit != nil && used+val > rQuota.Nominal+*rQuota.BorrowingLimit {
 		status.append(fmt.Sprintf("borrowing limit for %s in flavor %s exceeded", rName, fName))
 		return mode, 0, &status
 	}
 
-	cohortUsed := used
-	cohortAvailable := rQuota.Nominal
+	available := rQuota.Nominal - used
 	if cq.Cohort != nil {
-		cohortUsed = cq.Cohort.Usage[fName][rName]
-		cohortAvailable = cq.Cohort.RequestableResources[fName][rName]
+		// The unused quota in the cohort, and in the cohorts above it, up to
+		// their borrowing limits, plus the unused quota that the ClusterQueue
+		// doesn't lend.
+		available = cq.Available(fName, rName)
 	}
 
-	lack := cohortUsed + val - cohortAvailable
+	lack := val - available
 	if lack <= 0 {
 		borrow := used + val - rQuota.Nominal
 		if borrow < 0 {
@@ -496,6 +624,34 @@ func fitsResourceQuota(fName kueue.ResourceFlavorReference, rName corev1.Resourc
 	return mode, 0, &status
 }
 
+// canPreemptWhileBorrowing returns whether the ClusterQueue can preempt
+// workloads in the cohort to admit workloads that need borrowing.
+func canPreemptWhileBorrowing(cq *cache.ClusterQueue) bool {
+	return cq.Cohort != nil && cq.Preemption.BorrowWithinCohort != nil &&
+		cq.Preemption.BorrowWithinCohort.Policy != kueue.BorrowWithinCohortPolicyNever
+}
+
+// fitsLocalQueueLimit returns a Status with reasons if the request, added to
+// the usage of the LocalQueue, exceeds the limit of the LocalQueue for the
+// resource in the flavor.
+func fitsLocalQueueLimit(fName kueue.ResourceFlavorReference, rName corev1.ResourceName, val int64, lq *cache.LocalQueueLimits) *Status {
+	if lq == nil {
+		return nil
+	}
+	limit, found := lq.Limits[fName][rName]
+	if !found {
+		return nil
+	}
+	lack := lq.Usage[fName][rName] + val - limit
+	if lack <= 0 {
+		return nil
+	}
+	lackQuantity := workload.ResourceQuantity(rName, lack)
+	var status Status
+	status.append(fmt.Sprintf("insufficient unused quota for %s in flavor %s in LocalQueue, %s more needed", rName, fName, &lackQuantity))
+	return &status
+}
+
 func filterRequestedResources(req workload.Requests, allowList sets.Set[corev1.ResourceName]) workload.Requests {
 	filtered := make(workload.Requests)
 	for n, v := range req {
@@ -50,8 +50,34 @@ func TestAssignFlavors(t *testing.T) {
 				Value:  "spot",
 				Effect: corev1.TaintEffectNoSchedule,
 			}).Obj(),
+		"tainted_tolerated": utiltesting.MakeResourceFlavor("tainted_tolerated").
+			Taint(corev1.Taint{
+				Key:    "instance",
+				Value:  "spot",
+				Effect: corev1.TaintEffectNoSchedule,
+			}).
+			Toleration(corev1.Toleration{
+				Key:      "instance",
+				Operator: corev1.TolerationOpEqual,
+				Value:    "spot",
+				Effect:   corev1.TaintEffectNoSchedule,
+			}).Obj(),
+		"tas": utiltesting.MakeResourceFlavor("tas").TopologyName("default").Obj(),
 	}
 
+	tasFlavor := cache.NewTASFlavorSnapshot([]string{"cloud.com/rack"})
+	for _, n := range []struct{ name, rack, cpu string }{{"n1", "r1", "2"}, {"n2", "r2", "4"}} {
+		tasFlavor.AddNode(&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: n.name, Labels: map[string]string{"cloud.com/rack": n.rack}},
+			Status: corev1.NodeStatus{
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:  resource.MustParse(n.cpu),
+					corev1.ResourcePods: resource.MustParse("10"),
+				},
+			},
+		})
+	}
+	tasFlavors := map[kueue.ResourceFlavorReference]*cache.TASFlavorSnapshot{"tas": tasFlavor}
 	cases := map[string]struct {
 		wlPods            []kueue.PodSet
 		wlReclaimablePods []kueue.ReclaimablePod
@@ -131,6 +157,37 @@ func TestAssignFlavors(t *testing.T) {
 				}},
 			},
 		},
+		"single flavor, fits tainted flavor with the flavor tolerations": {
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "1").
+					Obj(),
+			},
+			clusterQueue: cache.ClusterQueue{
+				ResourceGroups: []cache.ResourceGroup{{
+					CoveredResources: sets.New(corev1.ResourceCPU),
+					Flavors: []cache.FlavorQuotas{{
+						Name: "tainted_tolerated",
+						Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+							corev1.ResourceCPU: {Nominal: 4000},
+						},
+					}},
+				}},
+			},
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "tainted_tolerated", Mode: Fit},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("1000m"),
+					},
+					Count: 1,
+				}},
+			},
+		},
 		"single flavor, used resources, doesn't fit": {
 			wlPods: []kueue.PodSet{
 				*utiltesting.MakePodSet("main", 1).
@@ -481,6 +538,96 @@ func TestAssignFlavors(t *testing.T) {
 				}},
 			},
 		},
+		"multiple flavors, LocalQueue limit reached in first flavor": {
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "2").
+					Obj(),
+			},
+			clusterQueue: cache.ClusterQueue{
+				ResourceGroups: []cache.ResourceGroup{{
+					CoveredResources: sets.New(corev1.ResourceCPU),
+					Flavors: []cache.FlavorQuotas{
+						{
+							Name: "one",
+							Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+								corev1.ResourceCPU: {Nominal: 4000},
+							},
+						},
+						{
+							Name: "two",
+							Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+								corev1.ResourceCPU: {Nominal: 4000},
+							},
+						},
+					},
+				}},
+				Usage: cache.FlavorResourceQuantities{
+					"one": {corev1.ResourceCPU: 1_000},
+				},
+				LocalQueueLimits: map[string]*cache.LocalQueueLimits{
+					"ns/lq": {
+						Limits: cache.FlavorResourceQuantities{
+							"one": {corev1.ResourceCPU: 2_000},
+						},
+						Usage: cache.FlavorResourceQuantities{
+							"one": {corev1.ResourceCPU: 1_000},
+						},
+					},
+				},
+			},
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "two", Mode: Fit},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("2000m"),
+					},
+					Count: 1,
+				}},
+			},
+		},
+		"single flavor, LocalQueue limit reached, doesn't fit": {
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "2").
+					Obj(),
+			},
+			clusterQueue: cache.ClusterQueue{
+				ResourceGroups: []cache.ResourceGroup{{
+					CoveredResources: sets.New(corev1.ResourceCPU),
+					Flavors: []cache.FlavorQuotas{{
+						Name: "default",
+						Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+							corev1.ResourceCPU: {Nominal: 4000},
+						},
+					}},
+				}},
+				LocalQueueLimits: map[string]*cache.LocalQueueLimits{
+					"ns/lq": {
+						Limits: cache.FlavorResourceQuantities{
+							"default": {corev1.ResourceCPU: 1_000},
+						},
+					},
+				},
+			},
+			wantRepMode: NoFit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("2000m"),
+					},
+					Status: &Status{
+						reasons: []string{"insufficient unused quota for cpu in flavor default in LocalQueue, 1 more needed"},
+					},
+					Count: 1,
+				}},
+			},
+		},
 		"multiple flavors, fits while skipping tainted flavor": {
 			wlPods: []kueue.PodSet{
 				*utiltesting.MakePodSet("main", 1).
@@ -972,10 +1119,10 @@ func TestAssignFlavors(t *testing.T) {
 				},
 			},
 		},
-		"not enough space to borrow": {
+		"can use the quota that is not lent": {
 			wlPods: []kueue.PodSet{
 				*utiltesting.MakePodSet("main", 1).
-					Request(corev1.ResourceCPU, "2").
+					Request(corev1.ResourceCPU, "3").
 					Obj(),
 			},
 			clusterQueue: cache.ClusterQueue{
@@ -984,36 +1131,39 @@ func TestAssignFlavors(t *testing.T) {
 					Flavors: []cache.FlavorQuotas{{
 						Name: "one",
 						Resources: map[corev1.ResourceName]*cache.ResourceQuota{
-							corev1.ResourceCPU: {Nominal: 1000},
+							corev1.ResourceCPU: {Nominal: 4000, LendingLimit: pointer.Int64(1000)},
 						},
 					}},
 				}},
 				Cohort: &cache.Cohort{
+					// Only the lendable quota of the ClusterQueue is in the cohort,
+					// and it is already borrowed.
 					RequestableResources: cache.FlavorResourceQuantities{
 						"one": {corev1.ResourceCPU: 10_000},
 					},
 					Usage: cache.FlavorResourceQuantities{
-						"one": {corev1.ResourceCPU: 9_000},
+						"one": {corev1.ResourceCPU: 10_000},
 					},
 				},
 			},
+			wantRepMode: Fit,
 			wantAssignment: Assignment{
 				PodSets: []PodSetAssignment{{
 					Name: "main",
-					Requests: corev1.ResourceList{
-						corev1.ResourceCPU: resource.MustParse("2000m"),
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "one", Mode: Fit},
 					},
-					Status: &Status{
-						reasons: []string{"insufficient unused quota in cohort for cpu in flavor one, 1 more needed"},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("3000m"),
 					},
 					Count: 1,
 				}},
 			},
 		},
-		"past max, but can preempt in ClusterQueue": {
+		"lent quota is not available": {
 			wlPods: []kueue.PodSet{
 				*utiltesting.MakePodSet("main", 1).
-					Request(corev1.ResourceCPU, "2").
+					Request(corev1.ResourceCPU, "4").
 					Obj(),
 			},
 			clusterQueue: cache.ClusterQueue{
@@ -1022,19 +1172,16 @@ func TestAssignFlavors(t *testing.T) {
 					Flavors: []cache.FlavorQuotas{{
 						Name: "one",
 						Resources: map[corev1.ResourceName]*cache.ResourceQuota{
-							corev1.ResourceCPU: {Nominal: 2000, BorrowingLimit: pointer.Int64(8_000)},
+							corev1.ResourceCPU: {Nominal: 4000, LendingLimit: pointer.Int64(1000)},
 						},
 					}},
 				}},
-				Usage: cache.FlavorResourceQuantities{
-					"one": {corev1.ResourceCPU: 9_000},
-				},
 				Cohort: &cache.Cohort{
 					RequestableResources: cache.FlavorResourceQuantities{
-						"one": {corev1.ResourceCPU: 100_000},
+						"one": {corev1.ResourceCPU: 10_000},
 					},
 					Usage: cache.FlavorResourceQuantities{
-						"one": {corev1.ResourceCPU: 9_000},
+						"one": {corev1.ResourceCPU: 10_000},
 					},
 				},
 			},
@@ -1046,112 +1193,121 @@ func TestAssignFlavors(t *testing.T) {
 						corev1.ResourceCPU: {Name: "one", Mode: Preempt},
 					},
 					Requests: corev1.ResourceList{
-						corev1.ResourceCPU: resource.MustParse("2000m"),
+						corev1.ResourceCPU: resource.MustParse("4000m"),
 					},
-
 					Status: &Status{
-						reasons: []string{"borrowing limit for cpu in flavor one exceeded"},
+						reasons: []string{"insufficient unused quota in cohort for cpu in flavor one, 1 more needed"},
 					},
 					Count: 1,
 				}},
 			},
 		},
-		"past min, but can preempt in ClusterQueue": {
+		"when borrowing is possible, try the next flavor": {
 			wlPods: []kueue.PodSet{
 				*utiltesting.MakePodSet("main", 1).
-					Request(corev1.ResourceCPU, "2").
+					Request(corev1.ResourceCPU, "3").
 					Obj(),
 			},
 			clusterQueue: cache.ClusterQueue{
 				ResourceGroups: []cache.ResourceGroup{{
 					CoveredResources: sets.New(corev1.ResourceCPU),
-					Flavors: []cache.FlavorQuotas{{
-						Name: "one",
-						Resources: map[corev1.ResourceName]*cache.ResourceQuota{
-							corev1.ResourceCPU: {Nominal: 2000},
+					Flavors: []cache.FlavorQuotas{
+						{
+							Name: "one",
+							Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+								corev1.ResourceCPU: {Nominal: 2000},
+							},
 						},
-					}},
+						{
+							Name: "two",
+							Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+								corev1.ResourceCPU: {Nominal: 4000},
+							},
+						},
+					},
 				}},
-				Usage: cache.FlavorResourceQuantities{
-					"one": {corev1.ResourceCPU: 1_000},
+				FlavorFungibility: kueue.FlavorFungibility{
+					WhenCanBorrow:  kueue.TryNextFlavor,
+					WhenCanPreempt: kueue.TryNextFlavor,
+				},
+				Cohort: &cache.Cohort{
+					RequestableResources: cache.FlavorResourceQuantities{
+						"one": {corev1.ResourceCPU: 10_000},
+						"two": {corev1.ResourceCPU: 10_000},
+					},
 				},
 			},
-			wantRepMode: Preempt,
+			wantRepMode: Fit,
 			wantAssignment: Assignment{
 				PodSets: []PodSetAssignment{{
 					Name: "main",
 					Flavors: ResourceAssignment{
-						corev1.ResourceCPU: {Name: "one", Mode: Preempt},
+						corev1.ResourceCPU: {Name: "two", Mode: Fit},
 					},
 					Requests: corev1.ResourceList{
-						corev1.ResourceCPU: resource.MustParse("2000m"),
-					},
-					Status: &Status{
-						reasons: []string{"insufficient unused quota for cpu in flavor one, 1 more needed"},
+						corev1.ResourceCPU: resource.MustParse("3000m"),
 					},
 					Count: 1,
 				}},
 			},
 		},
-		"past min, but can preempt in cohort and ClusterQueue": {
+		"when borrowing is possible, try the next flavor, but all flavors need borrowing": {
 			wlPods: []kueue.PodSet{
 				*utiltesting.MakePodSet("main", 1).
-					Request(corev1.ResourceCPU, "2").
+					Request(corev1.ResourceCPU, "3").
 					Obj(),
 			},
 			clusterQueue: cache.ClusterQueue{
 				ResourceGroups: []cache.ResourceGroup{{
 					CoveredResources: sets.New(corev1.ResourceCPU),
-					Flavors: []cache.FlavorQuotas{{
-						Name: "one",
-						Resources: map[corev1.ResourceName]*cache.ResourceQuota{
-							corev1.ResourceCPU: {Nominal: 3000},
+					Flavors: []cache.FlavorQuotas{
+						{
+							Name: "one",
+							Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+								corev1.ResourceCPU: {Nominal: 2000},
+							},
 						},
-					}},
+						{
+							Name: "two",
+							Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+								corev1.ResourceCPU: {Nominal: 1000},
+							},
+						},
+					},
 				}},
-				Usage: cache.FlavorResourceQuantities{
-					"one": {corev1.ResourceCPU: 2_000},
+				FlavorFungibility: kueue.FlavorFungibility{
+					WhenCanBorrow:  kueue.TryNextFlavor,
+					WhenCanPreempt: kueue.TryNextFlavor,
 				},
 				Cohort: &cache.Cohort{
 					RequestableResources: cache.FlavorResourceQuantities{
 						"one": {corev1.ResourceCPU: 10_000},
-					},
-					Usage: cache.FlavorResourceQuantities{
-						"one": {corev1.ResourceCPU: 10_000},
+						"two": {corev1.ResourceCPU: 10_000},
 					},
 				},
 			},
-			wantRepMode: Preempt,
+			wantRepMode: Fit,
 			wantAssignment: Assignment{
 				PodSets: []PodSetAssignment{{
 					Name: "main",
 					Flavors: ResourceAssignment{
-						corev1.ResourceCPU: {Name: "one", Mode: Preempt},
+						corev1.ResourceCPU: {Name: "one", Mode: Fit},
 					},
 					Requests: corev1.ResourceList{
-						corev1.ResourceCPU: resource.MustParse("2000m"),
-					},
-					Status: &Status{
-						reasons: []string{"insufficient unused quota in cohort for cpu in flavor one, 2 more needed"},
+						corev1.ResourceCPU: resource.MustParse("3000m"),
 					},
 					Count: 1,
 				}},
+				TotalBorrow: cache.FlavorResourceQuantities{
+					"one": {corev1.ResourceCPU: 1_000},
+				},
 			},
 		},
-		"can only preempt flavors that match affinity": {
+		"when preemption is possible, preempt in the first flavor": {
 			wlPods: []kueue.PodSet{
-				{
-					Count: 1,
-					Name:  "main",
-					Template: corev1.PodTemplateSpec{
-						Spec: corev1.PodSpec{
-							Containers: utiltesting.SingleContainerForRequest(map[corev1.ResourceName]string{
-								corev1.ResourceCPU: "2",
-							}),
-							NodeSelector: map[string]string{"type": "two"},
-						},
-					},
-				},
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "2").
+					Obj(),
 			},
 			clusterQueue: cache.ClusterQueue{
 				ResourceGroups: []cache.ResourceGroup{{
@@ -1171,9 +1327,13 @@ func TestAssignFlavors(t *testing.T) {
 						},
 					},
 				}},
+				FlavorFungibility: kueue.FlavorFungibility{
+					WhenCanBorrow:  kueue.Borrow,
+					WhenCanPreempt: kueue.Preempt,
+				},
 				Usage: cache.FlavorResourceQuantities{
-					"one": {corev1.ResourceCPU: 3000},
-					"two": {corev1.ResourceCPU: 3000},
+					"one": {corev1.ResourceCPU: 3_000},
+					"two": {corev1.ResourceCPU: 0},
 				},
 			},
 			wantRepMode: Preempt,
@@ -1181,35 +1341,23 @@ func TestAssignFlavors(t *testing.T) {
 				PodSets: []PodSetAssignment{{
 					Name: "main",
 					Flavors: ResourceAssignment{
-						corev1.ResourceCPU: {Name: "two", Mode: Preempt},
+						corev1.ResourceCPU: {Name: "one", Mode: Preempt},
 					},
 					Requests: corev1.ResourceList{
 						corev1.ResourceCPU: resource.MustParse("2000m"),
 					},
 					Status: &Status{
-						reasons: []string{
-							"flavor one doesn't match node affinity",
-							"insufficient unused quota for cpu in flavor two, 1 more needed",
-						},
+						reasons: []string{"insufficient unused quota for cpu in flavor one, 1 more needed"},
 					},
 					Count: 1,
 				}},
 			},
 		},
-		"each podset requires preemption on a different flavor": {
+		"when preemption is possible, try the next flavor": {
 			wlPods: []kueue.PodSet{
-				*utiltesting.MakePodSet("launcher", 1).
+				*utiltesting.MakePodSet("main", 1).
 					Request(corev1.ResourceCPU, "2").
 					Obj(),
-				*utiltesting.MakePodSet("workers", 10).
-					Request(corev1.ResourceCPU, "1").
-					Toleration(corev1.Toleration{
-						Key:      "instance",
-						Operator: corev1.TolerationOpEqual,
-						Value:    "spot",
-						Effect:   corev1.TaintEffectNoSchedule,
-					}).
-					Obj(),
 			},
 			clusterQueue: cache.ClusterQueue{
 				ResourceGroups: []cache.ResourceGroup{{
@@ -1222,9 +1370,485 @@ func TestAssignFlavors(t *testing.T) {
 							},
 						},
 						{
-							Name: "tainted",
+							Name: "two",
 							Resources: map[corev1.ResourceName]*cache.ResourceQuota{
-								corev1.ResourceCPU: {Nominal: 10_000},
+								corev1.ResourceCPU: {Nominal: 4000},
+							},
+						},
+					},
+				}},
+				FlavorFungibility: kueue.FlavorFungibility{
+					WhenCanBorrow:  kueue.Borrow,
+					WhenCanPreempt: kueue.TryNextFlavor,
+				},
+				Usage: cache.FlavorResourceQuantities{
+					"one": {corev1.ResourceCPU: 3_000},
+					"two": {corev1.ResourceCPU: 0},
+				},
+			},
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "two", Mode: Fit},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("2000m"),
+					},
+					Count: 1,
+				}},
+			},
+		},
+		"not enough space to borrow": {
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "2").
+					Obj(),
+			},
+			clusterQueue: cache.ClusterQueue{
+				ResourceGroups: []cache.ResourceGroup{{
+					CoveredResources: sets.New(corev1.ResourceCPU),
+					Flavors: []cache.FlavorQuotas{{
+						Name: "one",
+						Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+							corev1.ResourceCPU: {Nominal: 1000},
+						},
+					}},
+				}},
+				Cohort: &cache.Cohort{
+					RequestableResources: cache.FlavorResourceQuantities{
+						"one": {corev1.ResourceCPU: 10_000},
+					},
+					Usage: cache.FlavorResourceQuantities{
+						"one": {corev1.ResourceCPU: 9_000},
+					},
+				},
+			},
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("2000m"),
+					},
+					Status: &Status{
+						reasons: []string{"insufficient unused quota in cohort for cpu in flavor one, 1 more needed"},
+					},
+					Count: 1,
+				}},
+			},
+		},
+		"past max, but can preempt in ClusterQueue": {
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "2").
+					Obj(),
+			},
+			clusterQueue: cache.ClusterQueue{
+				ResourceGroups: []cache.ResourceGroup{{
+					CoveredResources: sets.New(corev1.ResourceCPU),
+					Flavors: []cache.FlavorQuotas{{
+						Name: "one",
+						Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+							corev1.ResourceCPU: {Nominal: 2000, BorrowingLimit: pointer.Int64(8_000)},
+						},
+					}},
+				}},
+				Usage: cache.FlavorResourceQuantities{
+					"one": {corev1.ResourceCPU: 9_000},
+				},
+				Cohort: &cache.Cohort{
+					RequestableResources: cache.FlavorResourceQuantities{
+						"one": {corev1.ResourceCPU: 100_000},
+					},
+					Usage: cache.FlavorResourceQuantities{
+						"one": {corev1.ResourceCPU: 9_000},
+					},
+				},
+			},
+			wantRepMode: Preempt,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "one", Mode: Preempt},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("2000m"),
+					},
+
+					Status: &Status{
+						reasons: []string{"borrowing limit for cpu in flavor one exceeded"},
+					},
+					Count: 1,
+				}},
+			},
+		},
+		"past min, but can preempt in ClusterQueue": {
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "2").
+					Obj(),
+			},
+			clusterQueue: cache.ClusterQueue{
+				ResourceGroups: []cache.ResourceGroup{{
+					CoveredResources: sets.New(corev1.ResourceCPU),
+					Flavors: []cache.FlavorQuotas{{
+						Name: "one",
+						Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+							corev1.ResourceCPU: {Nominal: 2000},
+						},
+					}},
+				}},
+				Usage: cache.FlavorResourceQuantities{
+					"one": {corev1.ResourceCPU: 1_000},
+				},
+			},
+			wantRepMode: Preempt,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "one", Mode: Preempt},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("2000m"),
+					},
+					Status: &Status{
+						reasons: []string{"insufficient unused quota for cpu in flavor one, 1 more needed"},
+					},
+					Count: 1,
+				}},
+			},
+		},
+		"past min, but can preempt in cohort and ClusterQueue": {
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "2").
+					Obj(),
+			},
+			clusterQueue: cache.ClusterQueue{
+				ResourceGroups: []cache.ResourceGroup{{
+					CoveredResources: sets.New(corev1.ResourceCPU),
+					Flavors: []cache.FlavorQuotas{{
+						Name: "one",
+						Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+							corev1.ResourceCPU: {Nominal: 3000},
+						},
+					}},
+				}},
+				Usage: cache.FlavorResourceQuantities{
+					"one": {corev1.ResourceCPU: 2_000},
+				},
+				Cohort: &cache.Cohort{
+					RequestableResources: cache.FlavorResourceQuantities{
+						"one": {corev1.ResourceCPU: 10_000},
+					},
+					Usage: cache.FlavorResourceQuantities{
+						"one": {corev1.ResourceCPU: 10_000},
+					},
+				},
+			},
+			wantRepMode: Preempt,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "one", Mode: Preempt},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("2000m"),
+					},
+					Status: &Status{
+						reasons: []string{"insufficient unused quota in cohort for cpu in flavor one, 2 more needed"},
+					},
+					Count: 1,
+				}},
+			},
+		},
+		"past min, but can preempt in cohort while borrowing": {
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "8").
+					Obj(),
+			},
+			clusterQueue: cache.ClusterQueue{
+				ResourceGroups: []cache.ResourceGroup{{
+					CoveredResources: sets.New(corev1.ResourceCPU),
+					Flavors: []cache.FlavorQuotas{{
+						Name: "one",
+						Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+							corev1.ResourceCPU: {Nominal: 6000, BorrowingLimit: pointer.Int64(6_000)},
+						},
+					}},
+				}},
+				Preemption: kueue.ClusterQueuePreemption{
+					BorrowWithinCohort: &kueue.BorrowWithinCohort{
+						Policy: kueue.BorrowWithinCohortPolicyLowerPriority,
+					},
+				},
+				Cohort: &cache.Cohort{
+					RequestableResources: cache.FlavorResourceQuantities{
+						"one": {corev1.ResourceCPU: 12_000},
+					},
+					Usage: cache.FlavorResourceQuantities{
+						"one": {corev1.ResourceCPU: 10_000},
+					},
+				},
+			},
+			wantRepMode: Preempt,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "one", Mode: Preempt},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("8000m"),
+					},
+					Status: &Status{
+						reasons: []string{"insufficient unused quota in cohort for cpu in flavor one, 6 more needed"},
+					},
+					Count: 1,
+				}},
+			},
+		},
+		"past min, can't preempt in cohort while borrowing with policy Never": {
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "8").
+					Obj(),
+			},
+			clusterQueue: cache.ClusterQueue{
+				ResourceGroups: []cache.ResourceGroup{{
+					CoveredResources: sets.New(corev1.ResourceCPU),
+					Flavors: []cache.FlavorQuotas{{
+						Name: "one",
+						Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+							corev1.ResourceCPU: {Nominal: 6000, BorrowingLimit: pointer.Int64(6_000)},
+						},
+					}},
+				}},
+				Preemption: kueue.ClusterQueuePreemption{
+					BorrowWithinCohort: &kueue.BorrowWithinCohort{
+						Policy: kueue.BorrowWithinCohortPolicyNever,
+					},
+				},
+				Cohort: &cache.Cohort{
+					RequestableResources: cache.FlavorResourceQuantities{
+						"one": {corev1.ResourceCPU: 12_000},
+					},
+					Usage: cache.FlavorResourceQuantities{
+						"one": {corev1.ResourceCPU: 10_000},
+					},
+				},
+			},
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("8000m"),
+					},
+					Status: &Status{
+						reasons: []string{"insufficient unused quota in cohort for cpu in flavor one, 6 more needed"},
+					},
+					Count: 1,
+				}},
+			},
+		},
+		"borrows from the parent cohort": {
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "3").
+					Obj(),
+			},
+			clusterQueue: cache.ClusterQueue{
+				ResourceGroups: []cache.ResourceGroup{{
+					CoveredResources: sets.New(corev1.ResourceCPU),
+					Flavors: []cache.FlavorQuotas{{
+						Name: "one",
+						Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+							corev1.ResourceCPU: {Nominal: 1000},
+						},
+					}},
+				}},
+				Cohort: &cache.Cohort{
+					RequestableResources: cache.FlavorResourceQuantities{
+						"one": {corev1.ResourceCPU: 1000},
+					},
+					Usage: cache.FlavorResourceQuantities{
+						"one": {corev1.ResourceCPU: 0},
+					},
+					Parent: &cache.Cohort{
+						RequestableResources: cache.FlavorResourceQuantities{
+							"one": {corev1.ResourceCPU: 10_000},
+						},
+						Usage: cache.FlavorResourceQuantities{
+							"one": {corev1.ResourceCPU: 5_000},
+						},
+					},
+				},
+			},
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "one", Mode: Fit},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("3000m"),
+					},
+					Count: 1,
+				}},
+				TotalBorrow: cache.FlavorResourceQuantities{
+					"one": {corev1.ResourceCPU: 2_000},
+				},
+			},
+		},
+		"borrowing limit of the cohort from its parent exceeded": {
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "3").
+					Obj(),
+			},
+			clusterQueue: cache.ClusterQueue{
+				ResourceGroups: []cache.ResourceGroup{{
+					CoveredResources: sets.New(corev1.ResourceCPU),
+					Flavors: []cache.FlavorQuotas{{
+						Name: "one",
+						Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+							corev1.ResourceCPU: {Nominal: 1000},
+						},
+					}},
+				}},
+				Cohort: &cache.Cohort{
+					ResourceGroups: []cache.ResourceGroup{{
+						CoveredResources: sets.New(corev1.ResourceCPU),
+						Flavors: []cache.FlavorQuotas{{
+							Name: "one",
+							Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+								corev1.ResourceCPU: {Nominal: 0, BorrowingLimit: pointer.Int64(1000)},
+							},
+						}},
+					}},
+					RequestableResources: cache.FlavorResourceQuantities{
+						"one": {corev1.ResourceCPU: 1000},
+					},
+					Usage: cache.FlavorResourceQuantities{
+						"one": {corev1.ResourceCPU: 0},
+					},
+					Parent: &cache.Cohort{
+						RequestableResources: cache.FlavorResourceQuantities{
+							"one": {corev1.ResourceCPU: 10_000},
+						},
+						Usage: cache.FlavorResourceQuantities{
+							"one": {corev1.ResourceCPU: 0},
+						},
+					},
+				},
+			},
+			wantRepMode: NoFit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("3000m"),
+					},
+					Status: &Status{
+						reasons: []string{"insufficient unused quota in cohort for cpu in flavor one, 1 more needed"},
+					},
+					Count: 1,
+				}},
+			},
+		},
+		"can only preempt flavors that match affinity": {
+			wlPods: []kueue.PodSet{
+				{
+					Count: 1,
+					Name:  "main",
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: utiltesting.SingleContainerForRequest(map[corev1.ResourceName]string{
+								corev1.ResourceCPU: "2",
+							}),
+							NodeSelector: map[string]string{"type": "two"},
+						},
+					},
+				},
+			},
+			clusterQueue: cache.ClusterQueue{
+				ResourceGroups: []cache.ResourceGroup{{
+					CoveredResources: sets.New(corev1.ResourceCPU),
+					Flavors: []cache.FlavorQuotas{
+						{
+							Name: "one",
+							Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+								corev1.ResourceCPU: {Nominal: 4000},
+							},
+						},
+						{
+							Name: "two",
+							Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+								corev1.ResourceCPU: {Nominal: 4000},
+							},
+						},
+					},
+				}},
+				Usage: cache.FlavorResourceQuantities{
+					"one": {corev1.ResourceCPU: 3000},
+					"two": {corev1.ResourceCPU: 3000},
+				},
+			},
+			wantRepMode: Preempt,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "two", Mode: Preempt},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("2000m"),
+					},
+					Status: &Status{
+						reasons: []string{
+							"flavor one doesn't match node affinity",
+							"insufficient unused quota for cpu in flavor two, 1 more needed",
+						},
+					},
+					Count: 1,
+				}},
+			},
+		},
+		"each podset requires preemption on a different flavor": {
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("launcher", 1).
+					Request(corev1.ResourceCPU, "2").
+					Obj(),
+				*utiltesting.MakePodSet("workers", 10).
+					Request(corev1.ResourceCPU, "1").
+					Toleration(corev1.Toleration{
+						Key:      "instance",
+						Operator: corev1.TolerationOpEqual,
+						Value:    "spot",
+						Effect:   corev1.TaintEffectNoSchedule,
+					}).
+					Obj(),
+			},
+			clusterQueue: cache.ClusterQueue{
+				ResourceGroups: []cache.ResourceGroup{{
+					CoveredResources: sets.New(corev1.ResourceCPU),
+					Flavors: []cache.FlavorQuotas{
+						{
+							Name: "one",
+							Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+								corev1.ResourceCPU: {Nominal: 4000},
+							},
+						},
+						{
+							Name: "tainted",
+							Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+								corev1.ResourceCPU: {Nominal: 10_000},
 							},
 						},
 					},
@@ -1440,6 +2064,121 @@ func TestAssignFlavors(t *testing.T) {
 			},
 			wantRepMode: Fit,
 		},
+		"required topology, skips flavors without topology": {
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 3).
+					Request(corev1.ResourceCPU, "1").
+					Annotation(kueue.PodSetRequiredTopologyAnnotation, "cloud.com/rack").
+					Obj(),
+			},
+			clusterQueue: cache.ClusterQueue{
+				ResourceGroups: []cache.ResourceGroup{{
+					CoveredResources: sets.New(corev1.ResourceCPU),
+					Flavors: []cache.FlavorQuotas{
+						{
+							Name: "default",
+							Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+								corev1.ResourceCPU: {Nominal: 10_000},
+							},
+						},
+						{
+							Name: "tas",
+							Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+								corev1.ResourceCPU: {Nominal: 10_000},
+							},
+						},
+					},
+				}},
+			},
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "tas", Mode: Fit},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("3000m"),
+					},
+					Count: 3,
+					TopologyAssignment: &kueue.TopologyAssignment{
+						Levels:  []string{"cloud.com/rack"},
+						Domains: []kueue.TopologyDomainAssignment{{Values: []string{"r2"}, Count: 3}},
+					},
+				}},
+			},
+		},
+		"required topology, doesn't fit in a domain": {
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 5).
+					Request(corev1.ResourceCPU, "1").
+					Annotation(kueue.PodSetRequiredTopologyAnnotation, "cloud.com/rack").
+					Obj(),
+			},
+			clusterQueue: cache.ClusterQueue{
+				ResourceGroups: []cache.ResourceGroup{{
+					CoveredResources: sets.New(corev1.ResourceCPU),
+					Flavors: []cache.FlavorQuotas{{
+						Name: "tas",
+						Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+							corev1.ResourceCPU: {Nominal: 10_000},
+						},
+					}},
+				}},
+			},
+			wantRepMode: NoFit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("5000m"),
+					},
+					Status: &Status{
+						reasons: []string{"not enough free capacity for 5 pods in a domain of topology level cloud.com/rack in flavor tas"},
+					},
+					Count: 5,
+				}},
+			},
+		},
+		"preferred topology, spreads over domains": {
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 5).
+					Request(corev1.ResourceCPU, "1").
+					Annotation(kueue.PodSetPreferredTopologyAnnotation, "cloud.com/rack").
+					Obj(),
+			},
+			clusterQueue: cache.ClusterQueue{
+				ResourceGroups: []cache.ResourceGroup{{
+					CoveredResources: sets.New(corev1.ResourceCPU),
+					Flavors: []cache.FlavorQuotas{{
+						Name: "tas",
+						Resources: map[corev1.ResourceName]*cache.ResourceQuota{
+							corev1.ResourceCPU: {Nominal: 10_000},
+						},
+					}},
+				}},
+			},
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "tas", Mode: Fit},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("5000m"),
+					},
+					Count: 5,
+					TopologyAssignment: &kueue.TopologyAssignment{
+						Levels: []string{"cloud.com/rack"},
+						Domains: []kueue.TopologyDomainAssignment{
+							{Values: []string{"r2"}, Count: 4},
+							{Values: []string{"r1"}, Count: 1},
+						},
+					},
+				}},
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -1447,8 +2186,10 @@ func TestAssignFlavors(t *testing.T) {
 				Verbosity: 2,
 			})
 			wlInfo := workload.NewInfo(&kueue.Workload{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns"},
 				Spec: kueue.WorkloadSpec{
-					PodSets: tc.wlPods,
+					QueueName: "lq",
+					PodSets:   tc.wlPods,
 				},
 				Status: kueue.WorkloadStatus{
 					ReclaimablePods: tc.wlReclaimablePods,
@@ -1456,7 +2197,7 @@ func TestAssignFlavors(t *testing.T) {
 			})
 			tc.clusterQueue.UpdateWithFlavors(resourceFlavors)
 			tc.clusterQueue.UpdateRGByResource()
-			assignment := AssignFlavors(log, wlInfo, resourceFlavors, &tc.clusterQueue, nil)
+			assignment := AssignFlavors(log, wlInfo, resourceFlavors, tasFlavors, &tc.clusterQueue, nil)
 			if repMode := assignment.RepresentativeMode(); repMode != tc.wantRepMode {
 				t.Errorf("e.assignFlavors(_).RepresentativeMode()=%s, want %s", repMode, tc.wantRepMode)
 			}
@@ -18,6 +18,7 @@ package preemption
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"sync/atomic"
 	"time"
@@ -29,6 +30,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/pointer"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -43,23 +45,32 @@ import (
 const parallelPreemptions = 8
 
 type Preemptor struct {
-	client   client.Client
-	recorder record.EventRecorder
+	client            client.Client
+	recorder          record.EventRecorder
+	enableFairSharing bool
+	victimOrdering    VictimOrdering
 
 	// stubs
-	applyPreemption func(context.Context, *kueue.Workload) error
+	applyPreemption func(ctx context.Context, w *kueue.Workload, message string) error
 }
 
-func New(cl client.Client, recorder record.EventRecorder) *Preemptor {
+// New returns a Preemptor. A nil victimOrdering selects the lowest priority
+// first.
+func New(cl client.Client, recorder record.EventRecorder, enableFairSharing bool, victimOrdering VictimOrdering) *Preemptor {
+	if victimOrdering == nil {
+		victimOrdering = lowestPriority{}
+	}
 	p := &Preemptor{
-		client:   cl,
-		recorder: recorder,
+		client:            cl,
+		recorder:          recorder,
+		enableFairSharing: enableFairSharing,
+		victimOrdering:    victimOrdering,
 	}
 	p.applyPreemption = p.applyPreemptionWithSSA
 	return p
 }
 
-func (p *Preemptor) OverrideApply(f func(context.Context, *kueue.Workload) error) {
+func (p *Preemptor) OverrideApply(f func(ctx context.Context, w *kueue.Workload, message string) error) {
 	p.applyPreemption = f
 }
 
@@ -82,7 +93,11 @@ func (p *Preemptor) GetTargets(wl workload.Info, assignment flavorassigner.Assig
 	if len(candidates) == 0 {
 		return nil
 	}
-	sort.Slice(candidates, candidatesOrdering(candidates, cq.Name, time.Now()))
+	sort.Slice(candidates, candidatesOrdering(candidates, cq.Name, cohortDistances(cq, snapshot), p.victimOrdering, time.Now()))
+
+	if p.enableFairSharing {
+		return fairPreemptions(&wl, assignment, snapshot, resPerFlv, candidates)
+	}
 
 	sameQueueCandidates := candidatesOnlyFromQueue(candidates, wl.ClusterQueue)
 	var targets []*workload.Info
@@ -96,33 +111,60 @@ func (p *Preemptor) GetTargets(wl workload.Info, assignment flavorassigner.Assig
 	if len(sameQueueCandidates) == len(candidates) {
 		// There is no risk of preemption of workloads from the other queue,
 		// so we can try borrowing.
-		targets = minimalPreemptions(&wl, assignment, snapshot, resPerFlv, candidates, true)
+		targets = minimalPreemptions(&wl, assignment, snapshot, resPerFlv, candidates, true, nil)
 	} else {
 		// There is a risk of preemption of workloads from the other queue in the
-		// cohort, proceeding without borrowing.
-		targets = minimalPreemptions(&wl, assignment, snapshot, resPerFlv, candidates, false)
+		// cohort. Borrowing is only allowed if the policy lets the workload
+		// preempt the workloads of the other queues below a priority threshold.
+		borrowWithinCohort, thresholdPrio := canBorrowWithinCohort(cq, wl.Obj)
+		targets = minimalPreemptions(&wl, assignment, snapshot, resPerFlv, candidates, borrowWithinCohort, thresholdPrio)
 		if len(targets) == 0 {
 			// Another attempt. This time only candidates from the same queue, but
 			// with borrowing. The previous attempt didn't try borrowing and had broader
 			// scope of preemption.
-			targets = minimalPreemptions(&wl, assignment, snapshot, resPerFlv, sameQueueCandidates, true)
+			targets = minimalPreemptions(&wl, assignment, snapshot, resPerFlv, sameQueueCandidates, true, nil)
 		}
 	}
 
 	return targets
 }
 
-// IssuePreemptions marks the target workloads as evicted.
-func (p *Preemptor) IssuePreemptions(ctx context.Context, targets []*workload.Info, cq *cache.ClusterQueue) (int, error) {
+// canBorrowWithinCohort returns whether the workload can borrow while
+// preempting workloads from other ClusterQueues in the cohort, and the
+// priority below which those workloads can be preempted.
+func canBorrowWithinCohort(cq *cache.ClusterQueue, wl *kueue.Workload) (bool, *int32) {
+	borrowWithinCohort := cq.Preemption.BorrowWithinCohort
+	if borrowWithinCohort == nil || borrowWithinCohort.Policy == kueue.BorrowWithinCohortPolicyNever {
+		return false, nil
+	}
+	threshold := priority.Priority(wl)
+	if borrowWithinCohort.MaxPriorityThreshold != nil && *borrowWithinCohort.MaxPriorityThreshold < threshold {
+		threshold = *borrowWithinCohort.MaxPriorityThreshold + 1
+	}
+	return true, &threshold
+}
+
+// IssuePreemptions marks the target workloads as evicted, or as pending
+// preemption if they have a grace period to terminate.
+func (p *Preemptor) IssuePreemptions(ctx context.Context, targets []*workload.Info, cq *cache.ClusterQueue, snapshot *cache.Snapshot) (int, error) {
 	log := ctrl.LoggerFrom(ctx)
 	errCh := routine.NewErrorChannel()
 	ctx, cancel := context.WithCancel(ctx)
 	var successfullyPreempted int64
 	defer cancel()
+	now := time.Now()
 	workqueue.ParallelizeUntil(ctx, parallelPreemptions, len(targets), func(i int) {
 		target := targets[i]
-		if !meta.IsStatusConditionTrue(target.Obj.Status.Conditions, kueue.WorkloadEvicted) {
-			err := p.applyPreemption(ctx, target.Obj)
+		if !meta.IsStatusConditionTrue(target.Obj.Status.Conditions, kueue.WorkloadEvicted) && !workload.IsPreemptionPending(target.Obj) {
+			message := fmt.Sprintf("Preempted to accommodate a higher priority Workload (victim ordering: %s, cost: %d)",
+				p.victimOrdering.Name(), p.victimOrdering.Cost(target, now))
+			wl := target.Obj
+			gracePeriod := preemptionGracePeriod(target, snapshot)
+			if gracePeriod > 0 {
+				wl = wl.DeepCopy()
+				workload.SetPreemptionPendingCondition(wl, message, metav1.NewTime(now.Add(gracePeriod)))
+			}
+			err := p.applyPreemption(ctx, wl, message)
 			if err != nil {
 				errCh.SendErrorWithCancel(err, cancel)
 				return
@@ -132,8 +174,12 @@ func (p *Preemptor) IssuePreemptions(ctx context.Context, targets []*workload.In
 			if cq.Name != target.ClusterQueue {
 				origin = "cohort"
 			}
-			log.V(3).Info("Preempted", "targetWorkload", klog.KObj(target.Obj))
-			p.recorder.Eventf(target.Obj, corev1.EventTypeNormal, "Preempted", "Preempted by another workload in the %s", origin)
+			log.V(3).Info("Preempted", "targetWorkload", klog.KObj(target.Obj), "gracePeriod", gracePeriod)
+			if gracePeriod > 0 {
+				p.recorder.Eventf(target.Obj, corev1.EventTypeNormal, "Preempted", "Preempted by another workload in the %s, with a grace period of %s", origin, gracePeriod)
+			} else {
+				p.recorder.Eventf(target.Obj, corev1.EventTypeNormal, "Preempted", "Preempted by another workload in the %s", origin)
+			}
 		} else {
 			log.V(3).Info("Preemption ongoing", "targetWorkload", klog.KObj(target.Obj))
 		}
@@ -142,12 +188,29 @@ func (p *Preemptor) IssuePreemptions(ctx context.Context, targets []*workload.In
 	return int(successfullyPreempted), errCh.ReceiveError()
 }
 
-func (p *Preemptor) applyPreemptionWithSSA(ctx context.Context, w *kueue.Workload) error {
+// applyPreemptionWithSSA evicts the workload, unless it was given a grace
+// period to terminate.
+func (p *Preemptor) applyPreemptionWithSSA(ctx context.Context, w *kueue.Workload, message string) error {
 	w = w.DeepCopy()
-	workload.SetEvictedCondition(w, kueue.WorkloadEvictedByPreemption, "Preempted to accommodate a higher priority Workload")
+	if !workload.IsPreemptionPending(w) {
+		workload.SetEvictedCondition(w, kueue.WorkloadEvictedByPreemption, message)
+	}
 	return workload.ApplyAdmissionStatus(ctx, p.client, w, false)
 }
 
+// preemptionGracePeriod returns the time the target is given to terminate
+// before it's evicted. The grace period of the workload takes precedence
+// over the one of its ClusterQueue.
+func preemptionGracePeriod(target *workload.Info, snapshot *cache.Snapshot) time.Duration {
+	seconds := target.Obj.Spec.PreemptionGracePeriodSeconds
+	if seconds == nil {
+		if targetCQ := snapshot.ClusterQueues[target.ClusterQueue]; targetCQ != nil {
+			seconds = targetCQ.Preemption.GracePeriodSeconds
+		}
+	}
+	return time.Duration(pointer.Int32Deref(seconds, 0)) * time.Second
+}
+
 // minimalPreemptions implements a heuristic to find a minimal set of Workloads
 // to preempt.
 // The heuristic first removes candidates, in the input order, while their
@@ -156,7 +219,9 @@ func (p *Preemptor) applyPreemptionWithSSA(ctx context.Context, w *kueue.Workloa
 // Once the Workload fits, the heuristic tries to add Workloads back, in the
 // reverse order in which they were removed, while the incoming Workload still
 // fits.
-func minimalPreemptions(wl *workload.Info, assignment flavorassigner.Assignment, snapshot *cache.Snapshot, resPerFlv resourcesPerFlavor, candidates []*workload.Info, allowBorrowing bool) []*workload.Info {
+// If allowBorrowingBelowPriority is set, borrowing is only allowed as long as
+// the Workloads removed from other ClusterQueues have a priority below it.
+func minimalPreemptions(wl *workload.Info, assignment flavorassigner.Assignment, snapshot *cache.Snapshot, resPerFlv resourcesPerFlavor, candidates []*workload.Info, allowBorrowing bool, allowBorrowingBelowPriority *int32) []*workload.Info {
 	wlReq := totalRequestsForAssignment(wl, assignment)
 	cq := snapshot.ClusterQueues[wl.ClusterQueue]
 	// Simulate removing all candidates from the ClusterQueue and cohort.
@@ -167,24 +232,75 @@ func minimalPreemptions(wl *workload.Info, assignment flavorassigner.Assignment,
 		if cq != candCQ && !cqIsBorrowing(candCQ, resPerFlv) {
 			continue
 		}
+		if cq != candCQ && allowBorrowingBelowPriority != nil && priority.Priority(candWl.Obj) >= *allowBorrowingBelowPriority {
+			// Preempting this workload is only allowed without borrowing.
+			allowBorrowing = false
+		}
 		snapshot.RemoveWorkload(candWl)
 		targets = append(targets, candWl)
-		if workloadFits(wlReq, cq, allowBorrowing) {
+		if workloadFits(wlReq, cq, allowBorrowing, false) {
 			fits = true
 			break
 		}
 	}
 	if !fits {
-		// Reset changes to the snapshot.
-		for _, t := range targets {
-			snapshot.AddWorkload(t)
+		restoreSnapshot(snapshot, targets)
+		return nil
+	}
+	targets = fillBackWorkloads(targets, wlReq, cq, snapshot, allowBorrowing, false)
+	restoreSnapshot(snapshot, targets)
+	return targets
+}
+
+// fairPreemptions implements a heuristic to find a set of Workloads to preempt
+// when fair sharing is enabled.
+// The heuristic removes candidates, in the input order, while the incoming
+// Workload doesn't fit in the quota. A candidate from another ClusterQueue is
+// only removed if the share of its ClusterQueue, after the removal, is not
+// lower than the share of the preemptor's ClusterQueue after admitting the
+// incoming Workload.
+// Once the Workload fits, the heuristic tries to add Workloads back, like in
+// minimalPreemptions.
+func fairPreemptions(wl *workload.Info, assignment flavorassigner.Assignment, snapshot *cache.Snapshot, resPerFlv resourcesPerFlavor, candidates []*workload.Info) []*workload.Info {
+	wlReq := totalRequestsForAssignment(wl, assignment)
+	cq := snapshot.ClusterQueues[wl.ClusterQueue]
+	preemptorShare, _ := cq.DominantResourceShareWith(wlReq)
+	var targets []*workload.Info
+	fits := false
+	for _, candWl := range candidates {
+		candCQ := snapshot.ClusterQueues[candWl.ClusterQueue]
+		if cq != candCQ && !cqIsBorrowing(candCQ, resPerFlv) {
+			continue
+		}
+		snapshot.RemoveWorkload(candWl)
+		if cq != candCQ {
+			if share, _ := candCQ.DominantResourceShare(); share < preemptorShare {
+				snapshot.AddWorkload(candWl)
+				continue
+			}
+		}
+		targets = append(targets, candWl)
+		if workloadFits(wlReq, cq, true, true) {
+			fits = true
+			break
 		}
+	}
+	if !fits {
+		restoreSnapshot(snapshot, targets)
 		return nil
 	}
-	// In the reverse order, check if any of the workloads can be added back.
+	targets = fillBackWorkloads(targets, wlReq, cq, snapshot, true, true)
+	restoreSnapshot(snapshot, targets)
+	return targets
+}
+
+// fillBackWorkloads checks, in the reverse order in which they were removed,
+// if any of the targets can be added back while the incoming Workload still
+// fits. It returns the targets that are still required.
+func fillBackWorkloads(targets []*workload.Info, wlReq cache.FlavorResourceQuantities, cq *cache.ClusterQueue, snapshot *cache.Snapshot, allowBorrowing, fairSharing bool) []*workload.Info {
 	for i := len(targets) - 2; i >= 0; i-- {
 		snapshot.AddWorkload(targets[i])
-		if workloadFits(wlReq, cq, allowBorrowing) {
+		if workloadFits(wlReq, cq, allowBorrowing, fairSharing) {
 			// O(1) deletion: copy the last element into index i and reduce size.
 			targets[i] = targets[len(targets)-1]
 			targets = targets[:len(targets)-1]
@@ -192,11 +308,15 @@ func minimalPreemptions(wl *workload.Info, assignment flavorassigner.Assignment,
 			snapshot.RemoveWorkload(targets[i])
 		}
 	}
-	// Reset changes to the snapshot.
+	return targets
+}
+
+// restoreSnapshot resets the changes done to the snapshot by removing the
+// targets.
+func restoreSnapshot(snapshot *cache.Snapshot, targets []*workload.Info) {
 	for _, t := range targets {
 		snapshot.AddWorkload(t)
 	}
-	return targets
 }
 
 type resourcesPerFlavor map[kueue.ResourceFlavorReference]sets.Set[corev1.ResourceName]
@@ -220,8 +340,8 @@ func resourcesRequiringPreemption(assignment flavorassigner.Assignment) resource
 }
 
 // findCandidates obtains candidates for preemption within the ClusterQueue and
-// cohort that respect the preemption policy and are using a resource that the
-// preempting workload needs.
+// the cohort tree that respect the preemption policy and are using a resource
+// that the preempting workload needs.
 func findCandidates(wl *kueue.Workload, cq *cache.ClusterQueue, resPerFlv resourcesPerFlavor) []*workload.Info {
 	var candidates []*workload.Info
 	wlPriority := priority.Priority(wl)
@@ -248,7 +368,7 @@ func findCandidates(wl *kueue.Workload, cq *cache.ClusterQueue, resPerFlv resour
 	}
 
 	if cq.Cohort != nil && cq.Preemption.ReclaimWithinCohort != kueue.PreemptionPolicyNever {
-		for cohortCQ := range cq.Cohort.Members {
+		for _, cohortCQ := range cq.Cohort.Root().SubtreeMembers() {
 			if cq == cohortCQ || !cqIsBorrowing(cohortCQ, resPerFlv) {
 				// Can't reclaim quota from itself or ClusterQueues that are not borrowing.
 				continue
@@ -316,9 +436,9 @@ func totalRequestsForAssignment(wl *workload.Info, assignment flavorassigner.Ass
 }
 
 // workloadFits determines if the workload requests would fits given the
-// requestable resources and simulated usage of the ClusterQueue and its cohort,
-// if it belongs to one.
-func workloadFits(wlReq cache.FlavorResourceQuantities, cq *cache.ClusterQueue, allowBorrowing bool) bool {
+// requestable resources and simulated usage of the ClusterQueue and its cohort
+// tree, if it belongs to one. fairSharing implies allowBorrowing.
+func workloadFits(wlReq cache.FlavorResourceQuantities, cq *cache.ClusterQueue, allowBorrowing, fairSharing bool) bool {
 	for _, rg := range cq.ResourceGroups {
 		for _, flvQuotas := range rg.Flavors {
 			flvReq, found := wlReq[flvQuotas.Name]
@@ -327,20 +447,20 @@ func workloadFits(wlReq cache.FlavorResourceQuantities, cq *cache.ClusterQueue,
 				continue
 			}
 			cqResUsage := cq.Usage[flvQuotas.Name]
-			var cohortResUsage, cohortResRequestable map[corev1.ResourceName]int64
-			if cq.Cohort != nil {
-				cohortResUsage = cq.Cohort.Usage[flvQuotas.Name]
-				cohortResRequestable = cq.Cohort.RequestableResources[flvQuotas.Name]
-			}
 			for rName, rReq := range flvReq {
-				limit := flvQuotas.Resources[rName].Nominal
-				if flvQuotas.Resources[rName].BorrowingLimit != nil && allowBorrowing {
-					limit += *flvQuotas.Resources[rName].BorrowingLimit
-				}
-				if cqResUsage[rName]+rReq > limit {
-					return false
+				rQuota := flvQuotas.Resources[rName]
+				// With fair sharing, borrowing without a borrowing limit is only
+				// limited by the unused quota in the cohort tree.
+				if !fairSharing || cq.Cohort == nil || rQuota.BorrowingLimit != nil {
+					limit := rQuota.Nominal
+					if rQuota.BorrowingLimit != nil && allowBorrowing {
+						limit += *rQuota.BorrowingLimit
+					}
+					if cqResUsage[rName]+rReq > limit {
+						return false
+					}
 				}
-				if cq.Cohort != nil && cohortResUsage[rName]+rReq > cohortResRequestable[rName] {
+				if cq.Cohort != nil && rReq > cq.Available(flvQuotas.Name, rName) {
 					return false
 				}
 			}
@@ -349,20 +469,60 @@ func workloadFits(wlReq cache.FlavorResourceQuantities, cq *cache.ClusterQueue,
 	return true
 }
 
+// cohortDistances returns, for each ClusterQueue in the cohort tree of cq,
+// how many levels up from the cohort of cq is the closest cohort that also
+// contains it.
+func cohortDistances(cq *cache.ClusterQueue, snapshot *cache.Snapshot) map[string]int {
+	if cq.Cohort == nil {
+		return nil
+	}
+	distances := make(map[string]int)
+	for _, other := range snapshot.ClusterQueues {
+		if other.Cohort == nil {
+			continue
+		}
+		d := 0
+		for cohort := cq.Cohort; cohort != nil && !other.Cohort.HasAncestor(cohort); cohort = cohort.Parent {
+			d++
+		}
+		distances[other.Name] = d
+	}
+	return distances
+}
+
 // candidatesOrdering criteria:
+// 0. Workloads already evicted or pending preemption first, so that a
+// preemptor waits for them instead of preempting more workloads.
 // 1. Workloads from other ClusterQueues in the cohort before the ones in the
 // same ClusterQueue as the preemptor.
-// 2. Workloads with lower priority first.
-// 3. Workloads admited more recently first.
-func candidatesOrdering(candidates []*workload.Info, cq string, now time.Time) func(int, int) bool {
+// 2. Workloads from ClusterQueues farther in the cohort tree first, as their
+// usage took quota out of a closer cohort.
+// 3. Workloads with a lower cost, according to the victim ordering policy,
+// first.
+// 4. Workloads with lower priority first.
+// 5. Workloads admited more recently first.
+func candidatesOrdering(candidates []*workload.Info, cq string, distances map[string]int, ordering VictimOrdering, now time.Time) func(int, int) bool {
+	costs := make(map[*workload.Info]int64, len(candidates))
+	for _, c := range candidates {
+		costs[c] = ordering.Cost(c, now)
+	}
 	return func(i, j int) bool {
 		a := candidates[i]
 		b := candidates[j]
+		if aPreempted, bPreempted := isBeingPreempted(a.Obj), isBeingPreempted(b.Obj); aPreempted != bPreempted {
+			return aPreempted
+		}
 		aInCQ := a.ClusterQueue == cq
 		bInCQ := b.ClusterQueue == cq
 		if aInCQ != bInCQ {
 			return !aInCQ
 		}
+		if da, db := distances[a.ClusterQueue], distances[b.ClusterQueue]; da != db {
+			return da > db
+		}
+		if costs[a] != costs[b] {
+			return costs[a] < costs[b]
+		}
 		pa := priority.Priority(a.Obj)
 		pb := priority.Priority(b.Obj)
 		if pa != pb {
@@ -372,8 +532,12 @@ func candidatesOrdering(candidates []*workload.Info, cq string, now time.Time) f
 	}
 }
 
+func isBeingPreempted(wl *kueue.Workload) bool {
+	return meta.IsStatusConditionTrue(wl.Status.Conditions, kueue.WorkloadEvicted) || workload.IsPreemptionPending(wl)
+}
+
 func admisionTime(wl *kueue.Workload, now time.Time) time.Time {
-	cond := meta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadAdmitted)
+	cond := meta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadQuotaReserved)
 	if cond == nil || cond.Status != metav1.ConditionTrue {
 		// The condition wasn't populated yet, use the current time.
 		return now
@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/cache"
@@ -41,7 +42,8 @@ import (
 
 var snapCmpOpts = []cmp.Option{
 	cmpopts.EquateEmpty(),
-	cmpopts.IgnoreUnexported(cache.ClusterQueue{}),
+	cmpopts.IgnoreUnexported(cache.ClusterQueue{}, cache.Cohort{}),
+	cmpopts.IgnoreFields(cache.Cohort{}, "Parent", "ChildCohorts"), // avoid recursion.
 	cmp.Transformer("Cohort.Members", func(s sets.Set[*cache.ClusterQueue]) sets.Set[string] {
 		result := make(sets.Set[string], len(s))
 		for cq := range s {
@@ -111,6 +113,76 @@ func TestPreemption(t *testing.T) {
 				ReclaimWithinCohort: kueue.PreemptionPolicyLowerPriority,
 			}).
 			Obj(),
+		utiltesting.MakeClusterQueue("f1").
+			Cohort("fair").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").
+				Resource(corev1.ResourceCPU, "4").
+				Obj(),
+			).
+			Preemption(kueue.ClusterQueuePreemption{
+				ReclaimWithinCohort: kueue.PreemptionPolicyAny,
+			}).
+			Obj(),
+		utiltesting.MakeClusterQueue("f2").
+			Cohort("fair").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").
+				Resource(corev1.ResourceCPU, "4").
+				Obj(),
+			).
+			Preemption(kueue.ClusterQueuePreemption{
+				ReclaimWithinCohort: kueue.PreemptionPolicyAny,
+			}).
+			Obj(),
+		utiltesting.MakeClusterQueue("f3").
+			Cohort("fair").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").
+				Resource(corev1.ResourceCPU, "4").
+				Obj(),
+			).
+			Obj(),
+		utiltesting.MakeClusterQueue("b1").
+			Cohort("borrowing").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").
+				Resource(corev1.ResourceCPU, "6", "6").
+				Obj(),
+			).
+			Preemption(kueue.ClusterQueuePreemption{
+				WithinClusterQueue:  kueue.PreemptionPolicyLowerPriority,
+				ReclaimWithinCohort: kueue.PreemptionPolicyLowerPriority,
+				BorrowWithinCohort: &kueue.BorrowWithinCohort{
+					Policy:               kueue.BorrowWithinCohortPolicyLowerPriority,
+					MaxPriorityThreshold: pointer.Int32(0),
+				},
+			}).
+			Obj(),
+		utiltesting.MakeClusterQueue("b2").
+			Cohort("borrowing").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").
+				Resource(corev1.ResourceCPU, "6", "6").
+				Obj(),
+			).
+			Obj(),
+		utiltesting.MakeClusterQueue("n1").
+			Cohort("nolimit").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").
+				Resource(corev1.ResourceCPU, "6").
+				Obj(),
+			).
+			Preemption(kueue.ClusterQueuePreemption{
+				ReclaimWithinCohort: kueue.PreemptionPolicyLowerPriority,
+				BorrowWithinCohort: &kueue.BorrowWithinCohort{
+					Policy:               kueue.BorrowWithinCohortPolicyLowerPriority,
+					MaxPriorityThreshold: pointer.Int32(0),
+				},
+			}).
+			Obj(),
+		utiltesting.MakeClusterQueue("n2").
+			Cohort("nolimit").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").
+				Resource(corev1.ResourceCPU, "6").
+				Obj(),
+			).
+			Obj(),
 		utiltesting.MakeClusterQueue("preventStarvation").
 			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").
 				Resource(corev1.ResourceCPU, "6").
@@ -121,12 +193,33 @@ func TestPreemption(t *testing.T) {
 			}).
 			Obj(),
 	}
+	fairAdmitted := []kueue.Workload{
+		*utiltesting.MakeWorkload("f1-low", "").
+			Priority(-1).
+			Request(corev1.ResourceCPU, "2").
+			Admit(utiltesting.MakeAdmission("f1").Assignment(corev1.ResourceCPU, "default", "2000m").Obj()).
+			Obj(),
+		*utiltesting.MakeWorkload("f1-mid", "").
+			Request(corev1.ResourceCPU, "3").
+			Admit(utiltesting.MakeAdmission("f1").Assignment(corev1.ResourceCPU, "default", "3000m").Obj()).
+			Obj(),
+		*utiltesting.MakeWorkload("f1-high", "").
+			Priority(1).
+			Request(corev1.ResourceCPU, "3").
+			Admit(utiltesting.MakeAdmission("f1").Assignment(corev1.ResourceCPU, "default", "3000m").Obj()).
+			Obj(),
+		*utiltesting.MakeWorkload("f2", "").
+			Request(corev1.ResourceCPU, "4").
+			Admit(utiltesting.MakeAdmission("f2").Assignment(corev1.ResourceCPU, "default", "4000m").Obj()).
+			Obj(),
+	}
 	cases := map[string]struct {
-		admitted      []kueue.Workload
-		incoming      *kueue.Workload
-		targetCQ      string
-		assignment    flavorassigner.Assignment
-		wantPreempted sets.Set[string]
+		admitted          []kueue.Workload
+		incoming          *kueue.Workload
+		targetCQ          string
+		assignment        flavorassigner.Assignment
+		enableFairSharing bool
+		wantPreempted     sets.Set[string]
 	}{
 		"preempt lowest priority": {
 			admitted: []kueue.Workload{
@@ -361,6 +454,86 @@ func TestPreemption(t *testing.T) {
 			}),
 			wantPreempted: sets.New("/c2-mid"),
 		},
+		"preempt borrower in the cohort while borrowing": {
+			admitted: []kueue.Workload{
+				*utiltesting.MakeWorkload("b2-low", "").
+					Priority(-1).
+					Request(corev1.ResourceCPU, "10").
+					Admit(utiltesting.MakeAdmission("b2").Assignment(corev1.ResourceCPU, "default", "10000m").Obj()).
+					Obj(),
+			},
+			incoming: utiltesting.MakeWorkload("in", "").
+				Priority(2).
+				Request(corev1.ResourceCPU, "8").
+				Obj(),
+			targetCQ: "b1",
+			assignment: singlePodSetAssignment(flavorassigner.ResourceAssignment{
+				corev1.ResourceCPU: &flavorassigner.FlavorAssignment{
+					Name: "default",
+					Mode: flavorassigner.Preempt,
+				},
+			}),
+			wantPreempted: sets.New("/b2-low"),
+		},
+		"don't preempt borrower above the priority threshold while borrowing": {
+			admitted: []kueue.Workload{
+				*utiltesting.MakeWorkload("b2-mid", "").
+					Priority(1).
+					Request(corev1.ResourceCPU, "10").
+					Admit(utiltesting.MakeAdmission("b2").Assignment(corev1.ResourceCPU, "default", "10000m").Obj()).
+					Obj(),
+			},
+			incoming: utiltesting.MakeWorkload("in", "").
+				Priority(2).
+				Request(corev1.ResourceCPU, "8").
+				Obj(),
+			targetCQ: "b1",
+			assignment: singlePodSetAssignment(flavorassigner.ResourceAssignment{
+				corev1.ResourceCPU: &flavorassigner.FlavorAssignment{
+					Name: "default",
+					Mode: flavorassigner.Preempt,
+				},
+			}),
+		},
+		"reclaim quota from borrower without borrowing limit": {
+			admitted: []kueue.Workload{
+				*utiltesting.MakeWorkload("n2-low", "").
+					Priority(-1).
+					Request(corev1.ResourceCPU, "10").
+					Admit(utiltesting.MakeAdmission("n2").Assignment(corev1.ResourceCPU, "default", "10000m").Obj()).
+					Obj(),
+			},
+			incoming: utiltesting.MakeWorkload("in", "").
+				Request(corev1.ResourceCPU, "4").
+				Obj(),
+			targetCQ: "n1",
+			assignment: singlePodSetAssignment(flavorassigner.ResourceAssignment{
+				corev1.ResourceCPU: &flavorassigner.FlavorAssignment{
+					Name: "default",
+					Mode: flavorassigner.Preempt,
+				},
+			}),
+			wantPreempted: sets.New("/n2-low"),
+		},
+		"don't preempt borrower to borrow without borrowing limit": {
+			admitted: []kueue.Workload{
+				*utiltesting.MakeWorkload("n2-low", "").
+					Priority(-1).
+					Request(corev1.ResourceCPU, "10").
+					Admit(utiltesting.MakeAdmission("n2").Assignment(corev1.ResourceCPU, "default", "10000m").Obj()).
+					Obj(),
+			},
+			incoming: utiltesting.MakeWorkload("in", "").
+				Request(corev1.ResourceCPU, "8").
+				Obj(),
+			targetCQ: "n1",
+			assignment: singlePodSetAssignment(flavorassigner.ResourceAssignment{
+				corev1.ResourceCPU: &flavorassigner.FlavorAssignment{
+					Name: "default",
+					Mode: flavorassigner.Preempt,
+				},
+			}),
+		},
 		"no workloads borrowing": {
 			admitted: []kueue.Workload{
 				*utiltesting.MakeWorkload("c1-high", "").
@@ -716,7 +889,7 @@ func TestPreemption(t *testing.T) {
 					Request(corev1.ResourceCPU, "2").
 					Admit(utiltesting.MakeAdmission("preventStarvation").Assignment(corev1.ResourceCPU, "default", "2").Obj()).
 					SetOrReplaceCondition(metav1.Condition{
-						Type:               kueue.WorkloadAdmitted,
+						Type:               kueue.WorkloadQuotaReserved,
 						Status:             metav1.ConditionTrue,
 						LastTransitionTime: metav1.NewTime(time.Now().Add(time.Second)),
 					}).
@@ -752,6 +925,48 @@ func TestPreemption(t *testing.T) {
 			},
 			wantPreempted: sets.New("/wl2"),
 		},
+		"can't reclaim quota to borrow without fair sharing": {
+			admitted: fairAdmitted,
+			incoming: utiltesting.MakeWorkload("in", "").
+				Request(corev1.ResourceCPU, "2").
+				Obj(),
+			targetCQ: "f2",
+			assignment: singlePodSetAssignment(flavorassigner.ResourceAssignment{
+				corev1.ResourceCPU: &flavorassigner.FlavorAssignment{
+					Name: "default",
+					Mode: flavorassigner.Preempt,
+				},
+			}),
+		},
+		"fair sharing: reclaim quota from ClusterQueue with higher share": {
+			admitted: fairAdmitted,
+			incoming: utiltesting.MakeWorkload("in", "").
+				Request(corev1.ResourceCPU, "2").
+				Obj(),
+			targetCQ: "f2",
+			assignment: singlePodSetAssignment(flavorassigner.ResourceAssignment{
+				corev1.ResourceCPU: &flavorassigner.FlavorAssignment{
+					Name: "default",
+					Mode: flavorassigner.Preempt,
+				},
+			}),
+			enableFairSharing: true,
+			wantPreempted:     sets.New("/f1-low"),
+		},
+		"fair sharing: don't reclaim quota if the share of the other ClusterQueue becomes lower": {
+			admitted: fairAdmitted,
+			incoming: utiltesting.MakeWorkload("in", "").
+				Request(corev1.ResourceCPU, "4").
+				Obj(),
+			targetCQ: "f2",
+			assignment: singlePodSetAssignment(flavorassigner.ResourceAssignment{
+				corev1.ResourceCPU: &flavorassigner.FlavorAssignment{
+					Name: "default",
+					Mode: flavorassigner.Preempt,
+				},
+			}),
+			enableFairSharing: true,
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -775,8 +990,8 @@ func TestPreemption(t *testing.T) {
 			broadcaster := record.NewBroadcaster()
 			scheme := runtime.NewScheme()
 			recorder := broadcaster.NewRecorder(scheme, corev1.EventSource{Component: constants.AdmissionName})
-			preemptor := New(cl, recorder)
-			preemptor.applyPreemption = func(ctx context.Context, w *kueue.Workload) error {
+			preemptor := New(cl, recorder, tc.enableFairSharing, nil)
+			preemptor.applyPreemption = func(ctx context.Context, w *kueue.Workload, _ string) error {
 				lock.Lock()
 				gotPreempted.Insert(workload.Key(w))
 				lock.Unlock()
@@ -789,7 +1004,7 @@ func TestPreemption(t *testing.T) {
 			wlInfo := workload.NewInfo(tc.incoming)
 			wlInfo.ClusterQueue = tc.targetCQ
 			targets := preemptor.GetTargets(*wlInfo, tc.assignment, &snapshot)
-			preempted, err := preemptor.IssuePreemptions(ctx, targets, snapshot.ClusterQueues[wlInfo.ClusterQueue])
+			preempted, err := preemptor.IssuePreemptions(ctx, targets, snapshot.ClusterQueues[wlInfo.ClusterQueue], &snapshot)
 			if err != nil {
 				t.Fatalf("Failed doing preemption")
 			}
@@ -809,6 +1024,14 @@ func TestPreemption(t *testing.T) {
 func TestCandidatesOrdering(t *testing.T) {
 	now := time.Now()
 	candidates := []*workload.Info{
+		workload.NewInfo(utiltesting.MakeWorkload("preempting", "").
+			Admit(utiltesting.MakeAdmission("self").Obj()).
+			Priority(10).
+			Condition(metav1.Condition{
+				Type:   kueue.WorkloadPreemptionPending,
+				Status: metav1.ConditionTrue,
+			}).
+			Obj()),
 		workload.NewInfo(utiltesting.MakeWorkload("high", "").
 			Admit(utiltesting.MakeAdmission("self").Obj()).
 			Priority(10).
@@ -834,12 +1057,12 @@ func TestCandidatesOrdering(t *testing.T) {
 			}).
 			Obj()),
 	}
-	sort.Slice(candidates, candidatesOrdering(candidates, "self", now))
+	sort.Slice(candidates, candidatesOrdering(candidates, "self", nil, lowestPriority{}, now))
 	gotNames := make([]string, len(candidates))
 	for i, c := range candidates {
 		gotNames[i] = workload.Key(c.Obj)
 	}
-	wantCandidates := []string{"/other", "/low", "/current", "/old", "/high"}
+	wantCandidates := []string{"/preempting", "/other", "/low", "/current", "/old", "/high"}
 	if diff := cmp.Diff(wantCandidates, gotNames); diff != "" {
 		t.Errorf("Sorted with wrong order (-want,+got):\n%s", diff)
 	}
@@ -853,3 +1076,72 @@ func singlePodSetAssignment(assignments flavorassigner.ResourceAssignment) flavo
 		}},
 	}
 }
+
+func TestIssuePreemptionsWithGracePeriod(t *testing.T) {
+	cases := map[string]struct {
+		workload        *kueue.Workload
+		wantPending     bool
+		wantGracePeriod time.Duration
+	}{
+		"grace period of the ClusterQueue": {
+			workload:        utiltesting.MakeWorkload("wl", "").Obj(),
+			wantPending:     true,
+			wantGracePeriod: time.Minute,
+		},
+		"grace period of the workload": {
+			workload:        utiltesting.MakeWorkload("wl", "").PreemptionGracePeriodSeconds(120).Obj(),
+			wantPending:     true,
+			wantGracePeriod: 2 * time.Minute,
+		},
+		"no grace period for the workload": {
+			workload: utiltesting.MakeWorkload("wl", "").PreemptionGracePeriodSeconds(0).Obj(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			cl := utiltesting.NewClientBuilder().Build()
+			cqCache := cache.New(cl)
+			cqCache.AddOrUpdateResourceFlavor(utiltesting.MakeResourceFlavor("default").Obj())
+			cq := utiltesting.MakeClusterQueue("cq").
+				ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "1").Obj()).
+				Preemption(kueue.ClusterQueuePreemption{GracePeriodSeconds: pointer.Int32(60)}).
+				Obj()
+			if err := cqCache.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Couldn't add ClusterQueue to cache: %v", err)
+			}
+			snapshot := cqCache.Snapshot()
+
+			var applied *kueue.Workload
+			var appliedMessage string
+			recorder := record.NewBroadcaster().NewRecorder(runtime.NewScheme(), corev1.EventSource{Component: constants.AdmissionName})
+			preemptor := New(cl, recorder, false, nil)
+			preemptor.applyPreemption = func(_ context.Context, w *kueue.Workload, message string) error {
+				applied = w
+				appliedMessage = message
+				return nil
+			}
+			target := workload.NewInfo(tc.workload)
+			target.ClusterQueue = "cq"
+			start := time.Now()
+			if _, err := preemptor.IssuePreemptions(ctx, []*workload.Info{target}, snapshot.ClusterQueues["cq"], &snapshot); err != nil {
+				t.Fatalf("Failed doing preemption: %v", err)
+			}
+			if applied == nil {
+				t.Fatal("Preemption was not applied")
+			}
+			if got := workload.IsPreemptionPending(applied); got != tc.wantPending {
+				t.Errorf("Unexpected preemption pending, want=%v, got=%v", tc.wantPending, got)
+			}
+			if appliedMessage == "" {
+				t.Error("Preemption was applied without a message")
+			}
+			if tc.wantPending {
+				deadline := applied.Status.PreemptionDeadline
+				if deadline == nil || deadline.Before(&metav1.Time{Time: start.Add(tc.wantGracePeriod)}) || deadline.After(time.Now().Add(tc.wantGracePeriod)) {
+					t.Errorf("Unexpected deadline %v for a grace period of %v", deadline, tc.wantGracePeriod)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preemption
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta1"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/util/priority"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// VictimOrdering determines the order in which the candidates for preemption
+// are considered. Candidates with a lower cost are preempted first.
+type VictimOrdering interface {
+	// Name returns the name of the policy, recorded in the eviction message.
+	Name() string
+	// Cost returns the cost of preempting the workload.
+	Cost(wl *workload.Info, now time.Time) int64
+}
+
+// NewVictimOrdering returns the VictimOrdering for the policy. An empty policy
+// selects the lowest priority first.
+func NewVictimOrdering(policy config.VictimOrderingPolicy) (VictimOrdering, error) {
+	switch policy {
+	case "", config.LowestPriorityFirst:
+		return lowestPriority{}, nil
+	case config.LeastRuntimeLost:
+		return leastRuntimeLost{}, nil
+	case config.FewestPods:
+		return fewestPods{}, nil
+	case config.LowestPreemptionCost:
+		return lowestPreemptionCost{}, nil
+	}
+	return nil, fmt.Errorf("unsupported victim ordering policy %q", policy)
+}
+
+type lowestPriority struct{}
+
+func (lowestPriority) Name() string {
+	return string(config.LowestPriorityFirst)
+}
+
+func (lowestPriority) Cost(wl *workload.Info, _ time.Time) int64 {
+	return int64(priority.Priority(wl.Obj))
+}
+
+// leastRuntimeLost prefers the workloads that have been running for the
+// shortest time since they were admitted. The cost is in seconds.
+type leastRuntimeLost struct{}
+
+func (leastRuntimeLost) Name() string {
+	return string(config.LeastRuntimeLost)
+}
+
+func (leastRuntimeLost) Cost(wl *workload.Info, now time.Time) int64 {
+	cond := meta.FindStatusCondition(wl.Obj.Status.Conditions, kueue.WorkloadAdmitted)
+	if cond == nil || cond.Status != metav1.ConditionTrue {
+		return 0
+	}
+	return int64(now.Sub(cond.LastTransitionTime.Time) / time.Second)
+}
+
+type fewestPods struct{}
+
+func (fewestPods) Name() string {
+	return string(config.FewestPods)
+}
+
+func (fewestPods) Cost(wl *workload.Info, _ time.Time) int64 {
+	var pods int64
+	for _, ps := range wl.TotalRequests {
+		pods += int64(ps.Count)
+	}
+	return pods
+}
+
+// lowestPreemptionCost prefers the workloads with the lowest cost set by the
+// user in the preemption-cost annotation. Workloads without a valid cost have
+// a cost of zero.
+type lowestPreemptionCost struct{}
+
+func (lowestPreemptionCost) Name() string {
+	return string(config.LowestPreemptionCost)
+}
+
+func (lowestPreemptionCost) Cost(wl *workload.Info, _ time.Time) int64 {
+	cost, err := strconv.ParseInt(wl.Obj.Annotations[controllerconsts.PreemptionCostAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return cost
+}
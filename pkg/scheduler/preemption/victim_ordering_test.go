@@ -0,0 +1,116 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preemption
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta1"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+func TestVictimOrdering(t *testing.T) {
+	now := time.Now()
+	admitted := func(ago time.Duration) metav1.Condition {
+		return metav1.Condition{
+			Type:               kueue.WorkloadAdmitted,
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: metav1.NewTime(now.Add(-ago)),
+		}
+	}
+	candidates := func() []*workload.Info {
+		return []*workload.Info{
+			workload.NewInfo(utiltesting.MakeWorkload("long-running", "").
+				Priority(-1).
+				PodSets(*utiltesting.MakePodSet("main", 2).Obj()).
+				Annotation(controllerconsts.PreemptionCostAnnotation, "30").
+				Admit(utiltesting.MakeAdmission("self").Obj()).
+				SetOrReplaceCondition(admitted(40 * time.Hour)).
+				Obj()),
+			workload.NewInfo(utiltesting.MakeWorkload("recent", "").
+				PodSets(*utiltesting.MakePodSet("main", 10).Obj()).
+				Annotation(controllerconsts.PreemptionCostAnnotation, "20").
+				Admit(utiltesting.MakeAdmission("self").Obj()).
+				SetOrReplaceCondition(admitted(time.Minute)).
+				Obj()),
+			workload.NewInfo(utiltesting.MakeWorkload("small", "").
+				Priority(1).
+				PodSets(*utiltesting.MakePodSet("main", 1).Obj()).
+				Annotation(controllerconsts.PreemptionCostAnnotation, "invalid").
+				Admit(utiltesting.MakeAdmission("self").Obj()).
+				SetOrReplaceCondition(admitted(time.Hour)).
+				Obj()),
+		}
+	}
+	cases := map[config.VictimOrderingPolicy]struct {
+		wantOrder []string
+		wantCosts map[string]int64
+	}{
+		"": {
+			wantOrder: []string{"/long-running", "/recent", "/small"},
+			wantCosts: map[string]int64{"/long-running": -1, "/recent": 0, "/small": 1},
+		},
+		config.LeastRuntimeLost: {
+			wantOrder: []string{"/recent", "/small", "/long-running"},
+			wantCosts: map[string]int64{"/long-running": 144000, "/recent": 60, "/small": 3600},
+		},
+		config.FewestPods: {
+			wantOrder: []string{"/small", "/long-running", "/recent"},
+			wantCosts: map[string]int64{"/long-running": 2, "/recent": 10, "/small": 1},
+		},
+		config.LowestPreemptionCost: {
+			wantOrder: []string{"/small", "/recent", "/long-running"},
+			wantCosts: map[string]int64{"/long-running": 30, "/recent": 20, "/small": 0},
+		},
+	}
+	for policy, tc := range cases {
+		t.Run(string(policy), func(t *testing.T) {
+			ordering, err := NewVictimOrdering(policy)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			wls := candidates()
+			sort.Slice(wls, candidatesOrdering(wls, "self", nil, ordering, now))
+			gotOrder := make([]string, len(wls))
+			gotCosts := make(map[string]int64, len(wls))
+			for i, wl := range wls {
+				gotOrder[i] = workload.Key(wl.Obj)
+				gotCosts[gotOrder[i]] = ordering.Cost(wl, now)
+			}
+			if diff := cmp.Diff(tc.wantOrder, gotOrder); diff != "" {
+				t.Errorf("Unexpected order (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantCosts, gotCosts); diff != "" {
+				t.Errorf("Unexpected costs (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewVictimOrderingUnsupported(t *testing.T) {
+	if _, err := NewVictimOrdering("Random"); err == nil {
+		t.Error("Expected an error for an unsupported policy")
+	}
+}
@@ -62,16 +62,35 @@ type Scheduler struct {
 	recorder                record.EventRecorder
 	admissionRoutineWrapper routine.Wrapper
 	preemptor               *preemption.Preemptor
+	enableFairSharing       bool
 	// Stubs.
 	applyAdmission func(context.Context, *kueue.Workload) error
 }
 
 type options struct {
+	enableFairSharing bool
+	victimOrdering    preemption.VictimOrdering
 }
 
 // Option configures the reconciler.
 type Option func(*options)
 
+// WithFairSharing indicates if the scheduler should order the workloads, and
+// select preemption candidates, based on the share of their ClusterQueues.
+func WithFairSharing(f bool) Option {
+	return func(o *options) {
+		o.enableFairSharing = f
+	}
+}
+
+// WithVictimOrdering sets the policy used to order the candidates for
+// preemption.
+func WithVictimOrdering(o preemption.VictimOrdering) Option {
+	return func(opts *options) {
+		opts.victimOrdering = o
+	}
+}
+
 var defaultOptions = options{}
 
 func New(queues *queue.Manager, cache *cache.Cache, cl client.Client, recorder record.EventRecorder, opts ...Option) *Scheduler {
@@ -84,8 +103,9 @@ func New(queues *queue.Manager, cache *cache.Cache, cl client.Client, recorder r
 		cache:                   cache,
 		client:                  cl,
 		recorder:                recorder,
-		preemptor:               preemption.New(cl, recorder),
+		preemptor:               preemption.New(cl, recorder, options.enableFairSharing, options.victimOrdering),
 		admissionRoutineWrapper: routine.DefaultWrapper,
+		enableFairSharing:       options.enableFairSharing,
 	}
 	s.applyAdmission = s.applyAdmissionWithSSA
 	return s
@@ -127,8 +147,11 @@ func (s *Scheduler) schedule(ctx context.Context) {
 	// 3. Calculate requirements (resource flavors, borrowing) for admitting workloads.
 	entries := s.nominate(ctx, headWorkloads, snapshot)
 
-	// 4. Sort entries based on borrowing and timestamps.
-	sort.Sort(entryOrdering(entries))
+	// 4. Sort entries based on borrowing, fair sharing and timestamps.
+	sort.Sort(entryOrdering{
+		entries:           entries,
+		enableFairSharing: s.enableFairSharing,
+	})
 
 	// 5. Admit entries, ensuring that no more than one workload gets
 	// admitted by a cohort (if borrowing).
@@ -143,26 +166,28 @@ func (s *Scheduler) schedule(ctx context.Context) {
 		}
 		cq := snapshot.ClusterQueues[e.ClusterQueue]
 		if cq.Cohort != nil {
+			// The quota is shared by the whole cohort tree.
+			cohort := cq.Cohort.Root()
 			// Having more than one workloads from the same cohort admitted in the same scheduling cycle can lead
 			// to over admission if:
 			//   1. One of the workloads is borrowing, since during the nomination the usage of the other workloads
 			//      evaluated in the same cycle is not taken into account.
 			//   2. An already admitted workload from a different cluster queue is borrowing, since all workloads
 			//      evaluated in the current cycle will compete for the resources that are not borrowed.
-			if usedCohorts.Has(cq.Cohort.Name) && (e.assignment.Borrows() || cq.Cohort.HasBorrowingQueues()) {
+			if usedCohorts.Has(cohort.Name) && (e.assignment.Borrows() || cohort.HasBorrowingQueues()) {
 				e.status = skipped
 				e.inadmissibleMsg = "other workloads in the cohort were prioritized"
 				continue
 			}
 			// Even if there was a failure, we shouldn't admit other workloads to this
 			// cohort.
-			usedCohorts.Insert(cq.Cohort.Name)
+			usedCohorts.Insert(cohort.Name)
 		}
 		log := log.WithValues("workload", klog.KObj(e.Obj), "clusterQueue", klog.KRef("", e.ClusterQueue))
 		ctx := ctrl.LoggerInto(ctx, log)
 		if e.assignment.RepresentativeMode() != flavorassigner.Fit {
 			if len(e.preemptionTargets) != 0 {
-				preempted, err := s.preemptor.IssuePreemptions(ctx, e.preemptionTargets, cq)
+				preempted, err := s.preemptor.IssuePreemptions(ctx, e.preemptionTargets, cq, &snapshot)
 				if err != nil {
 					log.Error(err, "Failed to preempt workloads")
 				}
@@ -175,12 +200,19 @@ func (s *Scheduler) schedule(ctx context.Context) {
 			}
 			continue
 		}
+		// The topology domains were found without considering the workloads
+		// admitted earlier in this cycle.
+		if !fitsTopologies(&snapshot, e.assignment.TASUsages()) {
+			e.status = skipped
+			e.inadmissibleMsg = "other workloads were placed in the topology domains"
+			continue
+		}
 		if !s.cache.PodsReadyForAllAdmittedWorkloads(log) {
 			log.V(5).Info("Waiting for all admitted workloads to be in the PodsReady condition")
 			// If WaitForPodsReady is enabled and WaitForPodsReady.BlockAdmission is true
 			// Block admission until all currently admitted workloads are in
 			// PodsReady condition if the waitForPodsReady is enabled
-			workload.UnsetAdmissionWithCondition(e.Obj, "Waiting", "waiting for all admitted workloads to be in PodsReady condition")
+			workload.UnsetQuotaReservationWithCondition(e.Obj, "Waiting", "waiting for all admitted workloads to be in PodsReady condition")
 			if err := workload.ApplyAdmissionStatus(ctx, s.client, e.Obj, true); err != nil {
 				log.Error(err, "Could not update Workload status")
 			}
@@ -188,8 +220,12 @@ func (s *Scheduler) schedule(ctx context.Context) {
 			log.V(5).Info("Finished waiting for all admitted workloads to be in the PodsReady condition")
 		}
 		e.status = nominated
-		if err := s.admit(ctx, e); err != nil {
+		if err := s.admit(ctx, e, cq.AdmissionChecks); err != nil {
 			e.inadmissibleMsg = fmt.Sprintf("Failed to admit workload: %v", err)
+			continue
+		}
+		for _, u := range e.assignment.TASUsages() {
+			snapshot.TASFlavors[u.Flavor].AddUsage(u)
 		}
 	}
 
@@ -210,6 +246,22 @@ func (s *Scheduler) schedule(ctx context.Context) {
 	metrics.AdmissionAttempt(result, time.Since(startTime))
 }
 
+// fitsTopologies returns whether the usages of the topology domains fit in
+// the free capacity of the nodes in the snapshot.
+func fitsTopologies(snapshot *cache.Snapshot, usages []cache.TASUsage) bool {
+	byFlavor := make(map[kueue.ResourceFlavorReference][]cache.TASUsage)
+	for _, u := range usages {
+		byFlavor[u.Flavor] = append(byFlavor[u.Flavor], u)
+	}
+	for fName, flavorUsages := range byFlavor {
+		tasFlavor := snapshot.TASFlavors[fName]
+		if tasFlavor == nil || !tasFlavor.Fits(flavorUsages) {
+			return false
+		}
+	}
+	return true
+}
+
 type entryStatus string
 
 const (
@@ -233,6 +285,9 @@ type entry struct {
 	inadmissibleMsg   string
 	requeueReason     queue.RequeueReason
 	preemptionTargets []*workload.Info
+	// dominantResourceShare is the share of the ClusterQueue if the workload
+	// was admitted. Only calculated when fair sharing is enabled.
+	dominantResourceShare int
 }
 
 // nominate returns the workloads with their requirements (resource flavors, borrowing) if
@@ -264,6 +319,12 @@ func (s *Scheduler) nominate(ctx context.Context, workloads []workload.Info, sna
 		} else {
 			e.assignment, e.preemptionTargets = s.getAssignments(log, &e.Info, &snap)
 			e.inadmissibleMsg = e.assignment.Message()
+			if e.assignment.RepresentativeMode() == flavorassigner.NoFit && e.assignment.LocalQueueLimited() {
+				e.requeueReason = queue.RequeueReasonLocalQueueLimit
+			}
+			if s.enableFairSharing {
+				e.dominantResourceShare, _ = cq.DominantResourceShareWith(e.assignment.Usage())
+			}
 		}
 		entries = append(entries, e)
 	}
@@ -277,7 +338,7 @@ type partialAssignment struct {
 
 func (s *Scheduler) getAssignments(log logr.Logger, wl *workload.Info, snap *cache.Snapshot) (flavorassigner.Assignment, []*workload.Info) {
 	cq := snap.ClusterQueues[wl.ClusterQueue]
-	fullAssignment := flavorassigner.AssignFlavors(log, wl, snap.ResourceFlavors, cq, nil)
+	fullAssignment := flavorassigner.AssignFlavors(log, wl, snap.ResourceFlavors, snap.TASFlavors, cq, nil)
 	var fullAssignmentTargets []*workload.Info
 
 	arm := fullAssignment.RepresentativeMode()
@@ -296,7 +357,7 @@ func (s *Scheduler) getAssignments(log logr.Logger, wl *workload.Info, snap *cac
 
 	if wl.CanBePartiallyAdmitted() {
 		reducer := flavorassigner.NewPodSetReducer(wl.Obj.Spec.PodSets, func(nextCounts []int32) (*partialAssignment, bool) {
-			assignment := flavorassigner.AssignFlavors(log, wl, snap.ResourceFlavors, cq, nextCounts)
+			assignment := flavorassigner.AssignFlavors(log, wl, snap.ResourceFlavors, snap.TASFlavors, cq, nextCounts)
 			if assignment.RepresentativeMode() == flavorassigner.Fit {
 				return &partialAssignment{assignment: assignment}, true
 			}
@@ -377,7 +438,9 @@ func (s *Scheduler) validateLimitRange(ctx context.Context, wi *workload.Info) e
 // admit sets the admitting clusterQueue and flavors into the workload of
 // the entry, and asynchronously updates the object in the apiserver after
 // assuming it in the cache.
-func (s *Scheduler) admit(ctx context.Context, e *entry) error {
+// If the ClusterQueue requires admission checks, the workload only reserves
+// quota; it becomes admitted once all the checks are ready.
+func (s *Scheduler) admit(ctx context.Context, e *entry, mustHaveChecks sets.Set[string]) error {
 	log := ctrl.LoggerFrom(ctx)
 	newWorkload := e.Obj.DeepCopy()
 	admission := &kueue.Admission{
@@ -385,7 +448,10 @@ func (s *Scheduler) admit(ctx context.Context, e *entry) error {
 		PodSetAssignments: e.assignment.ToAPI(),
 	}
 
-	workload.SetAdmission(newWorkload, admission)
+	workload.SetQuotaReservation(newWorkload, admission)
+	workload.SyncAdmissionChecks(newWorkload, mustHaveChecks)
+	// sync Admitted, ignore the result since an API update is always done.
+	_ = workload.SyncAdmittedCondition(newWorkload)
 	if err := s.cache.AssumeWorkload(newWorkload); err != nil {
 		return err
 	}
@@ -396,9 +462,14 @@ func (s *Scheduler) admit(ctx context.Context, e *entry) error {
 		err := s.applyAdmission(ctx, newWorkload)
 		if err == nil {
 			waitTime := time.Since(e.Obj.CreationTimestamp.Time)
-			s.recorder.Eventf(newWorkload, corev1.EventTypeNormal, "Admitted", "Admitted by ClusterQueue %v, wait time was %.0fs", admission.ClusterQueue, waitTime.Seconds())
-			metrics.AdmittedWorkload(admission.ClusterQueue, waitTime)
-			log.V(2).Info("Workload successfully admitted and assigned flavors", "assignments", admission.PodSetAssignments)
+			if workload.IsAdmitted(newWorkload) {
+				s.recorder.Eventf(newWorkload, corev1.EventTypeNormal, "Admitted", "Admitted by ClusterQueue %v, wait time was %.0fs", admission.ClusterQueue, waitTime.Seconds())
+				metrics.AdmittedWorkload(admission.ClusterQueue, waitTime)
+				log.V(2).Info("Workload successfully admitted and assigned flavors", "assignments", admission.PodSetAssignments)
+			} else {
+				s.recorder.Eventf(newWorkload, corev1.EventTypeNormal, "QuotaReserved", "Quota reserved in ClusterQueue %v, wait time was %.0fs", admission.ClusterQueue, waitTime.Seconds())
+				log.V(2).Info("Workload successfully reserved quota and assigned flavors, waiting for admission checks", "assignments", admission.PodSetAssignments)
+			}
 			return
 		}
 		// Ignore errors because the workload or clusterQueue could have been deleted
@@ -420,23 +491,27 @@ func (s *Scheduler) applyAdmissionWithSSA(ctx context.Context, w *kueue.Workload
 	return workload.ApplyAdmissionStatus(ctx, s.client, w, false)
 }
 
-type entryOrdering []entry
+type entryOrdering struct {
+	entries           []entry
+	enableFairSharing bool
+}
 
 func (e entryOrdering) Len() int {
-	return len(e)
+	return len(e.entries)
 }
 
 func (e entryOrdering) Swap(i, j int) {
-	e[i], e[j] = e[j], e[i]
+	e.entries[i], e.entries[j] = e.entries[j], e.entries[i]
 }
 
 // Less is the ordering criteria:
 // 1. request under nominal quota before borrowing.
-// 2. higher priority first.
-// 3. FIFO on eviction or creation timestamp.
+// 2. lower share of the ClusterQueue first, if fair sharing is enabled.
+// 3. higher priority first.
+// 4. FIFO on eviction or creation timestamp.
 func (e entryOrdering) Less(i, j int) bool {
-	a := e[i]
-	b := e[j]
+	a := e.entries[i]
+	b := e.entries[j]
 
 	// 1. Request under nominal quota.
 	aBorrows := a.assignment.Borrows()
@@ -445,14 +520,19 @@ func (e entryOrdering) Less(i, j int) bool {
 		return !aBorrows
 	}
 
-	// 2. Higher priority first.
+	// 2. Lower share first.
+	if e.enableFairSharing && a.dominantResourceShare != b.dominantResourceShare {
+		return a.dominantResourceShare < b.dominantResourceShare
+	}
+
+	// 3. Higher priority first.
 	p1 := priority.Priority(a.Obj)
 	p2 := priority.Priority(b.Obj)
 	if p1 != p2 {
 		return p1 > p2
 	}
 
-	// 2. FIFO.
+	// 4. FIFO.
 	aComparisonTimestamp := workload.GetQueueOrderTimestamp(a.Obj)
 	bComparisonTimestamp := workload.GetQueueOrderTimestamp(b.Obj)
 	return aComparisonTimestamp.Before(bComparisonTimestamp)
@@ -467,7 +547,7 @@ func (s *Scheduler) requeueAndUpdate(log logr.Logger, ctx context.Context, e ent
 	log.V(2).Info("Workload re-queued", "workload", klog.KObj(e.Obj), "clusterQueue", klog.KRef("", e.ClusterQueue), "queue", klog.KRef(e.Obj.Namespace, e.Obj.Spec.QueueName), "requeueReason", e.requeueReason, "added", added)
 
 	if e.status == notNominated {
-		workload.UnsetAdmissionWithCondition(e.Obj, "Pending", e.inadmissibleMsg)
+		workload.UnsetQuotaReservationWithCondition(e.Obj, "Pending", e.inadmissibleMsg)
 		err := workload.ApplyAdmissionStatus(ctx, s.client, e.Obj, true)
 		if err != nil {
 			log.Error(err, "Could not update Workload status")
@@ -533,6 +533,109 @@ func TestSchedule(t *testing.T) {
 				"eng-alpha/borrower": *utiltesting.MakeAdmission("eng-alpha").Assignment(corev1.ResourceCPU, "on-demand", "60").Obj(),
 			},
 		},
+		"preempt borrower in the cohort while borrowing": {
+			additionalClusterQueues: []kueue.ClusterQueue{
+				*utiltesting.MakeClusterQueue("cq-a").
+					Cohort("borrowing").
+					Preemption(kueue.ClusterQueuePreemption{
+						ReclaimWithinCohort: kueue.PreemptionPolicyAny,
+						BorrowWithinCohort: &kueue.BorrowWithinCohort{
+							Policy:               kueue.BorrowWithinCohortPolicyLowerPriority,
+							MaxPriorityThreshold: pointer.Int32(0),
+						},
+					}).
+					ResourceGroup(*utiltesting.MakeFlavorQuotas("default").
+						Resource(corev1.ResourceCPU, "6", "6").Obj()).
+					Obj(),
+				*utiltesting.MakeClusterQueue("cq-b").
+					Cohort("borrowing").
+					ResourceGroup(*utiltesting.MakeFlavorQuotas("default").
+						Resource(corev1.ResourceCPU, "6", "6").Obj()).
+					Obj(),
+			},
+			additionalLocalQueues: []kueue.LocalQueue{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "eng-gamma",
+						Name:      "main",
+					},
+					Spec: kueue.LocalQueueSpec{
+						ClusterQueue: "cq-a",
+					},
+				},
+			},
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("preemptor", "eng-gamma").
+					Queue("main").
+					Priority(1).
+					Request(corev1.ResourceCPU, "8").
+					Obj(),
+				*utiltesting.MakeWorkload("borrower", "eng-beta").
+					Priority(-1).
+					Request(corev1.ResourceCPU, "9").
+					Admit(utiltesting.MakeAdmission("cq-b").Assignment(corev1.ResourceCPU, "default", "9").Obj()).
+					Obj(),
+			},
+			wantLeft: map[string]sets.Set[string]{
+				// Preemptor is not admitted in this cycle.
+				"cq-a": sets.New("eng-gamma/preemptor"),
+			},
+			wantPreempted: sets.New("eng-beta/borrower"),
+			wantAssignments: map[string]kueue.Admission{
+				// Removal from cache for the preempted workloads is deferred until we receive Workload updates
+				"eng-beta/borrower": *utiltesting.MakeAdmission("cq-b").Assignment(corev1.ResourceCPU, "default", "9").Obj(),
+			},
+		},
+		"don't preempt borrower in the cohort above the priority threshold while borrowing": {
+			additionalClusterQueues: []kueue.ClusterQueue{
+				*utiltesting.MakeClusterQueue("cq-a").
+					Cohort("borrowing").
+					Preemption(kueue.ClusterQueuePreemption{
+						ReclaimWithinCohort: kueue.PreemptionPolicyAny,
+						BorrowWithinCohort: &kueue.BorrowWithinCohort{
+							Policy:               kueue.BorrowWithinCohortPolicyLowerPriority,
+							MaxPriorityThreshold: pointer.Int32(0),
+						},
+					}).
+					ResourceGroup(*utiltesting.MakeFlavorQuotas("default").
+						Resource(corev1.ResourceCPU, "6", "6").Obj()).
+					Obj(),
+				*utiltesting.MakeClusterQueue("cq-b").
+					Cohort("borrowing").
+					ResourceGroup(*utiltesting.MakeFlavorQuotas("default").
+						Resource(corev1.ResourceCPU, "6", "6").Obj()).
+					Obj(),
+			},
+			additionalLocalQueues: []kueue.LocalQueue{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "eng-gamma",
+						Name:      "main",
+					},
+					Spec: kueue.LocalQueueSpec{
+						ClusterQueue: "cq-a",
+					},
+				},
+			},
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("preemptor", "eng-gamma").
+					Queue("main").
+					Priority(2).
+					Request(corev1.ResourceCPU, "8").
+					Obj(),
+				*utiltesting.MakeWorkload("borrower", "eng-beta").
+					Priority(1).
+					Request(corev1.ResourceCPU, "9").
+					Admit(utiltesting.MakeAdmission("cq-b").Assignment(corev1.ResourceCPU, "default", "9").Obj()).
+					Obj(),
+			},
+			wantInadmissibleLeft: map[string]sets.Set[string]{
+				"cq-a": sets.New("eng-gamma/preemptor"),
+			},
+			wantAssignments: map[string]kueue.Admission{
+				"eng-beta/borrower": *utiltesting.MakeAdmission("cq-b").Assignment(corev1.ResourceCPU, "default", "9").Obj(),
+			},
+		},
 		"cannot borrow resource not listed in clusterQueue": {
 			workloads: []kueue.Workload{
 				*utiltesting.MakeWorkload("new", "eng-alpha").
@@ -920,7 +1023,7 @@ func TestSchedule(t *testing.T) {
 				func() { wg.Done() },
 			))
 			gotPreempted := sets.New[string]()
-			scheduler.preemptor.OverrideApply(func(_ context.Context, w *kueue.Workload) error {
+			scheduler.preemptor.OverrideApply(func(_ context.Context, w *kueue.Workload, _ string) error {
 				mu.Lock()
 				gotPreempted.Insert(workload.Key(w))
 				mu.Unlock()
@@ -994,6 +1097,7 @@ func TestEntryOrdering(t *testing.T) {
 					"flavor": {},
 				},
 			},
+			dominantResourceShare: 300,
 		},
 		{
 			Info: workload.Info{
@@ -1025,6 +1129,7 @@ func TestEntryOrdering(t *testing.T) {
 					"flavor": {},
 				},
 			},
+			dominantResourceShare: 200,
 		},
 		{
 			Info: workload.Info{
@@ -1048,6 +1153,7 @@ func TestEntryOrdering(t *testing.T) {
 					"flavor": {},
 				},
 			},
+			dominantResourceShare: 100,
 		},
 		{
 			Info: workload.Info{
@@ -1073,6 +1179,7 @@ func TestEntryOrdering(t *testing.T) {
 					"flavor": {},
 				},
 			},
+			dominantResourceShare: 100,
 		},
 		{
 			Info: workload.Info{
@@ -1095,14 +1202,34 @@ func TestEntryOrdering(t *testing.T) {
 			},
 		},
 	}
-	sort.Sort(entryOrdering(input))
-	order := make([]string, len(input))
-	for i, e := range input {
-		order[i] = e.Obj.Name
+	cases := map[string]struct {
+		enableFairSharing bool
+		wantOrder         []string
+	}{
+		"default": {
+			wantOrder: []string{"new_high_pri", "old", "recently_evicted", "new", "high_pri_borrowing", "old_borrowing", "evicted_borrowing", "new_borrowing"},
+		},
+		"fair sharing": {
+			enableFairSharing: true,
+			wantOrder:         []string{"new_high_pri", "old", "recently_evicted", "new", "evicted_borrowing", "new_borrowing", "high_pri_borrowing", "old_borrowing"},
+		},
 	}
-	wantOrder := []string{"new_high_pri", "old", "recently_evicted", "new", "high_pri_borrowing", "old_borrowing", "evicted_borrowing", "new_borrowing"}
-	if diff := cmp.Diff(wantOrder, order); diff != "" {
-		t.Errorf("Unexpected order (-want,+got):\n%s", diff)
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			entries := make([]entry, len(input))
+			copy(entries, input)
+			sort.Sort(entryOrdering{
+				entries:           entries,
+				enableFairSharing: tc.enableFairSharing,
+			})
+			order := make([]string, len(entries))
+			for i, e := range entries {
+				order[i] = e.Obj.Name
+			}
+			if diff := cmp.Diff(tc.wantOrder, order); diff != "" {
+				t.Errorf("Unexpected order (-want,+got):\n%s", diff)
+			}
+		})
 	}
 }
 
@@ -1128,7 +1255,7 @@ func TestRequeueAndUpdate(t *testing.T) {
 			wantStatus: kueue.WorkloadStatus{
 				Conditions: []metav1.Condition{
 					{
-						Type:    kueue.WorkloadAdmitted,
+						Type:    kueue.WorkloadQuotaReserved,
 						Status:  metav1.ConditionFalse,
 						Reason:  "Pending",
 						Message: "didn't fit",
@@ -149,6 +149,15 @@ func (h *Heap) Pop() interface{} {
 	return heap.Pop(&h.data)
 }
 
+// Peek returns the head of the heap without removing it, or nil if the heap
+// is empty.
+func (h *Heap) Peek() interface{} {
+	if h.data.Len() == 0 {
+		return nil
+	}
+	return h.data.items[h.data.keys[0]].obj
+}
+
 // Get returns the requested item, exists, error.
 func (h *Heap) Get(obj interface{}) (item interface{}) {
 	key := h.data.keyFunc(obj)
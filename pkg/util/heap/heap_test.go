@@ -283,3 +283,20 @@ func TestHeap_List(t *testing.T) {
 		}
 	}
 }
+
+// TestHeap_Peek tests Heap.Peek and ensures that the head is not removed.
+func TestHeap_Peek(t *testing.T) {
+	h := New(testHeapObjectKeyFunc, compareInts)
+	if item := h.Peek(); item != nil {
+		t.Errorf("expected nil for an empty heap, got %v", item)
+	}
+	h.PushOrUpdate(mkHeapObj("foo", 10))
+	h.PushOrUpdate(mkHeapObj("bar", 1))
+	h.PushOrUpdate(mkHeapObj("baz", 11))
+	if item := h.Peek(); item.(testHeapObject).val != 1 {
+		t.Errorf("expected %d, got %d", 1, item.(testHeapObject).val)
+	}
+	if h.Len() != 3 {
+		t.Errorf("expected the heap to keep %d items, got %d", 3, h.Len())
+	}
+}
@@ -37,31 +37,42 @@ func Priority(w *kueue.Workload) int32 {
 }
 
 // GetPriorityFromPriorityClass returns the priority populated from
-// priority class. If not specified, priority will be default or
-// zero if there is no default.
+// priority class, along with the source of the priority class.
+// If not specified, priority will be default or zero if there is no default.
 func GetPriorityFromPriorityClass(ctx context.Context, client client.Client,
-	priorityClass string) (string, int32, error) {
+	priorityClass string) (string, string, int32, error) {
 	if len(priorityClass) == 0 {
 		return getDefaultPriority(ctx, client)
 	}
 
 	pc := &schedulingv1.PriorityClass{}
 	if err := client.Get(ctx, types.NamespacedName{Name: priorityClass}, pc); err != nil {
-		return "", 0, err
+		return "", "", 0, err
 	}
 
-	return pc.Name, pc.Value, nil
+	return pc.Name, constants.PodPriorityClassSource, pc.Value, nil
 }
 
-func getDefaultPriority(ctx context.Context, client client.Client) (string, int32, error) {
+// GetPriorityFromWorkloadPriorityClass returns the priority populated from
+// the WorkloadPriorityClass, along with the source of the priority class.
+func GetPriorityFromWorkloadPriorityClass(ctx context.Context, client client.Client,
+	workloadPriorityClass string) (string, string, int32, error) {
+	wpc := &kueue.WorkloadPriorityClass{}
+	if err := client.Get(ctx, types.NamespacedName{Name: workloadPriorityClass}, wpc); err != nil {
+		return "", "", 0, err
+	}
+	return wpc.Name, constants.WorkloadPriorityClassSource, wpc.Value, nil
+}
+
+func getDefaultPriority(ctx context.Context, client client.Client) (string, string, int32, error) {
 	dpc, err := getDefaultPriorityClass(ctx, client)
 	if err != nil {
-		return "", 0, err
+		return "", "", 0, err
 	}
 	if dpc != nil {
-		return dpc.Name, dpc.Value, nil
+		return dpc.Name, constants.PodPriorityClassSource, dpc.Value, nil
 	}
-	return "", int32(constants.DefaultPriority), nil
+	return "", "", int32(constants.DefaultPriority), nil
 }
 
 func getDefaultPriorityClass(ctx context.Context, client client.Client) (*schedulingv1.PriorityClass, error) {
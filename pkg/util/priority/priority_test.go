@@ -65,11 +65,12 @@ func TestGetPriorityFromPriorityClass(t *testing.T) {
 	}
 
 	tests := map[string]struct {
-		priorityClassList      *schedulingv1.PriorityClassList
-		priorityClassName      string
-		wantPriorityClassName  string
-		wantPriorityClassValue int32
-		wantErr                string
+		priorityClassList       *schedulingv1.PriorityClassList
+		priorityClassName       string
+		wantPriorityClassName   string
+		wantPriorityClassSource string
+		wantPriorityClassValue  int32
+		wantErr                 string
 	}{
 		"priorityClass is specified and it exists": {
 			priorityClassList: &schedulingv1.PriorityClassList{
@@ -80,9 +81,10 @@ func TestGetPriorityFromPriorityClass(t *testing.T) {
 					},
 				},
 			},
-			priorityClassName:      "test",
-			wantPriorityClassName:  "test",
-			wantPriorityClassValue: 50,
+			priorityClassName:       "test",
+			wantPriorityClassName:   "test",
+			wantPriorityClassSource: constants.PodPriorityClassSource,
+			wantPriorityClassValue:  50,
 		},
 		"priorityClass is specified and it does not exist": {
 			priorityClassList: &schedulingv1.PriorityClassList{
@@ -101,8 +103,9 @@ func TestGetPriorityFromPriorityClass(t *testing.T) {
 					},
 				},
 			},
-			wantPriorityClassName:  "globalDefault",
-			wantPriorityClassValue: 40,
+			wantPriorityClassName:   "globalDefault",
+			wantPriorityClassSource: constants.PodPriorityClassSource,
+			wantPriorityClassValue:  40,
 		},
 		"priorityClass is unspecified and multiple global defaults exist": {
 			priorityClassList: &schedulingv1.PriorityClassList{
@@ -124,8 +127,20 @@ func TestGetPriorityFromPriorityClass(t *testing.T) {
 					},
 				},
 			},
-			wantPriorityClassName:  "globalDefault2",
-			wantPriorityClassValue: 20,
+			wantPriorityClassName:   "globalDefault2",
+			wantPriorityClassSource: constants.PodPriorityClassSource,
+			wantPriorityClassValue:  20,
+		},
+		"priorityClass is unspecified and there is no global default": {
+			priorityClassList: &schedulingv1.PriorityClassList{
+				Items: []schedulingv1.PriorityClass{
+					{
+						ObjectMeta: v1.ObjectMeta{Name: "test"},
+						Value:      50,
+					},
+				},
+			},
+			wantPriorityClassValue: constants.DefaultPriority,
 		},
 	}
 
@@ -137,7 +152,83 @@ func TestGetPriorityFromPriorityClass(t *testing.T) {
 			builder := fake.NewClientBuilder().WithScheme(scheme).WithLists(tt.priorityClassList)
 			client := builder.Build()
 
-			name, value, err := GetPriorityFromPriorityClass(context.Background(), client, tt.priorityClassName)
+			name, source, value, err := GetPriorityFromPriorityClass(context.Background(), client, tt.priorityClassName)
+			if tt.wantErr != "" {
+				if err == nil {
+					t.Fatalf("expected an error")
+				}
+
+				if diff := cmp.Diff(tt.wantErr, err.Error()); diff != "" {
+					t.Errorf("unexpected error (-want,+got):\n%s", diff)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if name != tt.wantPriorityClassName {
+				t.Errorf("unexpected name: got: %s, expected: %s", name, tt.wantPriorityClassName)
+			}
+
+			if source != tt.wantPriorityClassSource {
+				t.Errorf("unexpected source: got: %s, expected: %s", source, tt.wantPriorityClassSource)
+			}
+
+			if value != tt.wantPriorityClassValue {
+				t.Errorf("unexpected value: got: %d, expected: %d", value, tt.wantPriorityClassValue)
+			}
+		})
+	}
+}
+
+func TestGetPriorityFromWorkloadPriorityClass(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := kueue.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed adding kueue scheme: %v", err)
+	}
+
+	tests := map[string]struct {
+		workloadPriorityClassList *kueue.WorkloadPriorityClassList
+		workloadPriorityClassName string
+		wantPriorityClassName     string
+		wantPriorityClassSource   string
+		wantPriorityClassValue    int32
+		wantErr                   string
+	}{
+		"workloadPriorityClass is specified and it exists": {
+			workloadPriorityClassList: &kueue.WorkloadPriorityClassList{
+				Items: []kueue.WorkloadPriorityClass{
+					{
+						ObjectMeta: v1.ObjectMeta{Name: "test"},
+						Value:      50,
+					},
+				},
+			},
+			workloadPriorityClassName: "test",
+			wantPriorityClassName:     "test",
+			wantPriorityClassSource:   constants.WorkloadPriorityClassSource,
+			wantPriorityClassValue:    50,
+		},
+		"workloadPriorityClass is specified and it does not exist": {
+			workloadPriorityClassList: &kueue.WorkloadPriorityClassList{
+				Items: []kueue.WorkloadPriorityClass{},
+			},
+			workloadPriorityClassName: "test",
+			wantErr:                   `workloadpriorityclasses.kueue.x-k8s.io "test" not found`,
+		},
+	}
+
+	for desc, tt := range tests {
+		tt := tt
+		t.Run(desc, func(t *testing.T) {
+			t.Parallel()
+
+			builder := fake.NewClientBuilder().WithScheme(scheme).WithLists(tt.workloadPriorityClassList)
+			client := builder.Build()
+
+			name, source, value, err := GetPriorityFromWorkloadPriorityClass(context.Background(), client, tt.workloadPriorityClassName)
 			if tt.wantErr != "" {
 				if err == nil {
 					t.Fatalf("expected an error")
@@ -157,6 +248,10 @@ func TestGetPriorityFromPriorityClass(t *testing.T) {
 				t.Errorf("unexpected name: got: %s, expected: %s", name, tt.wantPriorityClassName)
 			}
 
+			if source != tt.wantPriorityClassSource {
+				t.Errorf("unexpected source: got: %s, expected: %s", source, tt.wantPriorityClassSource)
+			}
+
 			if value != tt.wantPriorityClassValue {
 				t.Errorf("unexpected value: got: %d, expected: %d", value, tt.wantPriorityClassValue)
 			}
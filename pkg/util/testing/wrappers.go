@@ -56,6 +56,31 @@ func (p *PriorityClassWrapper) Obj() *schedulingv1.PriorityClass {
 	return &p.PriorityClass
 }
 
+// WorkloadPriorityClassWrapper wraps a WorkloadPriorityClass.
+type WorkloadPriorityClassWrapper struct {
+	kueue.WorkloadPriorityClass
+}
+
+// MakeWorkloadPriorityClass creates a wrapper for a WorkloadPriorityClass.
+func MakeWorkloadPriorityClass(name string) *WorkloadPriorityClassWrapper {
+	return &WorkloadPriorityClassWrapper{kueue.WorkloadPriorityClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		}},
+	}
+}
+
+// PriorityValue updates the value of the WorkloadPriorityClass.
+func (p *WorkloadPriorityClassWrapper) PriorityValue(v int32) *WorkloadPriorityClassWrapper {
+	p.Value = v
+	return p
+}
+
+// Obj returns the inner WorkloadPriorityClass.
+func (p *WorkloadPriorityClassWrapper) Obj() *kueue.WorkloadPriorityClass {
+	return &p.WorkloadPriorityClass
+}
+
 type WorkloadWrapper struct{ kueue.Workload }
 
 // MakeWorkload creates a wrapper for a Workload with a single
@@ -75,6 +100,10 @@ func (w *WorkloadWrapper) Obj() *kueue.Workload {
 	return &w.Workload
 }
 
+func (w *WorkloadWrapper) Clone() *WorkloadWrapper {
+	return &WorkloadWrapper{Workload: *w.DeepCopy()}
+}
+
 func (w *WorkloadWrapper) Request(r corev1.ResourceName, q string) *WorkloadWrapper {
 	w.Spec.PodSets[0].Template.Spec.Containers[0].Resources.Requests[r] = resource.MustParse(q)
 	return w
@@ -97,10 +126,36 @@ func (w *WorkloadWrapper) Queue(q string) *WorkloadWrapper {
 	return w
 }
 
-func (w *WorkloadWrapper) Admit(a *kueue.Admission) *WorkloadWrapper {
+// Active sets the .spec.active of the workload.
+func (w *WorkloadWrapper) Active(a bool) *WorkloadWrapper {
+	w.Spec.Active = &a
+	return w
+}
+
+// MaximumExecutionTimeSeconds sets the maximum execution time of the workload.
+func (w *WorkloadWrapper) MaximumExecutionTimeSeconds(v int32) *WorkloadWrapper {
+	w.Spec.MaximumExecutionTimeSeconds = &v
+	return w
+}
+
+// PreemptionGracePeriodSeconds sets the preemption grace period of the workload.
+func (w *WorkloadWrapper) PreemptionGracePeriodSeconds(v int32) *WorkloadWrapper {
+	w.Spec.PreemptionGracePeriodSeconds = &v
+	return w
+}
+
+// RequeueState sets the requeue state of the workload.
+func (w *WorkloadWrapper) RequeueState(count *int32, requeueAt *metav1.Time) *WorkloadWrapper {
+	w.Status.RequeueState = &kueue.RequeueState{Count: count, RequeueAt: requeueAt}
+	return w
+}
+
+// ReserveQuota sets the admission and the QuotaReserved condition of the
+// workload, without marking it as admitted.
+func (w *WorkloadWrapper) ReserveQuota(a *kueue.Admission) *WorkloadWrapper {
 	w.Status.Admission = a
 	w.Status.Conditions = []metav1.Condition{{
-		Type:               kueue.WorkloadAdmitted,
+		Type:               kueue.WorkloadQuotaReserved,
 		Status:             metav1.ConditionTrue,
 		LastTransitionTime: metav1.Now(),
 		Reason:             "AdmittedByTest",
@@ -109,6 +164,31 @@ func (w *WorkloadWrapper) Admit(a *kueue.Admission) *WorkloadWrapper {
 	return w
 }
 
+// Admit reserves quota for the workload and marks it as admitted.
+func (w *WorkloadWrapper) Admit(a *kueue.Admission) *WorkloadWrapper {
+	w.ReserveQuota(a)
+	apimeta.SetStatusCondition(&w.Status.Conditions, metav1.Condition{
+		Type:               kueue.WorkloadAdmitted,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             "AdmittedByTest",
+		Message:            fmt.Sprintf("Admitted by ClusterQueue %s", w.Status.Admission.ClusterQueue),
+	})
+	return w
+}
+
+// AdmissionCheck adds or replaces the state of an admission check of the workload.
+func (w *WorkloadWrapper) AdmissionCheck(ac kueue.AdmissionCheckState) *WorkloadWrapper {
+	for i := range w.Status.AdmissionChecks {
+		if w.Status.AdmissionChecks[i].Name == ac.Name {
+			w.Status.AdmissionChecks[i] = ac
+			return w
+		}
+	}
+	w.Status.AdmissionChecks = append(w.Status.AdmissionChecks, ac)
+	return w
+}
+
 func (w *WorkloadWrapper) Creation(t time.Time) *WorkloadWrapper {
 	w.CreationTimestamp = metav1.NewTime(t)
 	return w
@@ -119,6 +199,11 @@ func (w *WorkloadWrapper) PriorityClass(priorityClassName string) *WorkloadWrapp
 	return w
 }
 
+func (w *WorkloadWrapper) PriorityClassSource(source string) *WorkloadWrapper {
+	w.Spec.PriorityClassSource = source
+	return w
+}
+
 func (w *WorkloadWrapper) RuntimeClass(name string) *WorkloadWrapper {
 	for i := range w.Spec.PodSets {
 		w.Spec.PodSets[i].Template.Spec.RuntimeClassName = &name
@@ -170,6 +255,14 @@ func (w *WorkloadWrapper) Labels(l map[string]string) *WorkloadWrapper {
 	return w
 }
 
+func (w *WorkloadWrapper) Annotation(k, v string) *WorkloadWrapper {
+	if w.ObjectMeta.Annotations == nil {
+		w.ObjectMeta.Annotations = make(map[string]string)
+	}
+	w.ObjectMeta.Annotations[k] = v
+	return w
+}
+
 type PodSetWrapper struct{ kueue.PodSet }
 
 func MakePodSet(name string, count int) *PodSetWrapper {
@@ -228,6 +321,20 @@ func (p *PodSetWrapper) NodeSelector(kv map[string]string) *PodSetWrapper {
 	return p
 }
 
+func (p *PodSetWrapper) PriorityClass(pc string) *PodSetWrapper {
+	p.Template.Spec.PriorityClassName = pc
+	return p
+}
+
+// Annotation sets an annotation of the pod template.
+func (p *PodSetWrapper) Annotation(k, v string) *PodSetWrapper {
+	if p.Template.Annotations == nil {
+		p.Template.Annotations = make(map[string]string)
+	}
+	p.Template.Annotations[k] = v
+	return p
+}
+
 // AdmissionWrapper wraps an Admission
 type AdmissionWrapper struct{ kueue.Admission }
 
@@ -276,6 +383,12 @@ func (w *AdmissionWrapper) AssignmentPodCount(value int32) *AdmissionWrapper {
 	return w
 }
 
+// TopologyAssignment sets the topology assignment of the first pod set.
+func (w *AdmissionWrapper) TopologyAssignment(ta *kueue.TopologyAssignment) *AdmissionWrapper {
+	w.PodSetAssignments[0].TopologyAssignment = ta
+	return w
+}
+
 func (w *AdmissionWrapper) PodSets(podSets ...kueue.PodSetAssignment) *AdmissionWrapper {
 	w.PodSetAssignments = podSets
 	return w
@@ -311,6 +424,37 @@ func (q *LocalQueueWrapper) PendingWorkloads(n int32) *LocalQueueWrapper {
 	return q
 }
 
+// FlavorLimit sets the limit of the LocalQueue for the resource in the flavor.
+func (q *LocalQueueWrapper) FlavorLimit(flavor string, rName corev1.ResourceName, max string) *LocalQueueWrapper {
+	limit := kueue.LocalQueueResourceLimit{
+		Name: rName,
+		Max:  resource.MustParse(max),
+	}
+	for i := range q.Spec.FlavorLimits {
+		if q.Spec.FlavorLimits[i].Name == kueue.ResourceFlavorReference(flavor) {
+			q.Spec.FlavorLimits[i].Resources = append(q.Spec.FlavorLimits[i].Resources, limit)
+			return q
+		}
+	}
+	q.Spec.FlavorLimits = append(q.Spec.FlavorLimits, kueue.LocalQueueFlavorLimit{
+		Name:      kueue.ResourceFlavorReference(flavor),
+		Resources: []kueue.LocalQueueResourceLimit{limit},
+	})
+	return q
+}
+
+// StopPolicy sets the stop policy of the LocalQueue.
+func (q *LocalQueueWrapper) StopPolicy(p kueue.StopPolicy) *LocalQueueWrapper {
+	q.Spec.StopPolicy = &p
+	return q
+}
+
+// Weight sets the weight of the LocalQueue.
+func (q *LocalQueueWrapper) Weight(w int32) *LocalQueueWrapper {
+	q.Spec.Weight = &w
+	return q
+}
+
 // ClusterQueueWrapper wraps a ClusterQueue.
 type ClusterQueueWrapper struct{ kueue.ClusterQueue }
 
@@ -341,6 +485,11 @@ func (c *ClusterQueueWrapper) Cohort(cohort string) *ClusterQueueWrapper {
 
 // ResourceGroup adds a ResourceGroup with flavors.
 func (c *ClusterQueueWrapper) ResourceGroup(flavors ...kueue.FlavorQuotas) *ClusterQueueWrapper {
+	c.Spec.ResourceGroups = append(c.Spec.ResourceGroups, makeResourceGroup(flavors...))
+	return c
+}
+
+func makeResourceGroup(flavors ...kueue.FlavorQuotas) kueue.ResourceGroup {
 	rg := kueue.ResourceGroup{
 		Flavors: flavors,
 	}
@@ -361,8 +510,7 @@ func (c *ClusterQueueWrapper) ResourceGroup(flavors ...kueue.FlavorQuotas) *Clus
 		}
 		rg.CoveredResources = resources
 	}
-	c.Spec.ResourceGroups = append(c.Spec.ResourceGroups, rg)
-	return c
+	return rg
 }
 
 // QueueingStrategy sets the queueing strategy in this ClusterQueue.
@@ -383,6 +531,60 @@ func (c *ClusterQueueWrapper) Preemption(p kueue.ClusterQueuePreemption) *Cluste
 	return c
 }
 
+// FlavorFungibility sets the flavor fungibility policies for the ClusterQueue.
+func (c *ClusterQueueWrapper) FlavorFungibility(p kueue.FlavorFungibility) *ClusterQueueWrapper {
+	c.Spec.FlavorFungibility = &p
+	return c
+}
+
+// AdmissionChecks replaces the admission checks required by the ClusterQueue.
+func (c *ClusterQueueWrapper) AdmissionChecks(checks ...string) *ClusterQueueWrapper {
+	c.Spec.AdmissionChecks = checks
+	return c
+}
+
+// FairWeight sets the weight of the ClusterQueue for fair sharing.
+func (c *ClusterQueueWrapper) FairWeight(w string) *ClusterQueueWrapper {
+	weight := resource.MustParse(w)
+	c.Spec.FairSharing = &kueue.FairSharing{Weight: &weight}
+	return c
+}
+
+// StopPolicy sets the stop policy of the ClusterQueue.
+func (c *ClusterQueueWrapper) StopPolicy(p kueue.StopPolicy) *ClusterQueueWrapper {
+	c.Spec.StopPolicy = &p
+	return c
+}
+
+// CohortWrapper wraps a Cohort.
+type CohortWrapper struct{ kueue.Cohort }
+
+// MakeCohort creates a wrapper for a Cohort.
+func MakeCohort(name string) *CohortWrapper {
+	return &CohortWrapper{kueue.Cohort{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}}
+}
+
+// Obj returns the inner Cohort.
+func (c *CohortWrapper) Obj() *kueue.Cohort {
+	return &c.Cohort
+}
+
+// Parent sets the parent of the Cohort.
+func (c *CohortWrapper) Parent(parent string) *CohortWrapper {
+	c.Spec.Parent = parent
+	return c
+}
+
+// ResourceGroup adds a ResourceGroup with flavors.
+func (c *CohortWrapper) ResourceGroup(flavors ...kueue.FlavorQuotas) *CohortWrapper {
+	c.Spec.ResourceGroups = append(c.Spec.ResourceGroups, makeResourceGroup(flavors...))
+	return c
+}
+
 // FlavorQuotasWrapper wraps a FlavorQuotas object.
 type FlavorQuotasWrapper struct{ kueue.FlavorQuotas }
 
@@ -405,11 +607,14 @@ func (f *FlavorQuotasWrapper) Resource(name corev1.ResourceName, qs ...string) *
 	if len(qs) > 0 {
 		rq.NominalQuota = resource.MustParse(qs[0])
 	}
-	if len(qs) > 1 {
+	if len(qs) > 1 && len(qs[1]) > 0 {
 		rq.BorrowingLimit = pointer.Quantity(resource.MustParse(qs[1]))
 	}
-	if len(qs) > 2 {
-		panic("Must have at most 2 quantities for nominalquota and borrowingLimit")
+	if len(qs) > 2 && len(qs[2]) > 0 {
+		rq.LendingLimit = pointer.Quantity(resource.MustParse(qs[2]))
+	}
+	if len(qs) > 3 {
+		panic("Must have at most 3 quantities for nominalquota, borrowingLimit and lendingLimit")
 	}
 	f.Resources = append(f.Resources, rq)
 	return f
@@ -447,6 +652,40 @@ func (rf *ResourceFlavorWrapper) Taint(t corev1.Taint) *ResourceFlavorWrapper {
 	return rf
 }
 
+// Toleration adds a toleration to the ResourceFlavor.
+func (rf *ResourceFlavorWrapper) Toleration(t corev1.Toleration) *ResourceFlavorWrapper {
+	rf.Spec.Tolerations = append(rf.Spec.Tolerations, t)
+	return rf
+}
+
+// TopologyName sets the Topology of the ResourceFlavor.
+func (rf *ResourceFlavorWrapper) TopologyName(name string) *ResourceFlavorWrapper {
+	rf.Spec.TopologyName = &name
+	return rf
+}
+
+// TopologyWrapper wraps a Topology.
+type TopologyWrapper struct{ kueue.Topology }
+
+// MakeTopology creates a wrapper for a Topology with the levels given by
+// node labels.
+func MakeTopology(name string, levels ...string) *TopologyWrapper {
+	t := &TopologyWrapper{kueue.Topology{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}}
+	for _, l := range levels {
+		t.Spec.Levels = append(t.Spec.Levels, kueue.TopologyLevel{NodeLabel: l})
+	}
+	return t
+}
+
+// Obj returns the inner Topology.
+func (t *TopologyWrapper) Obj() *kueue.Topology {
+	return &t.Topology
+}
+
 // RuntimeClassWrapper wraps a RuntimeClass.
 type RuntimeClassWrapper struct{ nodev1.RuntimeClass }
 
@@ -524,3 +763,47 @@ func (lr *LimitRangeWrapper) WithValue(member string, t corev1.ResourceName, q s
 func (lr *LimitRangeWrapper) Obj() *corev1.LimitRange {
 	return &lr.LimitRange
 }
+
+// AdmissionCheckWrapper wraps an AdmissionCheck.
+type AdmissionCheckWrapper struct{ kueue.AdmissionCheck }
+
+// MakeAdmissionCheck creates a wrapper for an AdmissionCheck.
+func MakeAdmissionCheck(name string) *AdmissionCheckWrapper {
+	return &AdmissionCheckWrapper{kueue.AdmissionCheck{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}}
+}
+
+// ControllerName sets the name of the controller performing the check.
+func (ac *AdmissionCheckWrapper) ControllerName(c string) *AdmissionCheckWrapper {
+	ac.Spec.ControllerName = c
+	return ac
+}
+
+// Parameters sets the reference to the parameters of the check.
+func (ac *AdmissionCheckWrapper) Parameters(apigroup, kind, name string) *AdmissionCheckWrapper {
+	ac.Spec.Parameters = &kueue.AdmissionCheckParametersReference{
+		APIGroup: apigroup,
+		Kind:     kind,
+		Name:     name,
+	}
+	return ac
+}
+
+// Active sets the Active condition of the AdmissionCheck.
+func (ac *AdmissionCheckWrapper) Active(status metav1.ConditionStatus) *AdmissionCheckWrapper {
+	apimeta.SetStatusCondition(&ac.Status.Conditions, metav1.Condition{
+		Type:    kueue.AdmissionCheckActive,
+		Status:  status,
+		Reason:  "ByTest",
+		Message: "by test",
+	})
+	return ac
+}
+
+// Obj returns the inner AdmissionCheck.
+func (ac *AdmissionCheckWrapper) Obj() *kueue.AdmissionCheck {
+	return &ac.AdmissionCheck
+}
@@ -105,6 +105,24 @@ func (j *JobWrapper) PriorityClass(pc string) *JobWrapper {
 	return j
 }
 
+// WorkloadPriorityClass updates the WorkloadPriorityClass label of the job.
+func (j *JobWrapper) WorkloadPriorityClass(wpc string) *JobWrapper {
+	if j.Labels == nil {
+		j.Labels = make(map[string]string)
+	}
+	j.Labels[constants.WorkloadPriorityClassLabel] = wpc
+	return j
+}
+
+// Label sets a label of the job.
+func (j *JobWrapper) Label(key, value string) *JobWrapper {
+	if j.Labels == nil {
+		j.Labels = make(map[string]string)
+	}
+	j.Labels[key] = value
+	return j
+}
+
 // Queue updates the queue name of the job
 func (j *JobWrapper) Queue(queue string) *JobWrapper {
 	if j.Labels == nil {
@@ -143,6 +161,24 @@ func (j *JobWrapper) NodeSelector(k, v string) *JobWrapper {
 	return j
 }
 
+// PodAnnotation adds an annotation to the pod template of the job.
+func (j *JobWrapper) PodAnnotation(k, v string) *JobWrapper {
+	if j.Spec.Template.Annotations == nil {
+		j.Spec.Template.Annotations = make(map[string]string)
+	}
+	j.Spec.Template.Annotations[k] = v
+	return j
+}
+
+// PodLabel adds a label to the pod template of the job.
+func (j *JobWrapper) PodLabel(k, v string) *JobWrapper {
+	if j.Spec.Template.Labels == nil {
+		j.Spec.Template.Labels = make(map[string]string)
+	}
+	j.Spec.Template.Labels[k] = v
+	return j
+}
+
 // Request adds a resource request to the default container.
 func (j *JobWrapper) Request(r corev1.ResourceName, v string) *JobWrapper {
 	j.Spec.Template.Spec.Containers[0].Resources.Requests[r] = resource.MustParse(v)
@@ -90,6 +90,24 @@ func (j *JobSetWrapper) Queue(queue string) *JobSetWrapper {
 	return j
 }
 
+// Label sets a label of the JobSet
+func (j *JobSetWrapper) Label(key, value string) *JobSetWrapper {
+	if j.Labels == nil {
+		j.Labels = make(map[string]string)
+	}
+	j.Labels[key] = value
+	return j
+}
+
+// Annotation sets an annotation of the JobSet
+func (j *JobSetWrapper) Annotation(key, value string) *JobSetWrapper {
+	if j.Annotations == nil {
+		j.Annotations = make(map[string]string)
+	}
+	j.Annotations[key] = value
+	return j
+}
+
 // Request adds a resource request to the first container of the target replicatedJob.
 func (j *JobSetWrapper) Request(replicatedJobName string, r corev1.ResourceName, v string) *JobSetWrapper {
 	for i, replicatedJob := range j.Spec.ReplicatedJobs {
@@ -106,6 +106,21 @@ func (j *MPIJobWrapper) Queue(queue string) *MPIJobWrapper {
 	return j
 }
 
+// Label sets a label of the job.
+func (j *MPIJobWrapper) Label(key, value string) *MPIJobWrapper {
+	if j.Labels == nil {
+		j.Labels = make(map[string]string)
+	}
+	j.Labels[key] = value
+	return j
+}
+
+// Annotation sets an annotation of the job.
+func (j *MPIJobWrapper) Annotation(key, value string) *MPIJobWrapper {
+	j.Annotations[key] = value
+	return j
+}
+
 // Request adds a resource request to the default container.
 func (j *MPIJobWrapper) Request(replicaType kubeflow.MPIReplicaType, r corev1.ResourceName, v string) *MPIJobWrapper {
 	j.Spec.MPIReplicaSpecs[replicaType].Template.Spec.Containers[0].Resources.Requests[r] = resource.MustParse(v)
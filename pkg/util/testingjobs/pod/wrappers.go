@@ -0,0 +1,153 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pod
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+)
+
+// PodWrapper wraps a Pod.
+type PodWrapper struct {
+	corev1.Pod
+}
+
+// MakePod creates a wrapper for a pod with a single container.
+func MakePod(name, ns string) *PodWrapper {
+	return &PodWrapper{corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   ns,
+			Annotations: make(map[string]string, 1),
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers: []corev1.Container{
+				{
+					Name:      "c",
+					Image:     "pause",
+					Command:   []string{},
+					Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{}},
+				},
+			},
+			SchedulingGates: make([]corev1.PodSchedulingGate, 0),
+		},
+	}}
+}
+
+// Obj returns the inner Pod.
+func (p *PodWrapper) Obj() *corev1.Pod {
+	return &p.Pod
+}
+
+// Clone returns deep copy of the Pod.
+func (p *PodWrapper) Clone() *PodWrapper {
+	return &PodWrapper{Pod: *p.DeepCopy()}
+}
+
+// Queue updates the queue name of the Pod.
+func (p *PodWrapper) Queue(q string) *PodWrapper {
+	return p.Label(constants.QueueLabel, q)
+}
+
+// Label sets the label of the Pod.
+func (p *PodWrapper) Label(k, v string) *PodWrapper {
+	if p.Labels == nil {
+		p.Labels = make(map[string]string)
+	}
+	p.Labels[k] = v
+	return p
+}
+
+// KueueSchedulingGate adds the kueue scheduling gate to the Pod.
+func (p *PodWrapper) KueueSchedulingGate() *PodWrapper {
+	return p.Gate("kueue.x-k8s.io/admission")
+}
+
+// Gate adds a scheduling gate to the Pod.
+func (p *PodWrapper) Gate(gateName string) *PodWrapper {
+	p.Spec.SchedulingGates = append(p.Spec.SchedulingGates, corev1.PodSchedulingGate{Name: gateName})
+	return p
+}
+
+// OwnerReference adds a controller owner reference to the Pod.
+func (p *PodWrapper) OwnerReference(ownerName string, ownerGVK schema.GroupVersionKind) *PodWrapper {
+	p.ObjectMeta.OwnerReferences = append(p.ObjectMeta.OwnerReferences,
+		metav1.OwnerReference{
+			APIVersion: ownerGVK.GroupVersion().String(),
+			Kind:       ownerGVK.Kind,
+			Name:       ownerName,
+			UID:        types.UID(ownerName),
+			Controller: pointer.Bool(true),
+		},
+	)
+	return p
+}
+
+// NodeSelector adds a node selector to the Pod.
+func (p *PodWrapper) NodeSelector(k, v string) *PodWrapper {
+	if p.Spec.NodeSelector == nil {
+		p.Spec.NodeSelector = make(map[string]string, 1)
+	}
+	p.Spec.NodeSelector[k] = v
+	return p
+}
+
+// Request adds a resource request to the default container.
+func (p *PodWrapper) Request(r corev1.ResourceName, v string) *PodWrapper {
+	p.Spec.Containers[0].Resources.Requests[r] = resource.MustParse(v)
+	return p
+}
+
+// Phase sets the phase of the Pod.
+func (p *PodWrapper) Phase(phase corev1.PodPhase) *PodWrapper {
+	p.Status.Phase = phase
+	return p
+}
+
+// StatusConditions adds a status condition to the Pod.
+func (p *PodWrapper) StatusConditions(conditions ...corev1.PodCondition) *PodWrapper {
+	p.Status.Conditions = append(p.Status.Conditions, conditions...)
+	return p
+}
+
+// Annotation sets the annotation of the Pod.
+func (p *PodWrapper) Annotation(k, v string) *PodWrapper {
+	if p.Annotations == nil {
+		p.Annotations = make(map[string]string)
+	}
+	p.Annotations[k] = v
+	return p
+}
+
+// Group sets the pod group name label and total count annotation of the Pod.
+func (p *PodWrapper) Group(groupName string, totalCount string) *PodWrapper {
+	return p.Label("kueue.x-k8s.io/pod-group-name", groupName).
+		Annotation("kueue.x-k8s.io/pod-group-total-count", totalCount)
+}
+
+// UID updates the uid of the Pod.
+func (p *PodWrapper) UID(uid string) *PodWrapper {
+	p.ObjectMeta.UID = types.UID(uid)
+	return p
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testing
+
+import (
+	kftraining "github.com/kubeflow/training-operator/pkg/apis/kubeflow.org/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/util/pointer"
+)
+
+// PyTorchJobWrapper wraps a PyTorchJob.
+type PyTorchJobWrapper struct{ kftraining.PyTorchJob }
+
+// MakePyTorchJob creates a wrapper for a suspended job with a single container
+// and one replica of the types Master, Worker.
+func MakePyTorchJob(name, ns string) *PyTorchJobWrapper {
+	return &PyTorchJobWrapper{kftraining.PyTorchJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   ns,
+			Annotations: make(map[string]string, 1),
+		},
+		Spec: kftraining.PyTorchJobSpec{
+			RunPolicy: kftraining.RunPolicy{
+				Suspend: pointer.Bool(true),
+			},
+			PyTorchReplicaSpecs: map[kftraining.ReplicaType]*kftraining.ReplicaSpec{
+				kftraining.PyTorchJobReplicaTypeMaster: replicaSpec(),
+				kftraining.PyTorchJobReplicaTypeWorker: replicaSpec(),
+			},
+		},
+	}}
+}
+
+func replicaSpec() *kftraining.ReplicaSpec {
+	return &kftraining.ReplicaSpec{
+		Replicas: pointer.Int32(1),
+		Template: corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				RestartPolicy: corev1.RestartPolicyNever,
+				Containers: []corev1.Container{
+					{
+						Name:      "c",
+						Image:     "pause",
+						Command:   []string{},
+						Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{}},
+					},
+				},
+				NodeSelector: map[string]string{},
+			},
+		},
+	}
+}
+
+// PriorityClass updates job priorityclass.
+func (j *PyTorchJobWrapper) PriorityClass(pc string) *PyTorchJobWrapper {
+	if j.Spec.RunPolicy.SchedulingPolicy == nil {
+		j.Spec.RunPolicy.SchedulingPolicy = &kftraining.SchedulingPolicy{}
+	}
+	j.Spec.RunPolicy.SchedulingPolicy.PriorityClass = pc
+	return j
+}
+
+// Obj returns the inner Job.
+func (j *PyTorchJobWrapper) Obj() *kftraining.PyTorchJob {
+	return &j.PyTorchJob
+}
+
+// Clone returns deep copy of the job.
+func (j *PyTorchJobWrapper) Clone() *PyTorchJobWrapper {
+	return &PyTorchJobWrapper{PyTorchJob: *j.DeepCopy()}
+}
+
+// Queue updates the queue name of the job.
+func (j *PyTorchJobWrapper) Queue(queue string) *PyTorchJobWrapper {
+	if j.Labels == nil {
+		j.Labels = make(map[string]string)
+	}
+	j.Labels[constants.QueueLabel] = queue
+	return j
+}
+
+// Label sets a label of the job.
+func (j *PyTorchJobWrapper) Label(key, value string) *PyTorchJobWrapper {
+	if j.Labels == nil {
+		j.Labels = make(map[string]string)
+	}
+	j.Labels[key] = value
+	return j
+}
+
+// Annotation sets an annotation of the job.
+func (j *PyTorchJobWrapper) Annotation(key, value string) *PyTorchJobWrapper {
+	j.Annotations[key] = value
+	return j
+}
+
+// Request adds a resource request to the default container.
+func (j *PyTorchJobWrapper) Request(replicaType kftraining.ReplicaType, r corev1.ResourceName, v string) *PyTorchJobWrapper {
+	j.Spec.PyTorchReplicaSpecs[replicaType].Template.Spec.Containers[0].Resources.Requests[r] = resource.MustParse(v)
+	return j
+}
+
+// NodeSelector adds a node selector to the pods of the replica type.
+func (j *PyTorchJobWrapper) NodeSelector(replicaType kftraining.ReplicaType, k, v string) *PyTorchJobWrapper {
+	j.Spec.PyTorchReplicaSpecs[replicaType].Template.Spec.NodeSelector[k] = v
+	return j
+}
+
+// Parallelism updates the number of workers of the job.
+func (j *PyTorchJobWrapper) Parallelism(p int32) *PyTorchJobWrapper {
+	j.Spec.PyTorchReplicaSpecs[kftraining.PyTorchJobReplicaTypeWorker].Replicas = pointer.Int32(p)
+	return j
+}
+
+// Suspend updates the suspend status of the job.
+func (j *PyTorchJobWrapper) Suspend(s bool) *PyTorchJobWrapper {
+	j.Spec.RunPolicy.Suspend = &s
+	return j
+}
+
+// UID updates the uid of the job.
+func (j *PyTorchJobWrapper) UID(uid string) *PyTorchJobWrapper {
+	j.ObjectMeta.UID = types.UID(uid)
+	return j
+}
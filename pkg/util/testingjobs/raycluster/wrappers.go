@@ -0,0 +1,176 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package raycluster
+
+import (
+	rayjobapi "github.com/ray-project/kuberay/ray-operator/apis/ray/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/util/pointer"
+)
+
+// ClusterWrapper wraps a RayCluster.
+type ClusterWrapper struct{ rayjobapi.RayCluster }
+
+// MakeCluster creates a wrapper for a suspended RayCluster
+func MakeCluster(name, ns string) *ClusterWrapper {
+	return &ClusterWrapper{rayjobapi.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   ns,
+			Annotations: make(map[string]string, 1),
+		},
+		Spec: rayjobapi.RayClusterSpec{
+			HeadGroupSpec: rayjobapi.HeadGroupSpec{
+				RayStartParams: map[string]string{"p1": "v1"},
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name: "head-container",
+							},
+						},
+					},
+				},
+			},
+			WorkerGroupSpecs: []rayjobapi.WorkerGroupSpec{
+				{
+					GroupName:      "workers-group-0",
+					Replicas:       pointer.Int32(1),
+					MinReplicas:    pointer.Int32(0),
+					MaxReplicas:    pointer.Int32(10),
+					RayStartParams: map[string]string{"p1": "v1"},
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name: "worker-container",
+								},
+							},
+						},
+					},
+				},
+			},
+			Suspend: pointer.Bool(true),
+		},
+	}}
+}
+
+// Obj returns the inner RayCluster.
+func (c *ClusterWrapper) Obj() *rayjobapi.RayCluster {
+	return &c.RayCluster
+}
+
+// Suspend updates the suspend status of the cluster
+func (c *ClusterWrapper) Suspend(s bool) *ClusterWrapper {
+	c.Spec.Suspend = pointer.Bool(s)
+	return c
+}
+
+// Queue updates the queue name of the cluster
+func (c *ClusterWrapper) Queue(queue string) *ClusterWrapper {
+	if c.Labels == nil {
+		c.Labels = make(map[string]string)
+	}
+	c.Labels[constants.QueueLabel] = queue
+	return c
+}
+
+// Label sets a label of the cluster
+func (c *ClusterWrapper) Label(key, value string) *ClusterWrapper {
+	if c.Labels == nil {
+		c.Labels = make(map[string]string)
+	}
+	c.Labels[key] = value
+	return c
+}
+
+// Annotation sets an annotation of the cluster
+func (c *ClusterWrapper) Annotation(key, value string) *ClusterWrapper {
+	c.Annotations[key] = value
+	return c
+}
+
+// OwnerReference sets the controller owner of the cluster
+func (c *ClusterWrapper) OwnerReference(ownerName string, ownerGVK schema.GroupVersionKind) *ClusterWrapper {
+	c.OwnerReferences = append(c.OwnerReferences, metav1.OwnerReference{
+		APIVersion: ownerGVK.GroupVersion().String(),
+		Kind:       ownerGVK.Kind,
+		Name:       ownerName,
+		UID:        "test-uid",
+		Controller: pointer.Bool(true),
+	})
+	return c
+}
+
+// UID updates the uid of the cluster.
+func (c *ClusterWrapper) UID(uid string) *ClusterWrapper {
+	c.ObjectMeta.UID = types.UID(uid)
+	return c
+}
+
+// State sets the state in the status of the cluster
+func (c *ClusterWrapper) State(state rayjobapi.ClusterState) *ClusterWrapper {
+	c.Status.State = state
+	return c
+}
+
+// DeletionTimestamp sets the deletion timestamp of the cluster
+func (c *ClusterWrapper) DeletionTimestamp(t metav1.Time) *ClusterWrapper {
+	c.ObjectMeta.DeletionTimestamp = &t
+	return c
+}
+
+func (c *ClusterWrapper) RequestWorkerGroup(name corev1.ResourceName, quantity string) *ClusterWrapper {
+	ct := &c.Spec.WorkerGroupSpecs[0].Template.Spec.Containers[0]
+	if ct.Resources.Requests == nil {
+		ct.Resources.Requests = corev1.ResourceList{name: resource.MustParse(quantity)}
+	} else {
+		ct.Resources.Requests[name] = resource.MustParse(quantity)
+	}
+	return c
+}
+
+func (c *ClusterWrapper) RequestHead(name corev1.ResourceName, quantity string) *ClusterWrapper {
+	ct := &c.Spec.HeadGroupSpec.Template.Spec.Containers[0]
+	if ct.Resources.Requests == nil {
+		ct.Resources.Requests = corev1.ResourceList{name: resource.MustParse(quantity)}
+	} else {
+		ct.Resources.Requests[name] = resource.MustParse(quantity)
+	}
+	return c
+}
+
+func (c *ClusterWrapper) WithEnableAutoscaling(value *bool) *ClusterWrapper {
+	c.Spec.EnableInTreeAutoscaling = value
+	return c
+}
+
+func (c *ClusterWrapper) WithWorkerGroups(workers ...rayjobapi.WorkerGroupSpec) *ClusterWrapper {
+	c.Spec.WorkerGroupSpecs = workers
+	return c
+}
+
+func (c *ClusterWrapper) WithHeadGroupSpec(value rayjobapi.HeadGroupSpec) *ClusterWrapper {
+	c.Spec.HeadGroupSpec = value
+	return c
+}
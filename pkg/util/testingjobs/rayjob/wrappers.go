@@ -87,6 +87,21 @@ func (j *JobWrapper) Suspend(s bool) *JobWrapper {
 	return j
 }
 
+// Label sets a label of the job
+func (j *JobWrapper) Label(key, value string) *JobWrapper {
+	if j.Labels == nil {
+		j.Labels = make(map[string]string)
+	}
+	j.Labels[key] = value
+	return j
+}
+
+// Annotation sets an annotation of the job
+func (j *JobWrapper) Annotation(key, value string) *JobWrapper {
+	j.Annotations[key] = value
+	return j
+}
+
 // Queue updates the queue name of the job
 func (j *JobWrapper) Queue(queue string) *JobWrapper {
 	if j.Labels == nil {
@@ -0,0 +1,143 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package visibility
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1alpha1"
+)
+
+// WithAuthorization returns a handler that authenticates the bearer token of
+// the requests with a TokenReview, and checks with a SubjectAccessReview that
+// the user can access the subresource of the queue, before passing the
+// requests to h. The subresources of a LocalQueue are authorized in its
+// namespace.
+func WithAuthorization(h http.Handler, c client.Client) http.Handler {
+	return &authorizationHandler{handler: h, client: c}
+}
+
+//+kubebuilder:rbac:groups=authentication.k8s.io,resources=tokenreviews,verbs=create
+//+kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
+type authorizationHandler struct {
+	handler http.Handler
+	client  client.Client
+}
+
+func (a *authorizationHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	user, err := a.authenticate(req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if attrs := resourceAttributes(pathParts(req)); attrs != nil {
+		if err := a.authorize(req.Context(), user, attrs); err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+	a.handler.ServeHTTP(w, req)
+}
+
+func (a *authorizationHandler) authenticate(req *http.Request) (*authenticationv1.UserInfo, error) {
+	token, found := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if !found || token == "" {
+		return nil, apierrors.NewUnauthorized("missing bearer token")
+	}
+	review := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}
+	if err := a.client.Create(req.Context(), review); err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("reviewing the token: %w", err))
+	}
+	if !review.Status.Authenticated {
+		return nil, apierrors.NewUnauthorized("invalid bearer token")
+	}
+	return &review.Status.User, nil
+}
+
+func (a *authorizationHandler) authorize(ctx context.Context, user *authenticationv1.UserInfo, attrs *authorizationv1.ResourceAttributes) error {
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+	review := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			ResourceAttributes: attrs,
+			User:               user.Username,
+			Groups:             user.Groups,
+			UID:                user.UID,
+			Extra:              extra,
+		},
+	}
+	if err := a.client.Create(ctx, review); err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("reviewing the access: %w", err))
+	}
+	if !review.Status.Allowed {
+		gr := schema.GroupResource{Group: attrs.Group, Resource: attrs.Resource + "/" + attrs.Subresource}
+		return apierrors.NewForbidden(gr, attrs.Name, errors.New(review.Status.Reason))
+	}
+	return nil
+}
+
+// resourceAttributes returns the attributes to authorize the access to the
+// path, or nil if the path is not served.
+func resourceAttributes(parts []string) *authorizationv1.ResourceAttributes {
+	switch {
+	case len(parts) == 3 && parts[0] == "clusterqueues" && parts[2] == pendingWorkloadsResource:
+		return &authorizationv1.ResourceAttributes{
+			Verb:        "get",
+			Group:       visibility.GroupVersion.Group,
+			Version:     visibility.GroupVersion.Version,
+			Resource:    "clusterqueues",
+			Subresource: pendingWorkloadsResource,
+			Name:        parts[1],
+		}
+	case len(parts) == 5 && parts[0] == "namespaces" && parts[2] == "localqueues" && parts[4] == pendingWorkloadsResource:
+		return &authorizationv1.ResourceAttributes{
+			Namespace:   parts[1],
+			Verb:        "get",
+			Group:       visibility.GroupVersion.Group,
+			Version:     visibility.GroupVersion.Version,
+			Resource:    "localqueues",
+			Subresource: pendingWorkloadsResource,
+			Name:        parts[3],
+		}
+	case len(parts) == 5 && parts[0] == "namespaces" && parts[2] == "localqueues" && parts[4] == dryRunResource:
+		return &authorizationv1.ResourceAttributes{
+			Namespace:   parts[1],
+			Verb:        "create",
+			Group:       visibility.GroupVersion.Group,
+			Version:     visibility.GroupVersion.Version,
+			Resource:    "localqueues",
+			Subresource: dryRunResource,
+			Name:        parts[3],
+		}
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package visibility
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestWithAuthorization(t *testing.T) {
+	cases := map[string]struct {
+		method     string
+		path       string
+		token      string
+		wantStatus int
+		wantAttrs  *authorizationv1.ResourceAttributes
+	}{
+		"missing token": {
+			path:       "clusterqueues/cq/pendingworkloads",
+			wantStatus: http.StatusUnauthorized,
+		},
+		"invalid token": {
+			path:       "clusterqueues/cq/pendingworkloads",
+			token:      "invalid",
+			wantStatus: http.StatusUnauthorized,
+		},
+		"cluster queue allowed": {
+			path:       "clusterqueues/cq/pendingworkloads",
+			token:      "admin",
+			wantStatus: http.StatusOK,
+			wantAttrs: &authorizationv1.ResourceAttributes{
+				Verb:        "get",
+				Group:       "visibility.kueue.x-k8s.io",
+				Version:     "v1alpha1",
+				Resource:    "clusterqueues",
+				Subresource: "pendingworkloads",
+				Name:        "cq",
+			},
+		},
+		"cluster queue forbidden": {
+			path:       "clusterqueues/cq/pendingworkloads",
+			token:      "user",
+			wantStatus: http.StatusForbidden,
+			wantAttrs: &authorizationv1.ResourceAttributes{
+				Verb:        "get",
+				Group:       "visibility.kueue.x-k8s.io",
+				Version:     "v1alpha1",
+				Resource:    "clusterqueues",
+				Subresource: "pendingworkloads",
+				Name:        "cq",
+			},
+		},
+		"local queue in the namespace of the user": {
+			path:       "namespaces/user-ns/localqueues/lq/pendingworkloads",
+			token:      "user",
+			wantStatus: http.StatusOK,
+			wantAttrs: &authorizationv1.ResourceAttributes{
+				Namespace:   "user-ns",
+				Verb:        "get",
+				Group:       "visibility.kueue.x-k8s.io",
+				Version:     "v1alpha1",
+				Resource:    "localqueues",
+				Subresource: "pendingworkloads",
+				Name:        "lq",
+			},
+		},
+		"local queue in another namespace": {
+			path:       "namespaces/other-ns/localqueues/lq/pendingworkloads",
+			token:      "user",
+			wantStatus: http.StatusForbidden,
+			wantAttrs: &authorizationv1.ResourceAttributes{
+				Namespace:   "other-ns",
+				Verb:        "get",
+				Group:       "visibility.kueue.x-k8s.io",
+				Version:     "v1alpha1",
+				Resource:    "localqueues",
+				Subresource: "pendingworkloads",
+				Name:        "lq",
+			},
+		},
+		"dry run in the namespace of the user": {
+			method:     http.MethodPost,
+			path:       "namespaces/user-ns/localqueues/lq/dryrun",
+			token:      "user",
+			wantStatus: http.StatusOK,
+			wantAttrs: &authorizationv1.ResourceAttributes{
+				Namespace:   "user-ns",
+				Verb:        "create",
+				Group:       "visibility.kueue.x-k8s.io",
+				Version:     "v1alpha1",
+				Resource:    "localqueues",
+				Subresource: "dryrun",
+				Name:        "lq",
+			},
+		},
+		"dry run in another namespace": {
+			method:     http.MethodPost,
+			path:       "namespaces/other-ns/localqueues/lq/dryrun",
+			token:      "user",
+			wantStatus: http.StatusForbidden,
+			wantAttrs: &authorizationv1.ResourceAttributes{
+				Namespace:   "other-ns",
+				Verb:        "create",
+				Group:       "visibility.kueue.x-k8s.io",
+				Version:     "v1alpha1",
+				Resource:    "localqueues",
+				Subresource: "dryrun",
+				Name:        "lq",
+			},
+		},
+		"dry run without token": {
+			method:     http.MethodPost,
+			path:       "namespaces/user-ns/localqueues/lq/dryrun",
+			wantStatus: http.StatusUnauthorized,
+		},
+		"unknown path is passed once authenticated": {
+			path:       "clusterqueues/cq",
+			token:      "user",
+			wantStatus: http.StatusOK,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var gotAttrs *authorizationv1.ResourceAttributes
+			c := utiltesting.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+					switch review := obj.(type) {
+					case *authenticationv1.TokenReview:
+						if review.Spec.Token == "admin" || review.Spec.Token == "user" {
+							review.Status.Authenticated = true
+							review.Status.User = authenticationv1.UserInfo{Username: review.Spec.Token}
+						}
+					case *authorizationv1.SubjectAccessReview:
+						gotAttrs = review.Spec.ResourceAttributes
+						review.Status.Allowed = review.Spec.User == "admin" || gotAttrs.Namespace == "user-ns"
+					}
+					return nil
+				},
+			}).Build()
+			h := WithAuthorization(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}), c)
+			method := http.MethodGet
+			if tc.method != "" {
+				method = tc.method
+			}
+			req := httptest.NewRequest(method, PathPrefix+tc.path, nil)
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			if rec.Code != tc.wantStatus {
+				t.Errorf("Unexpected status code %d, want %d, body: %s", rec.Code, tc.wantStatus, rec.Body.String())
+			}
+			if diff := cmp.Diff(tc.wantAttrs, gotAttrs); diff != "" {
+				t.Errorf("Unexpected authorized attributes (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
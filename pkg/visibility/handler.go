@@ -0,0 +1,296 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package visibility
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1alpha1"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/queue"
+	utilpriority "sigs.k8s.io/kueue/pkg/util/priority"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const (
+	// PathPrefix is the path under which the visibility API is served.
+	PathPrefix = "/apis/visibility.kueue.x-k8s.io/v1alpha1/"
+
+	pendingWorkloadsResource = "pendingworkloads"
+	dryRunResource           = "dryrun"
+
+	defaultDryRunName = "dry-run"
+	// maxDryRunBodyBytes is the maximum size of a dry-run request body, the
+	// same as the limit of the kube-apiserver.
+	maxDryRunBodyBytes = 3 * 1024 * 1024
+
+	defaultLimit = 1000
+)
+
+// NewHandler returns a handler serving the pending workloads of the queues:
+//
+//	GET <PathPrefix>clusterqueues/<name>/pendingworkloads
+//	GET <PathPrefix>namespaces/<namespace>/localqueues/<name>/pendingworkloads
+//
+// The workloads are listed in the order in which they are evaluated for
+// admission. The `offset` and `limit` query parameters select a page.
+//
+// When dryRunner is not nil, it also evaluates the admission of a Workload or
+// of a job of a registered integration, submitted to a LocalQueue:
+//
+//	POST <PathPrefix>namespaces/<namespace>/localqueues/<name>/dryrun
+func NewHandler(queues *queue.Manager, c client.Client, dryRunner DryRunner) http.Handler {
+	return &handler{queues: queues, client: c, dryRunner: dryRunner}
+}
+
+// DryRunner evaluates the admission of a workload without admitting it.
+type DryRunner interface {
+	DryRun(ctx context.Context, wl *kueue.Workload) (*visibility.DryRunResult, error)
+}
+
+type handler struct {
+	queues    *queue.Manager
+	client    client.Client
+	dryRunner DryRunner
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	parts := pathParts(req)
+	if len(parts) == 5 && parts[0] == "namespaces" && parts[2] == "localqueues" && parts[4] == dryRunResource {
+		h.serveDryRun(w, req, parts[1], parts[3])
+		return
+	}
+	if req.Method != http.MethodGet {
+		writeError(w, apierrors.NewMethodNotSupported(visibility.GroupVersion.WithResource(pendingWorkloadsResource).GroupResource(), req.Method))
+		return
+	}
+	offset, limit, err := pageParams(req)
+	if err != nil {
+		writeError(w, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	var summary *visibility.PendingWorkloadsSummary
+	switch {
+	case len(parts) == 3 && parts[0] == "clusterqueues" && parts[2] == pendingWorkloadsResource:
+		summary, err = h.clusterQueueSummary(parts[1], offset, limit)
+	case len(parts) == 5 && parts[0] == "namespaces" && parts[2] == "localqueues" && parts[4] == pendingWorkloadsResource:
+		summary, err = h.localQueueSummary(parts[1], parts[3], offset, limit)
+	default:
+		err = apierrors.NewNotFound(schema.GroupResource{Group: visibility.GroupVersion.Group}, req.URL.Path)
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeObject(w, summary)
+}
+
+func (h *handler) serveDryRun(w http.ResponseWriter, req *http.Request, namespace, lqName string) {
+	if h.dryRunner == nil {
+		writeError(w, apierrors.NewNotFound(schema.GroupResource{Group: visibility.GroupVersion.Group}, req.URL.Path))
+		return
+	}
+	if req.Method != http.MethodPost {
+		writeError(w, apierrors.NewMethodNotSupported(visibility.GroupVersion.WithResource(dryRunResource).GroupResource(), req.Method))
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxDryRunBodyBytes))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			writeError(w, apierrors.NewRequestEntityTooLargeError(fmt.Sprintf("limit is %d bytes", maxBytesErr.Limit)))
+			return
+		}
+		writeError(w, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	wl, err := h.workloadFromManifest(req.Context(), body, namespace)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if wl.Name == "" {
+		wl.Name = defaultDryRunName
+	}
+	wl.Namespace = namespace
+	wl.Spec.QueueName = lqName
+	result, err := h.dryRunner.DryRun(req.Context(), wl)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeObject(w, result)
+}
+
+// workloadFromManifest decodes a Workload, or builds the Workload that kueue
+// would create for a job of a registered integration.
+func (h *handler) workloadFromManifest(ctx context.Context, body []byte, namespace string) (*kueue.Workload, error) {
+	var typeMeta metav1.TypeMeta
+	if err := json.Unmarshal(body, &typeMeta); err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	gvk := typeMeta.GroupVersionKind()
+	if gvk == kueue.GroupVersion.WithKind("Workload") {
+		wl := &kueue.Workload{}
+		if err := json.Unmarshal(body, wl); err != nil {
+			return nil, apierrors.NewBadRequest(err.Error())
+		}
+		return wl, nil
+	}
+	job, found := jobframework.NewJobForGVK(gvk)
+	if !found {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("unsupported kind %q", gvk.String()))
+	}
+	if err := json.Unmarshal(body, job.Object()); err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	job.Object().SetNamespace(namespace)
+	if job.Object().GetName() == "" {
+		job.Object().SetName(defaultDryRunName)
+	}
+	wl, err := jobframework.NewWorkload(ctx, h.client, job)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	return wl, nil
+}
+
+// pathParts returns the segments of the path of the request, below PathPrefix.
+func pathParts(req *http.Request) []string {
+	return strings.Split(strings.Trim(strings.TrimPrefix(req.URL.Path, PathPrefix), "/"), "/")
+}
+
+func pageParams(req *http.Request) (int, int, error) {
+	offset, limit := 0, defaultLimit
+	query := req.URL.Query()
+	if v := query.Get("offset"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return 0, 0, fmt.Errorf("invalid offset %q", v)
+		}
+		offset = n
+	}
+	if v := query.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			return 0, 0, fmt.Errorf("invalid limit %q", v)
+		}
+		limit = n
+	}
+	return offset, limit, nil
+}
+
+func (h *handler) clusterQueueSummary(cqName string, offset, limit int) (*visibility.PendingWorkloadsSummary, error) {
+	infos, found := h.queues.PendingWorkloadsInClusterQueue(cqName)
+	if !found {
+		return nil, apierrors.NewNotFound(kueue.Resource("clusterqueues"), cqName)
+	}
+	summary := newSummary(metav1.ObjectMeta{Name: cqName})
+	summary.Items = page(pendingWorkloads(infos, nil), offset, limit)
+	return summary, nil
+}
+
+func (h *handler) localQueueSummary(namespace, lqName string, offset, limit int) (*visibility.PendingWorkloadsSummary, error) {
+	lq := &kueue.LocalQueue{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: lqName}}
+	cqName, found := h.queues.ClusterQueueForLocalQueue(queue.Key(lq))
+	if !found {
+		return nil, apierrors.NewNotFound(kueue.Resource("localqueues"), lqName)
+	}
+	infos, _ := h.queues.PendingWorkloadsInClusterQueue(cqName)
+	summary := newSummary(metav1.ObjectMeta{Namespace: namespace, Name: lqName})
+	summary.Items = page(pendingWorkloads(infos, lq), offset, limit)
+	return summary, nil
+}
+
+func newSummary(meta metav1.ObjectMeta) *visibility.PendingWorkloadsSummary {
+	return &visibility.PendingWorkloadsSummary{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: visibility.GroupVersion.String(),
+			Kind:       "PendingWorkloadsSummary",
+		},
+		ObjectMeta: meta,
+		Items:      []visibility.PendingWorkload{},
+	}
+}
+
+// pendingWorkloads converts the ordered workloads of a ClusterQueue, keeping
+// only the ones in the LocalQueue, if not nil.
+func pendingWorkloads(infos []*workload.Info, lq *kueue.LocalQueue) []visibility.PendingWorkload {
+	var items []visibility.PendingWorkload
+	positionsInLocalQueue := make(map[string]int32)
+	for i, info := range infos {
+		qKey := workload.QueueKey(info.Obj)
+		positionInLocalQueue := positionsInLocalQueue[qKey]
+		positionsInLocalQueue[qKey]++
+		if lq != nil && qKey != queue.Key(lq) {
+			continue
+		}
+		items = append(items, visibility.PendingWorkload{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              info.Obj.Name,
+				Namespace:         info.Obj.Namespace,
+				CreationTimestamp: info.Obj.CreationTimestamp,
+			},
+			Priority:               utilpriority.Priority(info.Obj),
+			LocalQueueName:         info.Obj.Spec.QueueName,
+			PositionInClusterQueue: int32(i),
+			PositionInLocalQueue:   positionInLocalQueue,
+		})
+	}
+	return items
+}
+
+func page(items []visibility.PendingWorkload, offset, limit int) []visibility.PendingWorkload {
+	if offset >= len(items) {
+		return []visibility.PendingWorkload{}
+	}
+	end := offset + limit
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[offset:end]
+}
+
+func writeObject(w http.ResponseWriter, obj any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(obj)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	status := apierrors.NewInternalError(err).ErrStatus
+	if apiStatus, ok := err.(apierrors.APIStatus); ok {
+		status = apiStatus.Status()
+	}
+	status.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "Status"}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(int(status.Code))
+	_ = json.NewEncoder(w).Encode(status)
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package visibility
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1alpha1"
+	_ "sigs.k8s.io/kueue/pkg/controller/jobs/job"
+	"sigs.k8s.io/kueue/pkg/queue"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingjob "sigs.k8s.io/kueue/pkg/util/testingjobs/job"
+)
+
+func TestHandler(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+	queues := queue.NewManager(utiltesting.NewFakeClient(), nil)
+	if err := queues.AddClusterQueue(ctx, utiltesting.MakeClusterQueue("cq").Obj()); err != nil {
+		t.Fatalf("Adding ClusterQueue: %v", err)
+	}
+	for _, lq := range []string{"a", "b"} {
+		if err := queues.AddLocalQueue(ctx, utiltesting.MakeLocalQueue(lq, "ns").ClusterQueue("cq").Obj()); err != nil {
+			t.Fatalf("Adding LocalQueue: %v", err)
+		}
+	}
+	queues.AddOrUpdateWorkload(utiltesting.MakeWorkload("a1", "ns").Queue("a").Creation(now).Obj())
+	queues.AddOrUpdateWorkload(utiltesting.MakeWorkload("b1", "ns").Queue("b").Creation(now.Add(time.Second)).Obj())
+	queues.AddOrUpdateWorkload(utiltesting.MakeWorkload("a2", "ns").Queue("a").Creation(now.Add(2 * time.Second)).Obj())
+	queues.AddOrUpdateWorkload(utiltesting.MakeWorkload("b0", "ns").Queue("b").Priority(10).Creation(now.Add(3 * time.Second)).Obj())
+
+	pending := func(name, lq string, priority, inCQ, inLQ int32, creation time.Time) visibility.PendingWorkload {
+		return visibility.PendingWorkload{
+			ObjectMeta:             metav1.ObjectMeta{Name: name, Namespace: "ns", CreationTimestamp: metav1.NewTime(creation)},
+			Priority:               priority,
+			LocalQueueName:         lq,
+			PositionInClusterQueue: inCQ,
+			PositionInLocalQueue:   inLQ,
+		}
+	}
+	cases := map[string]struct {
+		path       string
+		wantStatus int
+		wantItems  []visibility.PendingWorkload
+	}{
+		"cluster queue": {
+			path:       "clusterqueues/cq/pendingworkloads",
+			wantStatus: http.StatusOK,
+			wantItems: []visibility.PendingWorkload{
+				pending("b0", "b", 10, 0, 0, now.Add(3*time.Second)),
+				pending("a1", "a", 0, 1, 0, now),
+				pending("b1", "b", 0, 2, 1, now.Add(time.Second)),
+				pending("a2", "a", 0, 3, 1, now.Add(2*time.Second)),
+			},
+		},
+		"cluster queue page": {
+			path:       "clusterqueues/cq/pendingworkloads?offset=1&limit=2",
+			wantStatus: http.StatusOK,
+			wantItems: []visibility.PendingWorkload{
+				pending("a1", "a", 0, 1, 0, now),
+				pending("b1", "b", 0, 2, 1, now.Add(time.Second)),
+			},
+		},
+		"local queue": {
+			path:       "namespaces/ns/localqueues/a/pendingworkloads",
+			wantStatus: http.StatusOK,
+			wantItems: []visibility.PendingWorkload{
+				pending("a1", "a", 0, 1, 0, now),
+				pending("a2", "a", 0, 3, 1, now.Add(2*time.Second)),
+			},
+		},
+		"offset beyond the end": {
+			path:       "namespaces/ns/localqueues/a/pendingworkloads?offset=5",
+			wantStatus: http.StatusOK,
+			wantItems:  []visibility.PendingWorkload{},
+		},
+		"invalid limit": {
+			path:       "clusterqueues/cq/pendingworkloads?limit=0",
+			wantStatus: http.StatusBadRequest,
+		},
+		"cluster queue not found": {
+			path:       "clusterqueues/other/pendingworkloads",
+			wantStatus: http.StatusNotFound,
+		},
+		"local queue not found": {
+			path:       "namespaces/other/localqueues/a/pendingworkloads",
+			wantStatus: http.StatusNotFound,
+		},
+		"unknown path": {
+			path:       "clusterqueues/cq",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	h := NewHandler(queues, nil, nil)
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, PathPrefix+tc.path, nil))
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("Unexpected status code %d, want %d, body: %s", rec.Code, tc.wantStatus, rec.Body.String())
+			}
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+			var summary visibility.PendingWorkloadsSummary
+			if err := json.Unmarshal(rec.Body.Bytes(), &summary); err != nil {
+				t.Fatalf("Decoding response: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantItems, summary.Items); diff != "" {
+				t.Errorf("Unexpected pending workloads (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+type fakeDryRunner struct {
+	workload *kueue.Workload
+}
+
+func (f *fakeDryRunner) DryRun(_ context.Context, wl *kueue.Workload) (*visibility.DryRunResult, error) {
+	f.workload = wl
+	return &visibility.DryRunResult{
+		ObjectMeta:       metav1.ObjectMeta{Name: wl.Name, Namespace: wl.Namespace},
+		ClusterQueueName: "cq",
+		Mode:             "Fit",
+	}, nil
+}
+
+func TestDryRunHandler(t *testing.T) {
+	wl := utiltesting.MakeWorkload("wl", "other").Request("cpu", "1").Obj()
+	wl.TypeMeta = metav1.TypeMeta{APIVersion: kueue.GroupVersion.String(), Kind: "Workload"}
+	job := testingjob.MakeJob("job", "").Parallelism(2).Request("cpu", "1").Obj()
+	job.TypeMeta = metav1.TypeMeta{APIVersion: batchv1.SchemeGroupVersion.String(), Kind: "Job"}
+	unsupported := metav1.TypeMeta{APIVersion: "example.com/v1", Kind: "Other"}
+	large := wl.DeepCopy()
+	large.Annotations = map[string]string{"large": strings.Repeat("a", maxDryRunBodyBytes)}
+
+	cases := map[string]struct {
+		method        string
+		path          string
+		body          any
+		nilDryRunner  bool
+		wantStatus    int
+		wantName      string
+		wantPodSets   int
+		wantPodsCount int32
+	}{
+		"workload": {
+			method:        http.MethodPost,
+			path:          "namespaces/ns/localqueues/lq/dryrun",
+			body:          wl,
+			wantStatus:    http.StatusOK,
+			wantName:      "wl",
+			wantPodSets:   1,
+			wantPodsCount: 1,
+		},
+		"job": {
+			method:        http.MethodPost,
+			path:          "namespaces/ns/localqueues/lq/dryrun",
+			body:          job,
+			wantStatus:    http.StatusOK,
+			wantName:      "job-job-",
+			wantPodSets:   1,
+			wantPodsCount: 2,
+		},
+		"unsupported kind": {
+			method:     http.MethodPost,
+			path:       "namespaces/ns/localqueues/lq/dryrun",
+			body:       unsupported,
+			wantStatus: http.StatusBadRequest,
+		},
+		"invalid body": {
+			method:     http.MethodPost,
+			path:       "namespaces/ns/localqueues/lq/dryrun",
+			body:       "not an object",
+			wantStatus: http.StatusBadRequest,
+		},
+		"body too large": {
+			method:     http.MethodPost,
+			path:       "namespaces/ns/localqueues/lq/dryrun",
+			body:       large,
+			wantStatus: http.StatusRequestEntityTooLarge,
+		},
+		"get": {
+			method:     http.MethodGet,
+			path:       "namespaces/ns/localqueues/lq/dryrun",
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+		"disabled": {
+			method:       http.MethodPost,
+			path:         "namespaces/ns/localqueues/lq/dryrun",
+			body:         wl,
+			nilDryRunner: true,
+			wantStatus:   http.StatusNotFound,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			dryRunner := &fakeDryRunner{}
+			h := NewHandler(queue.NewManager(utiltesting.NewFakeClient(), nil), utiltesting.NewFakeClient(), dryRunner)
+			if tc.nilDryRunner {
+				h = NewHandler(queue.NewManager(utiltesting.NewFakeClient(), nil), utiltesting.NewFakeClient(), nil)
+			}
+			body, err := json.Marshal(tc.body)
+			if err != nil {
+				t.Fatalf("Encoding body: %v", err)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(tc.method, PathPrefix+tc.path, bytes.NewReader(body)))
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("Unexpected status code %d, want %d, body: %s", rec.Code, tc.wantStatus, rec.Body.String())
+			}
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+			var result visibility.DryRunResult
+			if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+				t.Fatalf("Decoding response: %v", err)
+			}
+			got := dryRunner.workload
+			if got.Namespace != "ns" || got.Spec.QueueName != "lq" {
+				t.Errorf("Unexpected namespace %q or queue %q of the workload, want \"ns\" and \"lq\"", got.Namespace, got.Spec.QueueName)
+			}
+			if !strings.HasPrefix(got.Name, tc.wantName) {
+				t.Errorf("Unexpected workload name %q, want prefix %q", got.Name, tc.wantName)
+			}
+			if len(got.Spec.PodSets) != tc.wantPodSets || got.Spec.PodSets[0].Count != tc.wantPodsCount {
+				t.Errorf("Unexpected pod sets %v, want %d pod sets with %d pods", got.Spec.PodSets, tc.wantPodSets, tc.wantPodsCount)
+			}
+			if result.Name != got.Name || result.Mode != "Fit" {
+				t.Errorf("Unexpected result %v", result)
+			}
+		})
+	}
+}
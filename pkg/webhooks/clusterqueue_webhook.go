@@ -107,8 +107,20 @@ func ValidateClusterQueue(cq *kueue.ClusterQueue) field.ErrorList {
 		allErrs = append(allErrs, validateNameReference(cq.Spec.Cohort, path.Child("cohort"))...)
 	}
 	allErrs = append(allErrs, validateResourceGroups(cq.Spec.ResourceGroups, path.Child("resourceGroups"))...)
+	if len(cq.Spec.Cohort) == 0 {
+		allErrs = append(allErrs, validateNoLendingLimits(cq.Spec.ResourceGroups, path.Child("resourceGroups"), "must be null when the clusterQueue has no cohort")...)
+	}
 	allErrs = append(allErrs,
 		validation.ValidateLabelSelector(cq.Spec.NamespaceSelector, validation.LabelSelectorValidationOptions{}, path.Child("namespaceSelector"))...)
+	for i, ac := range cq.Spec.AdmissionChecks {
+		allErrs = append(allErrs, validateNameReference(ac, path.Child("admissionChecks").Index(i))...)
+	}
+	if cq.Spec.Preemption != nil {
+		allErrs = append(allErrs, validatePreemption(cq.Spec.Preemption, path.Child("preemption"))...)
+	}
+	if cq.Spec.FairSharing != nil && cq.Spec.FairSharing.Weight != nil {
+		allErrs = append(allErrs, validateResourceQuantity(*cq.Spec.FairSharing.Weight, path.Child("fairSharing", "weight"))...)
+	}
 
 	return allErrs
 }
@@ -124,6 +136,16 @@ func ValidateClusterQueueUpdate(newObj, oldObj *kueue.ClusterQueue) field.ErrorL
 	return allErrs
 }
 
+func validatePreemption(preemption *kueue.ClusterQueuePreemption, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if preemption.ReclaimWithinCohort == kueue.PreemptionPolicyNever &&
+		preemption.BorrowWithinCohort != nil &&
+		preemption.BorrowWithinCohort.Policy != kueue.BorrowWithinCohortPolicyNever {
+		allErrs = append(allErrs, field.Invalid(path, preemption, "reclaimWithinCohort=Never and borrowWithinCohort.Policy!=Never"))
+	}
+	return allErrs
+}
+
 func validateResourceGroups(resourceGroups []kueue.ResourceGroup, path *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 	seenResources := sets.New[corev1.ResourceName]()
@@ -171,6 +193,28 @@ func validateFlavorQuotas(flavorQuotas kueue.FlavorQuotas, coveredResources []co
 		if rq.BorrowingLimit != nil {
 			allErrs = append(allErrs, validateResourceQuantity(*rq.BorrowingLimit, path.Child("borrowingLimit"))...)
 		}
+		if rq.LendingLimit != nil {
+			allErrs = append(allErrs, validateResourceQuantity(*rq.LendingLimit, path.Child("lendingLimit"))...)
+			if rq.LendingLimit.Cmp(rq.NominalQuota) > 0 {
+				allErrs = append(allErrs, field.Invalid(path.Child("lendingLimit"), rq.LendingLimit.String(), "must be less than or equal to the nominalQuota"))
+			}
+		}
+	}
+	return allErrs
+}
+
+// validateNoLendingLimits enforces that no quota in the resource groups has a
+// lending limit.
+func validateNoLendingLimits(resourceGroups []kueue.ResourceGroup, path *field.Path, msg string) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, rg := range resourceGroups {
+		for j, fqs := range rg.Flavors {
+			for k, rq := range fqs.Resources {
+				if rq.LendingLimit != nil {
+					allErrs = append(allErrs, field.Forbidden(path.Index(i).Child("flavors").Index(j).Child("resources").Index(k).Child("lendingLimit"), msg))
+				}
+			}
+		}
 	}
 	return allErrs
 }
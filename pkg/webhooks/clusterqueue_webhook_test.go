@@ -64,6 +64,51 @@ func TestValidateClusterQueue(t *testing.T) {
 				field.Invalid(specPath.Child("cohort"), "@prod", ""),
 			},
 		},
+		{
+			name:         "admission checks",
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").AdmissionChecks("check1", "check2").Obj(),
+		},
+		{
+			name:         "invalid admission check name",
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").AdmissionChecks("check1", "@check2").Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(specPath.Child("admissionChecks").Index(1), "@check2", ""),
+			},
+		},
+		{
+			name:         "fair sharing weight",
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").FairWeight("0.5").Obj(),
+		},
+		{
+			name:         "negative fair sharing weight",
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").FairWeight("-1").Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(specPath.Child("fairSharing", "weight"), "-1", ""),
+			},
+		},
+		{
+			name: "borrowWithinCohort with reclaimWithinCohort",
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				Preemption(kueue.ClusterQueuePreemption{
+					ReclaimWithinCohort: kueue.PreemptionPolicyLowerPriority,
+					BorrowWithinCohort: &kueue.BorrowWithinCohort{
+						Policy: kueue.BorrowWithinCohortPolicyLowerPriority,
+					},
+				}).Obj(),
+		},
+		{
+			name: "borrowWithinCohort without reclaimWithinCohort",
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				Preemption(kueue.ClusterQueuePreemption{
+					ReclaimWithinCohort: kueue.PreemptionPolicyNever,
+					BorrowWithinCohort: &kueue.BorrowWithinCohort{
+						Policy: kueue.BorrowWithinCohortPolicyLowerPriority,
+					},
+				}).Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(specPath.Child("preemption"), "", ""),
+			},
+		},
 		{
 			name: "extended resources with qualified names",
 			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
@@ -119,6 +164,46 @@ func TestValidateClusterQueue(t *testing.T) {
 				field.Invalid(resourceGroupsPath.Index(0).Child("flavors").Index(0).Child("resources").Index(0).Child("borrowingLimit"), "-1", ""),
 			},
 		},
+		{
+			name: "flavor quota with lendingLimit",
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				Cohort("cohort").
+				ResourceGroup(
+					*testingutil.MakeFlavorQuotas("x86").Resource("cpu", "2", "", "1").Obj()).
+				Obj(),
+		},
+		{
+			name: "flavor quota with negative lendingLimit",
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				Cohort("cohort").
+				ResourceGroup(
+					*testingutil.MakeFlavorQuotas("x86").Resource("cpu", "2", "", "-1").Obj()).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(resourceGroupsPath.Index(0).Child("flavors").Index(0).Child("resources").Index(0).Child("lendingLimit"), "-1", ""),
+			},
+		},
+		{
+			name: "flavor quota with lendingLimit greater than nominalQuota",
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				Cohort("cohort").
+				ResourceGroup(
+					*testingutil.MakeFlavorQuotas("x86").Resource("cpu", "2", "", "3").Obj()).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(resourceGroupsPath.Index(0).Child("flavors").Index(0).Child("resources").Index(0).Child("lendingLimit"), "3", ""),
+			},
+		},
+		{
+			name: "flavor quota with lendingLimit and no cohort",
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				ResourceGroup(
+					*testingutil.MakeFlavorQuotas("x86").Resource("cpu", "2", "", "1").Obj()).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Forbidden(resourceGroupsPath.Index(0).Child("flavors").Index(0).Child("resources").Index(0).Child("lendingLimit"), ""),
+			},
+		},
 		{
 			name: "empty queueing strategy is supported",
 			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
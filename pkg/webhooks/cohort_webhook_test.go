@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestValidateCohort(t *testing.T) {
+	resourcesPath := field.NewPath("spec", "resourceGroups").Index(0).Child("flavors").Index(0).Child("resources").Index(0)
+	testcases := []struct {
+		name    string
+		cohort  *kueue.Cohort
+		wantErr field.ErrorList
+	}{
+		{
+			name:   "empty",
+			cohort: utiltesting.MakeCohort("cohort").Obj(),
+		},
+		{
+			name: "valid",
+			cohort: utiltesting.MakeCohort("cohort").
+				Parent("parent").
+				ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10", "5").Obj()).
+				Obj(),
+		},
+		{
+			name:   "invalid parent name",
+			cohort: utiltesting.MakeCohort("cohort").Parent("@parent").Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "parent"), "@parent", ""),
+			},
+		},
+		{
+			name:   "parent is itself",
+			cohort: utiltesting.MakeCohort("cohort").Parent("cohort").Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "parent"), "cohort", ""),
+			},
+		},
+		{
+			name: "negative quota",
+			cohort: utiltesting.MakeCohort("cohort").
+				ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "-1").Obj()).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(resourcesPath.Child("nominalQuota"), "-1", ""),
+			},
+		},
+		{
+			name: "borrowingLimit without parent",
+			cohort: utiltesting.MakeCohort("cohort").
+				ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10", "5").Obj()).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Forbidden(resourcesPath.Child("borrowingLimit"), ""),
+			},
+		},
+		{
+			name: "lendingLimit",
+			cohort: utiltesting.MakeCohort("cohort").
+				Parent("parent").
+				ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10", "", "5").Obj()).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Forbidden(resourcesPath.Child("lendingLimit"), ""),
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotErr := ValidateCohort(tc.cohort)
+			if diff := cmp.Diff(tc.wantErr, gotErr, cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail")); diff != "" {
+				t.Errorf("ValidateCohort() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
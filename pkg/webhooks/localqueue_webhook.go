@@ -69,9 +69,26 @@ func ValidateLocalQueue(q *kueue.LocalQueue) field.ErrorList {
 	var allErrs field.ErrorList
 	clusterQueuePath := field.NewPath("spec", "clusterQueue")
 	allErrs = append(allErrs, validateNameReference(string(q.Spec.ClusterQueue), clusterQueuePath)...)
+	allErrs = append(allErrs, validateFlavorLimits(q.Spec.FlavorLimits, field.NewPath("spec", "flavorLimits"))...)
 	return allErrs
 }
 
 func ValidateLocalQueueUpdate(newObj, oldObj *kueue.LocalQueue) field.ErrorList {
-	return apivalidation.ValidateImmutableField(newObj.Spec.ClusterQueue, oldObj.Spec.ClusterQueue, field.NewPath("spec", "clusterQueue"))
+	allErrs := apivalidation.ValidateImmutableField(newObj.Spec.ClusterQueue, oldObj.Spec.ClusterQueue, field.NewPath("spec", "clusterQueue"))
+	allErrs = append(allErrs, validateFlavorLimits(newObj.Spec.FlavorLimits, field.NewPath("spec", "flavorLimits"))...)
+	return allErrs
+}
+
+func validateFlavorLimits(flavorLimits []kueue.LocalQueueFlavorLimit, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, fl := range flavorLimits {
+		flvPath := path.Index(i)
+		allErrs = append(allErrs, validateNameReference(string(fl.Name), flvPath.Child("name"))...)
+		for j, rl := range fl.Resources {
+			resPath := flvPath.Child("resources").Index(j)
+			allErrs = append(allErrs, validateResourceName(rl.Name, resPath.Child("name"))...)
+			allErrs = append(allErrs, validateResourceQuantity(rl.Max, resPath.Child("max"))...)
+		}
+	}
+	return allErrs
 }
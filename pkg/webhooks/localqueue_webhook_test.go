@@ -21,6 +21,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
@@ -43,6 +44,25 @@ func TestValidateLocalQueueCreate(t *testing.T) {
 				field.Invalid(field.NewPath("spec").Child("clusterQueue"), "invalid_name", ""),
 			},
 		},
+		"should accept queue creation with flavor limits": {
+			queue: testingutil.MakeLocalQueue(testLocalQueueName, testLocalQueueNamespace).
+				ClusterQueue("cq").
+				FlavorLimit("default", corev1.ResourceCPU, "10").
+				FlavorLimit("default", corev1.ResourceMemory, "10Gi").
+				Obj(),
+		},
+		"should reject queue creation with invalid flavor limits": {
+			queue: testingutil.MakeLocalQueue(testLocalQueueName, testLocalQueueNamespace).
+				ClusterQueue("cq").
+				FlavorLimit("@default", corev1.ResourceCPU, "10").
+				FlavorLimit("spot", "@cpu", "-1").
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "flavorLimits").Index(0).Child("name"), "@default", ""),
+				field.Invalid(field.NewPath("spec", "flavorLimits").Index(1).Child("resources").Index(0).Child("name"), "@cpu", ""),
+				field.Invalid(field.NewPath("spec", "flavorLimits").Index(1).Child("resources").Index(0).Child("max"), "-1", ""),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -66,6 +86,15 @@ func TestValidateLocalQueueUpdate(t *testing.T) {
 				field.Invalid(field.NewPath("spec").Child("clusterQueue"), nil, ""),
 			},
 		},
+		"flavor limits can be updated": {
+			before: testingutil.MakeLocalQueue(testLocalQueueName, testLocalQueueNamespace).
+				FlavorLimit("default", corev1.ResourceCPU, "10").
+				Obj(),
+			after: testingutil.MakeLocalQueue(testLocalQueueName, testLocalQueueNamespace).
+				FlavorLimit("default", corev1.ResourceCPU, "20").
+				Obj(),
+			wantErr: field.ErrorList{},
+		},
 		"status could be updated": {
 			before:  testingutil.MakeLocalQueue(testLocalQueueName, testLocalQueueNamespace).Obj(),
 			after:   testingutil.MakeLocalQueue(testLocalQueueName, testLocalQueueNamespace).PendingWorkloads(10).Obj(),
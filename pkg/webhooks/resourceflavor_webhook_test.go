@@ -59,6 +59,32 @@ func TestValidateResourceFlavor(t *testing.T) {
 				field.Required(field.NewPath("spec", "nodeTaints").Index(0).Child("effect"), ""),
 			},
 		},
+		{
+			name: "valid toleration",
+			rf: utiltesting.MakeResourceFlavor("resource-flavor").
+				Toleration(corev1.Toleration{
+					Key:      "spot",
+					Operator: corev1.TolerationOpEqual,
+					Value:    "true",
+					Effect:   corev1.TaintEffectNoSchedule,
+				}).Obj(),
+		},
+		{
+			// Toleration validation is not exhaustively tested, because the code was copied from upstream k8s.
+			name: "invalid toleration",
+			rf: utiltesting.MakeResourceFlavor("resource-flavor").Toleration(corev1.Toleration{
+				Key:      "spot",
+				Operator: corev1.TolerationOpExists,
+				Value:    "true",
+			}).Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "tolerations").Index(0).Child("operator"), corev1.Toleration{
+					Key:      "spot",
+					Operator: corev1.TolerationOpExists,
+					Value:    "true",
+				}, ""),
+			},
+		},
 		{
 			name: "invalid label name",
 			rf:   utiltesting.MakeResourceFlavor("resource-flavor").Label("@abc", "foo").Obj(),
@@ -36,5 +36,9 @@ func Setup(mgr ctrl.Manager) (string, error) {
 	if err := setupWebhookForLocalQueue(mgr); err != nil {
 		return "Queue", err
 	}
+
+	if err := setupWebhookForCohort(mgr); err != nil {
+		return "Cohort", err
+	}
 	return "", nil
 }
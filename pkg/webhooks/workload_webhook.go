@@ -130,13 +130,16 @@ func ValidateWorkload(obj *kueue.Workload) field.ErrorList {
 			allErrs = append(allErrs, field.Invalid(specPath.Child("priority"), obj.Spec.Priority, "priority should not be nil when priorityClassName is set"))
 		}
 	}
+	if len(obj.Spec.PriorityClassSource) > 0 && len(obj.Spec.PriorityClassName) == 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("priorityClassSource"), obj.Spec.PriorityClassSource, "priorityClassSource should be empty when priorityClassName is not set"))
+	}
 
 	if len(obj.Spec.QueueName) > 0 {
 		allErrs = append(allErrs, validateNameReference(obj.Spec.QueueName, specPath.Child("queueName"))...)
 	}
 
 	statusPath := field.NewPath("status")
-	if workload.IsAdmitted(obj) {
+	if workload.HasQuotaReservation(obj) {
 		allErrs = append(allErrs, validateAdmission(obj, statusPath.Child("admission"))...)
 	}
 
@@ -244,7 +247,7 @@ func ValidateWorkloadUpdate(newObj, oldObj *kueue.Workload) field.ErrorList {
 	specPath := field.NewPath("spec")
 	allErrs = append(allErrs, ValidateWorkload(newObj)...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newObj.Spec.PodSets, oldObj.Spec.PodSets, specPath.Child("podSets"))...)
-	if workload.IsAdmitted(newObj) && workload.IsAdmitted(oldObj) {
+	if workload.HasQuotaReservation(newObj) && workload.HasQuotaReservation(oldObj) {
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newObj.Spec.QueueName, oldObj.Spec.QueueName, specPath.Child("queueName"))...)
 		allErrs = append(allErrs, validateReclaimablePodsUpdate(newObj, oldObj, field.NewPath("status", "reclaimablePods"))...)
 	}
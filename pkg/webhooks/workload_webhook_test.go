@@ -27,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/constants"
 	testingutil "sigs.k8s.io/kueue/pkg/util/testing"
 )
 
@@ -140,6 +141,14 @@ func TestValidateWorkload(t *testing.T) {
 				field.Invalid(specPath.Child("priority"), nil, ""),
 			},
 		},
+		"should have priorityClassName once priorityClassSource is set": {
+			workload: testingutil.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PriorityClassSource(constants.WorkloadPriorityClassSource).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(specPath.Child("priorityClassSource"), nil, ""),
+			},
+		},
 		"should have a valid queueName": {
 			workload: testingutil.MakeWorkload(testWorkloadName, testWorkloadNamespace).
 				Queue("@invalid").
@@ -0,0 +1,170 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/util/api"
+)
+
+// SyncAdmissionChecks sets the list of admission checks of the workload to the
+// provided names. The state of the checks that are already present is kept,
+// the new ones are added in the Pending state and the ones no longer needed
+// are dropped.
+// Returns true if the list was changed.
+func SyncAdmissionChecks(wl *kueue.Workload, checks sets.Set[string]) bool {
+	changed := false
+	newChecks := make([]kueue.AdmissionCheckState, 0, checks.Len())
+	for i := range wl.Status.AdmissionChecks {
+		if checks.Has(wl.Status.AdmissionChecks[i].Name) {
+			newChecks = append(newChecks, wl.Status.AdmissionChecks[i])
+		} else {
+			changed = true
+		}
+	}
+	for _, name := range sets.List(checks) {
+		if FindAdmissionCheck(newChecks, name) == nil {
+			newChecks = append(newChecks, kueue.AdmissionCheckState{
+				Name:               name,
+				State:              kueue.CheckStatePending,
+				LastTransitionTime: metav1.Now(),
+			})
+			changed = true
+		}
+	}
+	if !changed {
+		return false
+	}
+	sort.Slice(newChecks, func(i, j int) bool {
+		return newChecks[i].Name < newChecks[j].Name
+	})
+	wl.Status.AdmissionChecks = newChecks
+	return true
+}
+
+// FindAdmissionCheck returns the admission check with the provided name,
+// nil if not found.
+func FindAdmissionCheck(checks []kueue.AdmissionCheckState, name string) *kueue.AdmissionCheckState {
+	for i := range checks {
+		if checks[i].Name == name {
+			return &checks[i]
+		}
+	}
+	return nil
+}
+
+// SetAdmissionCheckState updates or adds the provided admission check.
+// The LastTransitionTime is only updated if the state changes.
+func SetAdmissionCheckState(checks *[]kueue.AdmissionCheckState, newCheck kueue.AdmissionCheckState) {
+	if checks == nil {
+		return
+	}
+	newCheck.Message = api.TruncateConditionMessage(newCheck.Message)
+	existing := FindAdmissionCheck(*checks, newCheck.Name)
+	if existing == nil {
+		if newCheck.LastTransitionTime.IsZero() {
+			newCheck.LastTransitionTime = metav1.Now()
+		}
+		*checks = append(*checks, newCheck)
+		return
+	}
+
+	if existing.State != newCheck.State {
+		existing.State = newCheck.State
+		if !newCheck.LastTransitionTime.IsZero() {
+			existing.LastTransitionTime = newCheck.LastTransitionTime
+		} else {
+			existing.LastTransitionTime = metav1.Now()
+		}
+	}
+	existing.Message = newCheck.Message
+	existing.PodSetUpdates = newCheck.PodSetUpdates
+}
+
+// ResetChecksOnEviction sets all the admission checks of the workload
+// back to Pending.
+func ResetChecksOnEviction(w *kueue.Workload) {
+	checks := w.Status.AdmissionChecks
+	for i := range checks {
+		if checks[i].State == kueue.CheckStatePending {
+			continue
+		}
+		checks[i] = kueue.AdmissionCheckState{
+			Name:               checks[i].Name,
+			State:              kueue.CheckStatePending,
+			LastTransitionTime: metav1.Now(),
+			Message:            "Reset to Pending after eviction. Previously: " + string(checks[i].State),
+		}
+	}
+}
+
+// HasAllChecksReady returns true if all the admission checks of the workload
+// are in the Ready state.
+func HasAllChecksReady(wl *kueue.Workload) bool {
+	for i := range wl.Status.AdmissionChecks {
+		if wl.Status.AdmissionChecks[i].State != kueue.CheckStateReady {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAllChecks returns true if the workload has a state for all the
+// provided admission checks.
+func HasAllChecks(wl *kueue.Workload, mustHaveChecks sets.Set[string]) bool {
+	if mustHaveChecks.Len() == 0 {
+		return true
+	}
+	if mustHaveChecks.Len() > len(wl.Status.AdmissionChecks) {
+		return false
+	}
+	for name := range mustHaveChecks {
+		if FindAdmissionCheck(wl.Status.AdmissionChecks, name) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// HasRetryOrRejectedChecks returns true if at least one of the admission
+// checks of the workload is in the Retry or Rejected state.
+func HasRetryOrRejectedChecks(wl *kueue.Workload) bool {
+	for i := range wl.Status.AdmissionChecks {
+		state := wl.Status.AdmissionChecks[i].State
+		if state == kueue.CheckStateRetry || state == kueue.CheckStateRejected {
+			return true
+		}
+	}
+	return false
+}
+
+// RejectedChecks returns the list of the admission checks of the workload
+// that are in the Rejected state.
+func RejectedChecks(wl *kueue.Workload) []kueue.AdmissionCheckState {
+	var rejected []kueue.AdmissionCheckState
+	for i := range wl.Status.AdmissionChecks {
+		if wl.Status.AdmissionChecks[i].State == kueue.CheckStateRejected {
+			rejected = append(rejected, wl.Status.AdmissionChecks[i])
+		}
+	}
+	return rejected
+}
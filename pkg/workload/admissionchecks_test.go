@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestSyncAdmissionChecks(t *testing.T) {
+	cases := map[string]struct {
+		wl          *kueue.Workload
+		checks      sets.Set[string]
+		wantChanged bool
+		wantChecks  []kueue.AdmissionCheckState
+	}{
+		"empty": {
+			wl: utiltesting.MakeWorkload("wl", "ns").Obj(),
+		},
+		"add checks": {
+			wl:          utiltesting.MakeWorkload("wl", "ns").Obj(),
+			checks:      sets.New("ac2", "ac1"),
+			wantChanged: true,
+			wantChecks: []kueue.AdmissionCheckState{
+				{Name: "ac1", State: kueue.CheckStatePending},
+				{Name: "ac2", State: kueue.CheckStatePending},
+			},
+		},
+		"keep existing and drop extra": {
+			wl: utiltesting.MakeWorkload("wl", "ns").
+				AdmissionCheck(kueue.AdmissionCheckState{Name: "ac1", State: kueue.CheckStateReady, Message: "ok"}).
+				AdmissionCheck(kueue.AdmissionCheckState{Name: "ac3", State: kueue.CheckStateRetry}).
+				Obj(),
+			checks:      sets.New("ac1", "ac2"),
+			wantChanged: true,
+			wantChecks: []kueue.AdmissionCheckState{
+				{Name: "ac1", State: kueue.CheckStateReady, Message: "ok"},
+				{Name: "ac2", State: kueue.CheckStatePending},
+			},
+		},
+		"no change": {
+			wl: utiltesting.MakeWorkload("wl", "ns").
+				AdmissionCheck(kueue.AdmissionCheckState{Name: "ac1", State: kueue.CheckStateReady}).
+				Obj(),
+			checks: sets.New("ac1"),
+			wantChecks: []kueue.AdmissionCheckState{
+				{Name: "ac1", State: kueue.CheckStateReady},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := SyncAdmissionChecks(tc.wl, tc.checks)
+			if changed != tc.wantChanged {
+				t.Errorf("Unexpected changed, want=%v, got=%v", tc.wantChanged, changed)
+			}
+			if diff := cmp.Diff(tc.wantChecks, tc.wl.Status.AdmissionChecks, cmpopts.IgnoreFields(kueue.AdmissionCheckState{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("Unexpected admission checks (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetAdmissionCheckState(t *testing.T) {
+	cases := map[string]struct {
+		checks     []kueue.AdmissionCheckState
+		newCheck   kueue.AdmissionCheckState
+		wantChecks []kueue.AdmissionCheckState
+	}{
+		"add": {
+			newCheck: kueue.AdmissionCheckState{Name: "ac1", State: kueue.CheckStatePending},
+			wantChecks: []kueue.AdmissionCheckState{
+				{Name: "ac1", State: kueue.CheckStatePending},
+			},
+		},
+		"update": {
+			checks: []kueue.AdmissionCheckState{
+				{Name: "ac1", State: kueue.CheckStatePending},
+				{Name: "ac2", State: kueue.CheckStatePending},
+			},
+			newCheck: kueue.AdmissionCheckState{Name: "ac2", State: kueue.CheckStateReady, Message: "ready"},
+			wantChecks: []kueue.AdmissionCheckState{
+				{Name: "ac1", State: kueue.CheckStatePending},
+				{Name: "ac2", State: kueue.CheckStateReady, Message: "ready"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			SetAdmissionCheckState(&tc.checks, tc.newCheck)
+			if diff := cmp.Diff(tc.wantChecks, tc.checks, cmpopts.IgnoreFields(kueue.AdmissionCheckState{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("Unexpected admission checks (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResetChecksOnEviction(t *testing.T) {
+	wl := utiltesting.MakeWorkload("wl", "ns").
+		AdmissionCheck(kueue.AdmissionCheckState{Name: "ac1", State: kueue.CheckStatePending, Message: "waiting"}).
+		AdmissionCheck(kueue.AdmissionCheckState{Name: "ac2", State: kueue.CheckStateRetry}).
+		AdmissionCheck(kueue.AdmissionCheckState{Name: "ac3", State: kueue.CheckStateReady}).
+		Obj()
+	ResetChecksOnEviction(wl)
+	wantChecks := []kueue.AdmissionCheckState{
+		{Name: "ac1", State: kueue.CheckStatePending, Message: "waiting"},
+		{Name: "ac2", State: kueue.CheckStatePending, Message: "Reset to Pending after eviction. Previously: Retry"},
+		{Name: "ac3", State: kueue.CheckStatePending, Message: "Reset to Pending after eviction. Previously: Ready"},
+	}
+	if diff := cmp.Diff(wantChecks, wl.Status.AdmissionChecks, cmpopts.IgnoreFields(kueue.AdmissionCheckState{}, "LastTransitionTime")); diff != "" {
+		t.Errorf("Unexpected admission checks (-want,+got):\n%s", diff)
+	}
+}
+
+func TestChecksStates(t *testing.T) {
+	cases := map[string]struct {
+		checks             []kueue.AdmissionCheckState
+		wantAllReady       bool
+		wantRetryOrReject  bool
+		wantRejectedChecks []string
+	}{
+		"no checks": {
+			wantAllReady: true,
+		},
+		"all ready": {
+			checks: []kueue.AdmissionCheckState{
+				{Name: "ac1", State: kueue.CheckStateReady},
+				{Name: "ac2", State: kueue.CheckStateReady},
+			},
+			wantAllReady: true,
+		},
+		"one pending": {
+			checks: []kueue.AdmissionCheckState{
+				{Name: "ac1", State: kueue.CheckStateReady},
+				{Name: "ac2", State: kueue.CheckStatePending},
+			},
+		},
+		"one retry": {
+			checks: []kueue.AdmissionCheckState{
+				{Name: "ac1", State: kueue.CheckStateReady},
+				{Name: "ac2", State: kueue.CheckStateRetry},
+			},
+			wantRetryOrReject: true,
+		},
+		"one rejected": {
+			checks: []kueue.AdmissionCheckState{
+				{Name: "ac1", State: kueue.CheckStateRejected},
+				{Name: "ac2", State: kueue.CheckStatePending},
+			},
+			wantRetryOrReject:  true,
+			wantRejectedChecks: []string{"ac1"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			wl := utiltesting.MakeWorkload("wl", "ns").Obj()
+			wl.Status.AdmissionChecks = tc.checks
+			if got := HasAllChecksReady(wl); got != tc.wantAllReady {
+				t.Errorf("Unexpected HasAllChecksReady, want=%v, got=%v", tc.wantAllReady, got)
+			}
+			if got := HasRetryOrRejectedChecks(wl); got != tc.wantRetryOrReject {
+				t.Errorf("Unexpected HasRetryOrRejectedChecks, want=%v, got=%v", tc.wantRetryOrReject, got)
+			}
+			var rejected []string
+			for _, c := range RejectedChecks(wl) {
+				rejected = append(rejected, c.Name)
+			}
+			if diff := cmp.Diff(tc.wantRejectedChecks, rejected); diff != "" {
+				t.Errorf("Unexpected rejected checks (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
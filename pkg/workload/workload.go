@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
@@ -36,7 +37,7 @@ import (
 )
 
 var (
-	admissionManagedConditions = []string{kueue.WorkloadAdmitted, kueue.WorkloadEvicted}
+	admissionManagedConditions = []string{kueue.WorkloadQuotaReserved, kueue.WorkloadEvicted, kueue.WorkloadAdmitted, kueue.WorkloadPreemptionPending}
 )
 
 // Info holds a Workload object and some pre-processing.
@@ -69,6 +70,15 @@ func (psr *PodSetResources) ScaledTo(newCount int32) *PodSetResources {
 	return ret
 }
 
+// SinglePodRequests returns the requests of a single pod of the podset.
+func (psr *PodSetResources) SinglePodRequests() Requests {
+	ret := maps.Clone(psr.Requests)
+	if psr.Count > 0 {
+		ret.scaleDown(int64(psr.Count))
+	}
+	return ret
+}
+
 func NewInfo(w *kueue.Workload) *Info {
 	info := &Info{
 		Obj: w,
@@ -150,7 +160,7 @@ func totalRequestsFromPodSets(wl *kueue.Workload) []PodSetResources {
 			Name:  ps.Name,
 			Count: count,
 		}
-		setRes.Requests = newRequests(limitrange.TotalRequests(&ps.Template.Spec))
+		setRes.Requests = NewRequests(limitrange.TotalRequests(&ps.Template.Spec))
 		setRes.Requests.scaleUp(int64(count))
 		res = append(res, setRes)
 	}
@@ -169,7 +179,7 @@ func totalRequestsFromAdmission(wl *kueue.Workload) []PodSetResources {
 			Name:     psa.Name,
 			Flavors:  psa.Flavors,
 			Count:    pointer.Int32Deref(psa.Count, totalCounts[psa.Name]),
-			Requests: newRequests(psa.ResourceUsage),
+			Requests: NewRequests(psa.ResourceUsage),
 		}
 
 		if count := currentCounts[psa.Name]; count != setRes.Count {
@@ -189,7 +199,8 @@ func totalRequestsFromAdmission(wl *kueue.Workload) []PodSetResources {
 // Requests maps ResourceName to flavor to value; for CPU it is tracked in MilliCPU.
 type Requests map[corev1.ResourceName]int64
 
-func newRequests(rl corev1.ResourceList) Requests {
+// NewRequests converts a ResourceList into Requests.
+func NewRequests(rl corev1.ResourceList) Requests {
 	r := Requests{}
 	for name, quant := range rl {
 		r[name] = ResourceValue(name, quant)
@@ -263,16 +274,30 @@ func UpdateStatus(ctx context.Context,
 	return c.Status().Patch(ctx, newWl, client.Apply, client.FieldOwner(managerPrefix+"-"+condition.Type))
 }
 
-func UnsetAdmissionWithCondition(wl *kueue.Workload, reason, message string) {
+// UnsetQuotaReservationWithCondition clears the admission of the workload,
+// sets the QuotaReserved condition to False with the provided reason and message,
+// and resets the state of the admission checks.
+func UnsetQuotaReservationWithCondition(wl *kueue.Workload, reason, message string) {
+	now := metav1.Now()
+	if IsAdmitted(wl) {
+		// Keep track of the time spent admitted, for the maximum execution time.
+		wl.Status.AccumulatedPastExecutionTimeSeconds = pointer.Int32(int32(ExecutionTime(wl, now.Time) / time.Second))
+	}
 	condition := metav1.Condition{
-		Type:               kueue.WorkloadAdmitted,
+		Type:               kueue.WorkloadQuotaReserved,
 		Status:             metav1.ConditionFalse,
-		LastTransitionTime: metav1.Now(),
+		LastTransitionTime: now,
 		Reason:             reason,
 		Message:            api.TruncateConditionMessage(message),
 	}
 	apimeta.SetStatusCondition(&wl.Status.Conditions, condition)
 	wl.Status.Admission = nil
+	ResetChecksOnEviction(wl)
+	apimeta.RemoveStatusCondition(&wl.Status.Conditions, kueue.WorkloadPreemptionPending)
+	wl.Status.PreemptionDeadline = nil
+
+	// Reset the admitted condition if necessary.
+	_ = SyncAdmittedCondition(wl)
 }
 
 // BaseSSAWorkload creates a new object based on the input workload that
@@ -297,16 +322,16 @@ func BaseSSAWorkload(w *kueue.Workload) *kueue.Workload {
 	return wlCopy
 }
 
-// SetAdmission applies the provided admission to the workload.
-// The WorkloadAdmitted and WorkloadEvicted are added or updated if necessary.
-func SetAdmission(w *kueue.Workload, admission *kueue.Admission) {
+// SetQuotaReservation applies the provided admission to the workload.
+// The WorkloadQuotaReserved and WorkloadEvicted are added or updated if necessary.
+func SetQuotaReservation(w *kueue.Workload, admission *kueue.Admission) {
 	w.Status.Admission = admission
 	admittedCond := metav1.Condition{
-		Type:               kueue.WorkloadAdmitted,
+		Type:               kueue.WorkloadQuotaReserved,
 		Status:             metav1.ConditionTrue,
 		LastTransitionTime: metav1.Now(),
-		Reason:             "Admitted",
-		Message:            fmt.Sprintf("Admitted by ClusterQueue %s", w.Status.Admission.ClusterQueue),
+		Reason:             "QuotaReserved",
+		Message:            fmt.Sprintf("Quota reserved in ClusterQueue %s", w.Status.Admission.ClusterQueue),
 	}
 	apimeta.SetStatusCondition(&w.Status.Conditions, admittedCond)
 
@@ -328,12 +353,37 @@ func SetEvictedCondition(w *kueue.Workload, reason string, message string) {
 	apimeta.SetStatusCondition(&w.Status.Conditions, condition)
 }
 
+// SetPreemptionPendingCondition marks the workload as preempted, to be evicted
+// at the deadline.
+func SetPreemptionPendingCondition(w *kueue.Workload, message string, deadline metav1.Time) {
+	condition := metav1.Condition{
+		Type:               kueue.WorkloadPreemptionPending,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             kueue.WorkloadEvictedByPreemption,
+		Message:            message,
+	}
+	apimeta.SetStatusCondition(&w.Status.Conditions, condition)
+	w.Status.PreemptionDeadline = &deadline
+}
+
+// IsPreemptionPending returns whether the workload was preempted and is in
+// its grace period, not yet evicted.
+func IsPreemptionPending(w *kueue.Workload) bool {
+	return apimeta.IsStatusConditionTrue(w.Status.Conditions, kueue.WorkloadPreemptionPending) &&
+		!apimeta.IsStatusConditionTrue(w.Status.Conditions, kueue.WorkloadEvicted)
+}
+
 // admissionPatch creates a new object based on the input workload that contains
 // the admission and related conditions. The object can be used in Server-Side-Apply.
 func admissionPatch(w *kueue.Workload) *kueue.Workload {
 	wlCopy := BaseSSAWorkload(w)
 
 	wlCopy.Status.Admission = w.Status.Admission.DeepCopy()
+	wlCopy.Status.AdmissionChecks = append([]kueue.AdmissionCheckState(nil), w.Status.AdmissionChecks...)
+	wlCopy.Status.RequeueState = w.Status.RequeueState.DeepCopy()
+	wlCopy.Status.AccumulatedPastExecutionTimeSeconds = w.Status.AccumulatedPastExecutionTimeSeconds
+	wlCopy.Status.PreemptionDeadline = w.Status.PreemptionDeadline.DeepCopy()
 	for _, conditionName := range admissionManagedConditions {
 		if existing := apimeta.FindStatusCondition(w.Status.Conditions, conditionName); existing != nil {
 			wlCopy.Status.Conditions = append(wlCopy.Status.Conditions, *existing.DeepCopy())
@@ -345,12 +395,14 @@ func admissionPatch(w *kueue.Workload) *kueue.Workload {
 // ApplyAdmissionStatus updated all the admission related status fields of a workload with SSA.
 // if strict is true, resourceVersion will be part of the patch, make this call fail if Workload
 // was changed.
+// The ownership of the fields is forced, since the state of the admission checks
+// is also managed by their controllers.
 func ApplyAdmissionStatus(ctx context.Context, c client.Client, w *kueue.Workload, strict bool) error {
 	patch := admissionPatch(w)
 	if strict {
 		patch.ResourceVersion = w.ResourceVersion
 	}
-	return c.Status().Patch(ctx, patch, client.Apply, client.FieldOwner(constants.AdmissionName))
+	return c.Status().Patch(ctx, patch, client.Apply, client.FieldOwner(constants.AdmissionName), client.ForceOwnership)
 }
 
 // GetQueueOrderTimestamp return the timestamp to be used by the scheduler. It could
@@ -362,11 +414,75 @@ func GetQueueOrderTimestamp(w *kueue.Workload) *metav1.Time {
 	return &w.CreationTimestamp
 }
 
-// IsAdmitted checks if workload is admitted based on conditions
+// IsActive returns whether the workload can be admitted, based on
+// spec.active. A nil value means active.
+func IsActive(w *kueue.Workload) bool {
+	return w.Spec.Active == nil || *w.Spec.Active
+}
+
+// ExecutionTime returns the time the workload has been admitted, including the
+// time it was admitted before previous evictions.
+func ExecutionTime(w *kueue.Workload, now time.Time) time.Duration {
+	total := time.Duration(pointer.Int32Deref(w.Status.AccumulatedPastExecutionTimeSeconds, 0)) * time.Second
+	if c := apimeta.FindStatusCondition(w.Status.Conditions, kueue.WorkloadAdmitted); c != nil && c.Status == metav1.ConditionTrue {
+		total += now.Sub(c.LastTransitionTime.Time)
+	}
+	return total
+}
+
+// IsWaitingForRequeue returns whether the workload is kept out of the queues
+// until its requeueAt time, after being evicted for exceeding the PodsReady
+// timeout.
+func IsWaitingForRequeue(w *kueue.Workload) bool {
+	return w.Status.RequeueState != nil && w.Status.RequeueState.RequeueAt != nil
+}
+
+// HasQuotaReservation checks if workload has reserved quota based on conditions
+func HasQuotaReservation(w *kueue.Workload) bool {
+	return apimeta.IsStatusConditionTrue(w.Status.Conditions, kueue.WorkloadQuotaReserved)
+}
+
+// IsAdmitted returns true if the workload is admitted.
 func IsAdmitted(w *kueue.Workload) bool {
 	return apimeta.IsStatusConditionTrue(w.Status.Conditions, kueue.WorkloadAdmitted)
 }
 
+// SyncAdmittedCondition sync the state of the Admitted condition
+// with the state of QuotaReserved and AdmissionChecks.
+// Return true if any change was done.
+func SyncAdmittedCondition(w *kueue.Workload) bool {
+	hasReservation := HasQuotaReservation(w)
+	hasAllChecksReady := HasAllChecksReady(w)
+	isAdmitted := IsAdmitted(w)
+
+	if isAdmitted == (hasReservation && hasAllChecksReady) {
+		return false
+	}
+	newCondition := metav1.Condition{
+		Type:               kueue.WorkloadAdmitted,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             "Admitted",
+		Message:            "The workload is admitted",
+	}
+	switch {
+	case !hasReservation && !hasAllChecksReady:
+		newCondition.Status = metav1.ConditionFalse
+		newCondition.Reason = "NoReservationUnsatisfiedChecks"
+		newCondition.Message = "The workload has no reservation and not all checks ready"
+	case !hasReservation:
+		newCondition.Status = metav1.ConditionFalse
+		newCondition.Reason = "NoReservation"
+		newCondition.Message = "The workload has no reservation"
+	case !hasAllChecksReady:
+		newCondition.Status = metav1.ConditionFalse
+		newCondition.Reason = "UnsatisfiedChecks"
+		newCondition.Message = "The workload has not all checks ready"
+	}
+	apimeta.SetStatusCondition(&w.Status.Conditions, newCondition)
+	return true
+}
+
 // UpdateReclaimablePods updates the ReclaimablePods list for the workload wit SSA.
 func UpdateReclaimablePods(ctx context.Context, c client.Client, w *kueue.Workload, reclaimablePods []kueue.ReclaimablePod) error {
 	patch := BaseSSAWorkload(w)
@@ -296,6 +296,19 @@ func TestGetQueueOrderTimestamp(t *testing.T) {
 				Obj(),
 			want: creationTime,
 		},
+		"evicted by deactivation": {
+			wl: utiltesting.MakeWorkload("name", "ns").
+				Creation(creationTime.Time).
+				Active(true).
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadEvicted,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: conditionTime,
+					Reason:             kueue.WorkloadEvictedByDeactivation,
+				}).
+				Obj(),
+			want: creationTime,
+		},
 		"evicted by PodsReady timeout": {
 			wl: utiltesting.MakeWorkload("name", "ns").
 				Creation(creationTime.Time).
@@ -374,3 +387,99 @@ func TestReclaimablePodsAreEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncAdmittedCondition(t *testing.T) {
+	cases := map[string]struct {
+		wl             *kueue.Workload
+		wantChanged    bool
+		wantConditions []metav1.Condition
+	}{
+		"pending": {
+			wl: utiltesting.MakeWorkload("wl", "ns").Obj(),
+		},
+		"quota reserved without checks": {
+			wl: utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+				Obj(),
+			wantChanged: true,
+			wantConditions: []metav1.Condition{
+				{
+					Type:    kueue.WorkloadQuotaReserved,
+					Status:  metav1.ConditionTrue,
+					Reason:  "AdmittedByTest",
+					Message: "Admitted by ClusterQueue cq",
+				},
+				{
+					Type:    kueue.WorkloadAdmitted,
+					Status:  metav1.ConditionTrue,
+					Reason:  "Admitted",
+					Message: "The workload is admitted",
+				},
+			},
+		},
+		"quota reserved with pending checks": {
+			wl: utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+				AdmissionCheck(kueue.AdmissionCheckState{Name: "ac1", State: kueue.CheckStatePending}).
+				Obj(),
+			wantConditions: []metav1.Condition{
+				{
+					Type:    kueue.WorkloadQuotaReserved,
+					Status:  metav1.ConditionTrue,
+					Reason:  "AdmittedByTest",
+					Message: "Admitted by ClusterQueue cq",
+				},
+			},
+		},
+		"admitted with a check in retry": {
+			wl: utiltesting.MakeWorkload("wl", "ns").
+				Admit(utiltesting.MakeAdmission("cq").Obj()).
+				AdmissionCheck(kueue.AdmissionCheckState{Name: "ac1", State: kueue.CheckStateRetry}).
+				Obj(),
+			wantChanged: true,
+			wantConditions: []metav1.Condition{
+				{
+					Type:    kueue.WorkloadQuotaReserved,
+					Status:  metav1.ConditionTrue,
+					Reason:  "AdmittedByTest",
+					Message: "Admitted by ClusterQueue cq",
+				},
+				{
+					Type:    kueue.WorkloadAdmitted,
+					Status:  metav1.ConditionFalse,
+					Reason:  "UnsatisfiedChecks",
+					Message: "The workload has not all checks ready",
+				},
+			},
+		},
+		"admitted without reservation": {
+			wl: utiltesting.MakeWorkload("wl", "ns").
+				Condition(metav1.Condition{
+					Type:   kueue.WorkloadAdmitted,
+					Status: metav1.ConditionTrue,
+					Reason: "Admitted",
+				}).
+				Obj(),
+			wantChanged: true,
+			wantConditions: []metav1.Condition{
+				{
+					Type:    kueue.WorkloadAdmitted,
+					Status:  metav1.ConditionFalse,
+					Reason:  "NoReservation",
+					Message: "The workload has no reservation",
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			changed := SyncAdmittedCondition(tc.wl)
+			if changed != tc.wantChanged {
+				t.Errorf("Unexpected changed, want=%v, got=%v", tc.wantChanged, changed)
+			}
+			if diff := cmp.Diff(tc.wantConditions, tc.wl.Status.Conditions, ignoreConditionTimestamps); diff != "" {
+				t.Errorf("Unexpected conditions (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}